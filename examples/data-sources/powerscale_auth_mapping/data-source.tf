@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# This Terraform DataSource is used to look up the identity mappings of source personas from PowerScale array.
+
+# Returns the identity mappings of the source personas specified in the filter block.
+data "powerscale_auth_mapping" "test" {
+  filter {
+    # Optional zone, defaults to System
+    zone    = "System"
+    sources = ["UID:10001", "SID:S-1-5-21-1234567890-1234567890-1234567890-1001"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# You can use the the fetched information by the variable data.powerscale_auth_mapping.test
+output "powerscale_auth_mapping" {
+  value = data.powerscale_auth_mapping.test
+}
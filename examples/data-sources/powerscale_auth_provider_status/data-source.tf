@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# This Terraform DataSource is used to query the status of the authentication providers (ADS, LDAP, NIS, file and local) on PowerScale array.
+
+# Returns the status of all the authentication providers
+data "powerscale_auth_provider_status" "all" {
+}
+
+# Returns the status of the providers matching the filter block
+data "powerscale_auth_provider_status" "test" {
+  filter {
+    # Optional, only the providers used by this access zone
+    zone = "System"
+    # Optional, only the providers with these names
+    # names = ["DOMAIN"]
+    # Optional, only the providers of these types. Acceptable values: ads, ldap, nis, file, local
+    types = ["ads", "ldap"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# You can use the the fetched information by the variable data.powerscale_auth_provider_status.test
+output "powerscale_auth_provider_status" {
+  value = data.powerscale_auth_provider_status.test
+}
+
+# The status can be used in a check block to validate the providers after apply
+check "auth_providers_online" {
+  assert {
+    condition     = alltrue([for provider in data.powerscale_auth_provider_status.test.auth_providers_status : provider.online])
+    error_message = "Authentication providers offline: ${join(", ", [for provider in data.powerscale_auth_provider_status.test.auth_providers_status : provider.id if !provider.online])}"
+  }
+}
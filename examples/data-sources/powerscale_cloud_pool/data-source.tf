@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# This Terraform DataSource is used to query the existing cloud pools from PowerScale array.
+
+# Returns all the cloud pools
+data "powerscale_cloud_pool" "all" {
+}
+
+# Returns the cloud pools matching the filter block
+data "powerscale_cloud_pool" "test" {
+  filter {
+    # Optional, names of the cloud pools
+    names = ["ecs_archive_pool"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# You can use the the fetched information by the variable data.powerscale_cloud_pool.test
+output "powerscale_cloud_pool" {
+  value = data.powerscale_cloud_pool.test
+}
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# This Terraform DataSource is used to query the data reduction statistics from PowerScale array,
+# together with the physical usage of each node pool and tier. The logical size and the data reduction ratio
+# are tracked cluster wide by the statistics keys, the storage pools only report their physical capacity.
+
+# Returns the default dedupe savings, compression, data reduction and capacity statistics and the usage of all the node pools and tiers
+data "powerscale_data_reduction_stats" "all" {
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_data_reduction_stats.all
+output "powerscale_data_reduction_stats_all" {
+  value = data.powerscale_data_reduction_stats.all
+}
+
+# Returns the given statistics keys and the usage of the node pools only
+data "powerscale_data_reduction_stats" "nodepools" {
+  # Optional, the statistics keys to query. Defaults to the dedupe savings, capacity, compression and data reduction keys
+  statistics_keys = [
+    "cluster.dedupe.logical.saved.bytes",
+    "ifs.bytes.used",
+  ]
+  filter {
+    # Optional, acceptable values: nodepool, tier
+    type = "nodepool"
+    # Optional, names of the storage pools
+    # names = ["x410_nodepool"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_data_reduction_stats.nodepools
+output "powerscale_data_reduction_stats_nodepools" {
+  value = data.powerscale_data_reduction_stats.nodepools
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# This Terraform DataSource is used to query the SmartDedupe savings summary from PowerScale array,
+# together with the reports of the Dedupe and DedupeAssessment jobs.
+
+# Returns the savings summary and all the dedupe reports
+data "powerscale_dedupe_report" "all" {
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_dedupe_report.all
+output "powerscale_dedupe_report_all" {
+  value = data.powerscale_dedupe_report.all
+}
+
+# Returns the savings summary and the reports of the DedupeAssessment jobs only
+data "powerscale_dedupe_report" "assessment" {
+  filter {
+    # Optional, acceptable values: Dedupe, DedupeAssessment
+    job_type = "DedupeAssessment"
+    # Optional, IDs of the jobs that generated the reports
+    # job_ids = [1234]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_dedupe_report.assessment
+output "powerscale_dedupe_report_assessment" {
+  value = data.powerscale_dedupe_report.assessment
+}
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# This Terraform data source previews the effect of a File Pool Policy on a directory subtree.
+# Files are sampled through the namespace API, so keep max_files and max_depth small for large subtrees.
+
+# Evaluates an existing File Pool Policy against /ifs/data.
+data "powerscale_filepool_policy_preview" "existing_policy" {
+  path        = "/ifs/data"
+  policy_name = "filePoolPolicySample"
+  # Optional, maximum number of files to scan. Defaults to 10000.
+  max_files = 5000
+  # Optional, maximum number of directories to list. Defaults to 1000.
+  max_directories = 500
+  # Optional, maximum directory depth to descend below path. Unlimited if not set.
+  max_depth = 3
+  # Optional, maximum number of matching files to return. Defaults to 10.
+  sample_size = 5
+}
+
+# Output value of above block by executing 'terraform output' command.
+# The user can use the fetched information by the variable data.powerscale_filepool_policy_preview.existing_policy
+output "powerscale_filepool_policy_preview_existing_policy" {
+  value = data.powerscale_filepool_policy_preview.existing_policy
+}
+
+# Evaluates a candidate file matching pattern before it is applied with the powerscale_filepool_policy resource.
+data "powerscale_filepool_policy_preview" "candidate" {
+  path = "/ifs/data"
+  file_matching_pattern = {
+    or_criteria = [
+      {
+        and_criteria = [
+          {
+            operator = ">"
+            type     = "size"
+            units    = "MB"
+            value    = "100"
+          },
+          {
+            operator          = ">"
+            type              = "accessed_time"
+            use_relative_time = true
+            value             = "2592000"
+          }
+        ]
+      }
+    ]
+  }
+  # Optional, storage pool the matching files would be moved to.
+  matched_storage_pool = "anywhere"
+  max_files            = 5000
+}
+
+# Output value of above block by executing 'terraform output' command.
+# The user can use the fetched information by the variable data.powerscale_filepool_policy_preview.candidate
+output "powerscale_filepool_policy_preview_candidate" {
+  value = data.powerscale_filepool_policy_preview.candidate
+}
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# This Terraform DataSource is used to resolve user or group names to their SID, UID and GID, group memberships and mapped identities on PowerScale array.
+
+# Returns the identities of the names specified in the filter block.
+data "powerscale_identity" "test" {
+  filter {
+    # Required, names of users or groups
+    names = ["DOMAIN\\user", "DOMAIN\\group", "admin"]
+
+    # Optional, defaults to System
+    zone = "System"
+    # Optional, defaults to all the providers of the zone
+    # provider = "lsa-activedirectory-provider:DOMAIN"
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# You can use the the fetched information by the variable data.powerscale_identity.test
+output "powerscale_identity" {
+  value = data.powerscale_identity.test
+}
+
+# The resolved IDs can be referenced in other resources, e.g. the SID of the first name
+output "powerscale_identity_sid" {
+  value = data.powerscale_identity.test.identities_details[0].sid
+}
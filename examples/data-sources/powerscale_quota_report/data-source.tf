@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# This Terraform DataSource is used to query the existing quota reports from PowerScale array,
+# together with the usage of each quota at the time the report was generated.
+
+# Returns all the quota reports
+data "powerscale_quota_report" "all" {
+}
+
+# Returns the quota reports matching the filter block
+data "powerscale_quota_report" "test" {
+  filter {
+    # Optional, IDs of the quota reports
+    # ids = ["1760832000_scheduled"]
+    # Optional, type of the quota reports. Acceptable values: scheduled, ad-hoc, live
+    type = "scheduled"
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# You can use the the fetched information by the variable data.powerscale_quota_report.test
+output "powerscale_quota_report" {
+  value = data.powerscale_quota_report.test
+}
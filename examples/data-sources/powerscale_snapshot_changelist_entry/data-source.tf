@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# This Terraform DataSource is used to query the entries of a snapshot changelist from PowerScale array.
+
+# Returns up to 1000 entries of the changelist
+data "powerscale_snapshot_changelist_entry" "all" {
+  # Required, ID of the changelist
+  changelist = powerscale_snapshot_changelist.example.id
+}
+
+# Returns the entries matching the filter block
+data "powerscale_snapshot_changelist_entry" "test" {
+  changelist = powerscale_snapshot_changelist.example.id
+
+  # Optional, maximum number of matching entries to return. Defaults to 1000.
+  # The truncated attribute is set to true when more entries match.
+  limit = 5000
+
+  filter {
+    # Optional, only the entries whose path starts with this prefix
+    path_prefix = "/ifs/data/projects"
+
+    # Optional, only the entries with any of these change types, among added, removed, path_changed and modified
+    change_types = ["added", "modified"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# You can use the the fetched information by the variable data.powerscale_snapshot_changelist_entry.test
+output "powerscale_snapshot_changelist_entry" {
+  value = data.powerscale_snapshot_changelist_entry.test
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# This Terraform DataSource is used to evaluate the RPO compliance of the SyncIQ policies on PowerScale array.
+# For each policy, the time since the last successful job is compared with the rpo_alert of the policy,
+# or with twice the interval of its schedule if rpo_alert is not set.
+
+# Returns the RPO status of all the SyncIQ policies
+data "powerscale_synciq_rpo_status" "all" {
+}
+
+# Returns the RPO status of the policies matching the filter block
+data "powerscale_synciq_rpo_status" "test" {
+  filter {
+    # Optional, names of the policies
+    names = ["policy1", "policy2"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# You can use the the fetched information by the variable data.powerscale_synciq_rpo_status.test
+output "powerscale_synciq_rpo_status" {
+  value = data.powerscale_synciq_rpo_status.test
+}
+
+# The status can be used in a check block to report RPO breaches on every plan and apply
+check "synciq_rpo" {
+  assert {
+    condition     = length(data.powerscale_synciq_rpo_status.all.breached_policies) == 0
+    error_message = "SyncIQ policies breaching their RPO: ${join(", ", data.powerscale_synciq_rpo_status.all.breached_policies)}"
+  }
+}
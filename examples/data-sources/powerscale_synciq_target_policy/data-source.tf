@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# This Terraform DataSource is used to query the SyncIQ policies targeting the PowerScale array, i.e. the DR side of the replication.
+
+# Returns all the SyncIQ target policies
+data "powerscale_synciq_target_policy" "all" {
+}
+
+# Returns the SyncIQ target policies matching the filter block
+data "powerscale_synciq_target_policy" "test" {
+  filter {
+    # Optional, names of the policies
+    names = ["policy1"]
+    # Optional, names of the source clusters
+    source_cluster_names = ["source-cluster"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# You can use the the fetched information by the variable data.powerscale_synciq_target_policy.test
+output "powerscale_synciq_target_policy" {
+  value = data.powerscale_synciq_target_policy.test
+}
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# This Terraform DataSource is used to query the details of existing SmartLock WORM domains from PowerScale array.
+
+# Returns all the WORM domains
+data "powerscale_worm_domain" "all" {
+}
+
+# Returns the WORM domains matching the filter block
+data "powerscale_worm_domain" "test" {
+  filter {
+    # Optional, root paths of the WORM domains
+    paths = ["/ifs/archive"]
+    # Optional, acceptable values: enterprise, compliance
+    types = ["enterprise"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# You can use the the fetched information by the variable data.powerscale_worm_domain.test
+output "powerscale_worm_domain" {
+  value = data.powerscale_worm_domain.test
+}
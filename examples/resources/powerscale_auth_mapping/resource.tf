@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update and Delete
+# After `terraform apply` of this example file for the first time, you will create the identity mappings on the PowerScale
+
+# PowerScale identity mappings (ID map) map a source persona to a target persona in an access zone
+resource "powerscale_auth_mapping" "example" {
+  #   Optional zone, defaults to System. Cannot be updated
+  # zone = "System"
+
+  #   Required set of mappings. Only the mappings listed here are managed by this resource
+  mappings = [
+    {
+      source = "SID:S-1-5-21-1234567890-1234567890-1234567890-1001"
+      target = "UID:10001"
+
+      # Optional parameters
+      # two_way = false
+      # on_disk = true
+    },
+    {
+      source = "UID:10002"
+      target = "GID:10002"
+    },
+  ]
+}
+
+# After the execution of above resource block, identity mappings would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
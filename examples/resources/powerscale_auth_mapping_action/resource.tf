@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create and Update performs the identity mapping action. Delete only removes the resource from the state.
+# After `terraform apply` of this example file, the specified identity mapping action will be performed on the PowerScale
+
+# Import identity mappings from a file on the cluster
+resource "powerscale_auth_mapping_action" "import" {
+  action = "import"
+  file   = "/ifs/data/idmap.txt"
+
+  # Optional parameters
+  # zone    = "System"
+  # replace = true
+}
+
+# Flush the identity mappings of an access zone
+resource "powerscale_auth_mapping_action" "flush" {
+  action = "flush"
+
+  # Optional parameters
+  # zone   = "System"
+  # filter = "UID:*"
+  # remove = true
+}
+
+# After the execution of above resource blocks, the identity mapping actions would have been performed on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,24 @@
+# Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+
+#     http://mozilla.org/MPL/2.0/
+
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# The command is
+# terraform import powerscale_cloud_account.example <cloud_account_id_or_name>
+# Example1:
+terraform import powerscale_cloud_account.example 5f0e2c1a-ad2a-4a3a-9e2c-8d0a6b2f1c3e
+# Example2:
+terraform import powerscale_cloud_account.example ecs_archive
+# after running this command, populate the name, type, uri, account_username and key fields in the config file to start managing this resource.
+# Note: the key is never returned by PowerScale, so it is empty after the import and must be set in the config.
+# Note: running "terraform show" after importing shows the current config/state of the resource. You can copy/paste that config to make it easier to manage the resource.
@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file for the first time, you will create a cloud account on the PowerScale
+
+# PowerScale cloud accounts hold the connection details and the credentials of a cloud storage.
+# A cloud account is added to a cloud pool, which is the target of the cloudpool policy action of a file pool policy.
+resource "powerscale_cloud_account" "example" {
+  # Required, name of the cloud account
+  name = "ecs_archive"
+
+  # Required, type of the cloud storage. Acceptable values are ecs, s3, azure, google, alibaba and isilon.
+  # Changing the type recreates the cloud account.
+  type = "ecs"
+
+  # Required, URI of the cloud storage
+  uri = "https://ecs.example.com:9021"
+
+  # Required, username of the cloud storage account, or the access key ID for the s3 type
+  account_username = "ecs_user"
+
+  # Required, key or password of the cloud storage account.
+  # The key is write-only: it is never stored in the Terraform state, which requires Terraform 1.11 or later.
+  key = "secret_key"
+
+  # Optional, version of the key. The key is only sent to the array on create and when this value changes,
+  # so change it along with the key to rotate the key.
+  key_version = 1
+
+  # Optional, account ID and region of the cloud storage, used by the s3 type
+  # account_id     = "123456789012"
+  # storage_region = "us-east-1"
+
+  # Optional, name of the network proxy used to reach the cloud storage
+  # proxy = "proxy_name"
+
+  # Optional, whether the cloud account is enabled
+  enabled = true
+
+  # Optional, whether to skip the validation of the SSL certificate of the cloud storage
+  skip_ssl_validation = false
+}
+
+# After the execution of above resource block, the cloud account would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
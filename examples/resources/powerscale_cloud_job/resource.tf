@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create and Update start the cloud job. Delete only removes the resource from the Terraform state.
+# After `terraform apply` of this example file, the cloud job will be started on the PowerScale
+# and Terraform will wait for the job to complete.
+
+# Archive a directory to the cloud pool of a file pool policy without waiting for the SmartPools job
+resource "powerscale_cloud_job" "archive" {
+  # Required, acceptable values: archive, recall
+  type = "archive"
+
+  # At least one of files and directories is required
+  directories = ["/ifs/data/archive"]
+  # files = ["/ifs/data/report.csv"]
+
+  # Optional, name of the file pool policy whose cloudpool policy action is used to archive the files
+  policy = "archive_to_cloud"
+}
+
+# Recall files from the cloud
+resource "powerscale_cloud_job" "recall" {
+  type  = "recall"
+  files = ["/ifs/data/archive/report.csv"]
+
+  # Optional, whether to wait for the job to complete. Defaults to true.
+  wait_for_completion = true
+
+  # Optional, seconds between two polls of the job. Defaults to 5.
+  poll_interval = 5
+
+  # Optional, seconds to wait for the job to complete. Defaults to 3600.
+  timeout = 3600
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file for the first time, you will create a cloud pool on the PowerScale
+
+# PowerScale cloud pools group the cloud accounts of the same type. A file pool policy tiers the matching files
+# to a cloud pool through its cloudpool policy action, so the whole chain can be declared in one configuration.
+resource "powerscale_cloud_account" "archive" {
+  name             = "ecs_archive"
+  type             = "ecs"
+  uri              = "https://ecs.example.com:9021"
+  account_username = "ecs_user"
+  key              = "secret_key"
+}
+
+resource "powerscale_cloud_pool" "example" {
+  # Required, name of the cloud pool
+  name = "ecs_archive_pool"
+
+  # Required, type of the cloud storage, which must match the type of the cloud accounts.
+  # Acceptable values are ecs, s3, azure, google, alibaba and isilon. Changing the type recreates the cloud pool.
+  type = powerscale_cloud_account.archive.type
+
+  # Required, names of the cloud accounts in the pool
+  accounts = [powerscale_cloud_account.archive.name]
+
+  # Optional, description of the cloud pool
+  description = "Archive tier on ECS"
+
+  # Optional, vendor of the cloud storage
+  # vendor = "Dell"
+}
+
+# Tier the files not accessed for 90 days to the cloud pool
+resource "powerscale_filepool_policy" "archive" {
+  name = "archive_to_cloud"
+  file_matching_pattern = {
+    or_criteria = [
+      {
+        and_criteria = [
+          {
+            operator          = ">"
+            type              = "accessed_time"
+            use_relative_time = true
+            value             = "90D"
+          }
+        ]
+      }
+    ]
+  }
+  actions = [
+    {
+      action_type = "set_cloudpool_policy"
+      cloudpool_policy_action = {
+        pool = powerscale_cloud_pool.example.name
+      }
+    }
+  ]
+}
+
+# After the execution of above resource blocks, the cloud pool would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
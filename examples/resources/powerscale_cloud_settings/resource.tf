@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load cloud settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load cloud settings (if not loaded) and update the settings.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting cloud settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale Cloud Settings allow you to configure the CloudPools settings on PowerScale,
+# including the default archive, cache and retention values used by the cloudpool policy action of the file pool policies.
+resource "powerscale_cloud_settings" "example" {
+  # Optional fields both for creating and updating
+  cloud_policy_defaults = {
+    # archive_snapshot_files = true
+    # full_backup_retention = 145152000
+    # incremental_backup_retention = 145152000
+    # writeback_frequency = 32400
+
+    # Compress and encrypt the archived data
+    compression = true
+    encryption  = true
+
+    # Keep the archived data one week in the cloud after deletion
+    data_retention = 604800
+
+    cache = {
+      # expiration = 86400
+      # Acceptable values: partial, full
+      read_ahead = "partial"
+      # Acceptable values: cached, no-cache
+      type = "cached"
+    }
+  }
+
+  # Optional, name of the network proxy used by default to reach the cloud storage
+  # default_network_proxy = "proxy_name"
+}
+
+# After the execution of above resource block, cloud settings would have been cached in terraform state file, or
+# cloud settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load dedupe settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load dedupe settings (if not loaded) and update the settings.
+# If job_type is set, a job of that type is started when the settings are created, when paths or assess_paths change, or when job_type changes.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting dedupe settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale SmartDedupe Settings define which paths are deduplicated by the Dedupe job
+# and which paths are assessed by the DedupeAssessment job.
+resource "powerscale_dedupe_settings" "example" {
+  # Optional fields both for creating and updating
+  # There are no exclusions, a path is deduplicated along with all its subdirectories.
+  paths        = ["/ifs/data/vm_images"]
+  assess_paths = ["/ifs/data/vm_images", "/ifs/data/home"]
+
+  # Optional, acceptable values: Dedupe, DedupeAssessment
+  # Estimate the savings of the assessed paths once the settings are applied.
+  # The results can be read with the powerscale_dedupe_report data source once the job has finished.
+  job_type = "DedupeAssessment"
+}
+
+# After the execution of above resource block, dedupe settings would have been cached in terraform state file, or
+# dedupe settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file for the first time, you will create a default quota notification rule on the PowerScale
+
+# PowerScale default quota notification rules apply to all the quotas whose notifications are not set to custom rules.
+resource "powerscale_quota_default_notification" "example" {
+  # Required, acceptable values: hard, soft, advisory
+  threshold = "soft"
+
+  # Required, acceptable values: exceeded, denied, violated, expired
+  condition = "expired"
+
+  # Optional, send an alert and an email to the owner of the quota
+  action_alert       = true
+  action_email_owner = true
+
+  # Optional, email address to send the notification to
+  # action_email_address = "storage-admin@example.com"
+
+  # Optional, path of the email template, the default template is used when empty
+  # email_template = "/ifs/home/admin/quota_email_template.txt"
+
+  # Optional, schedule of the notification, used by the violated and expired conditions
+  schedule = "Every 1 days"
+
+  # Optional, seconds between two notifications, used by the exceeded and denied conditions
+  # holdoff = 3600
+}
+
+# After the execution of above resource block, the default notification rule would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,21 @@
+# Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+
+#     http://mozilla.org/MPL/2.0/
+
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# The command is
+# terraform import powerscale_quota_notification.example <quota_id>:<notification_rule_id>
+# Example:
+terraform import powerscale_quota_notification.example AABpAQEAAAAAAAAAAAAAQA0AAAAAAAAA:a6c29b4d-5bfb-43a6-a2e5-2f12a0c0f2a1
+# after running this command, populate the quota_id, threshold and condition fields in the config file to start managing this resource.
+# Note: running "terraform show" after importing shows the current config/state of the resource. You can copy/paste that config to make it easier to manage the resource.
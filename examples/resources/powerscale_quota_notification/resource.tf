@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file for the first time, you will create a notification rule on a quota of the PowerScale
+
+# PowerScale quota notification rules send alerts and emails when a threshold of the quota is exceeded.
+# Note: creating a rule switches the notifications of the quota to custom rules, so the default rules no longer apply to this quota.
+resource "powerscale_quota" "projects" {
+  path = "/ifs/projects"
+  type = "directory"
+  zone = "System"
+  thresholds = {
+    soft       = 800000000000
+    soft_grace = 604800
+    hard       = 1000000000000
+  }
+}
+
+resource "powerscale_quota_notification" "example" {
+  # Required, ID of the quota. Changing the quota recreates the rule.
+  quota_id = powerscale_quota.projects.id
+
+  # Required, acceptable values: hard, soft, advisory
+  threshold = "hard"
+
+  # Required, acceptable values: exceeded, denied, violated, expired
+  condition = "exceeded"
+
+  # Optional, send an alert and an email to the owner of the quota and to an address
+  action_alert         = true
+  action_email_owner   = true
+  action_email_address = "storage-admin@example.com"
+
+  # Optional, path of the email template, the default template is used when empty
+  # email_template = "/ifs/home/admin/quota_email_template.txt"
+
+  # Optional, seconds between two notifications, used by the exceeded and denied conditions
+  holdoff = 3600
+
+  # Optional, schedule of the notification, used by the violated and expired conditions
+  # schedule = "Every 1 days"
+}
+
+# After the execution of above resource block, the notification rule would have been created on the quota.
+# For more information, Please check the terraform state file.
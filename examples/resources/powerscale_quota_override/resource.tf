@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file the quota generated by the default-user quota for the given user is
+# unlinked from the default quota and gets the custom thresholds.
+# `terraform destroy` links the quota to its default quota again, so it gets the default thresholds back.
+# For more information, Please check the terraform state file.
+
+# PowerScale default-user and default-group quotas generate a linked quota for each of their members.
+# A quota override gives one of the members custom thresholds.
+resource "powerscale_quota" "default_user" {
+  path              = "/ifs/example_quota"
+  type              = "default-user"
+  include_snapshots = false
+  thresholds = {
+    hard = 10737418240
+  }
+}
+
+resource "powerscale_quota_override" "example" {
+  depends_on = [powerscale_quota.default_user]
+
+  # Required and update not supported
+  path    = "/ifs/example_quota"
+  type    = "user"
+  persona = "USER:example_user"
+
+  # Optional and update not supported
+  # zone = "System"
+  # include_snapshots = false
+
+  # Optional and update supported
+  # enforced = true
+  # thresholds_on = "applogicalsize"
+
+  # Required and update supported
+  thresholds = {
+    # advisory = 16106127360
+    # soft = 18253611008
+    # soft_grace = 86400
+    hard = 21474836480
+  }
+}
+
+# After the execution of above resource block, the quota of the user would have been overridden on the PowerScale array.
+# For more information, Please check the terraform state file.
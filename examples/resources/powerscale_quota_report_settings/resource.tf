@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load quota report settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load quota report settings (if not loaded) and update the settings.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting quota report settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale Quota Report Settings allow you to configure where quota reports are written,
+# how often scheduled reports are generated and how many reports are kept.
+resource "powerscale_quota_report_settings" "example" {
+  # Optional fields both for creating and updating
+  #  live_dir = "/ifs/.isilon/smartquotas/reports"
+  #  scheduled_dir = "/ifs/.isilon/smartquotas/reports"
+
+  # Generate a report every day and keep the reports of the last two weeks
+  schedule         = "Every day at 1:00 AM"
+  scheduled_retain = 14
+  live_retain      = 5
+}
+
+# After the execution of above resource block, quota report settings would have been cached in terraform state file, or
+# quota report settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
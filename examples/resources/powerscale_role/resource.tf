@@ -28,6 +28,8 @@ resource "powerscale_role" "role_test" {
 
   # Optional fields both for creating and updating
   description = "role_test_description"
+  # Optional, defaults to authoritative. Use additive to keep the members added outside of this resource, e.g. by powerscale_role_member.
+  membership_mode = "authoritative"
   # To add members, the uid/gid is required. Please use user/user_group datasource to look up the uid/gid needed.
   members = [
     {
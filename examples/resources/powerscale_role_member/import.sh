@@ -0,0 +1,23 @@
+# Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+
+#     http://mozilla.org/MPL/2.0/
+
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# The command is
+# terraform import powerscale_role_member.member_test [<zone_id>:]<role_id>/<member_id>
+# Example1, <zone_id> is Optional, defaults to System:
+terraform import powerscale_role_member.member_test role_id/GID:2000
+# Example2:
+terraform import powerscale_role_member.member_test zone_id:role_id/GID:2000
+# after running this command, populate the role_id and member_id fields in the config file to start managing this resource.
+# Note: running "terraform show" after importing shows the current config/state of the resource. You can copy/paste that config to make it easier to manage the resource.
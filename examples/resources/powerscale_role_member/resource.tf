@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Delete and Import
+# After `terraform apply` of this example file for the first time, you will add a member to an existing role on the PowerScale
+
+# PowerScale role member allows different configurations to attach their users or groups to a shared role, without overwriting each other's members.
+# Note: the role should be managed by a powerscale_role resource with membership_mode = "additive", or not be managed by terraform at all.
+resource "powerscale_role_member" "member_test" {
+  # Required, cannot be updated
+  role_id = "SystemAdmin"
+
+  # Exactly one of member_id and member_name is required, cannot be updated
+  # Please use user/user_group datasource to look up the uid/gid/sid needed.
+  member_id = "GID:2000"
+  # member_name = "DOMAIN\\group"
+
+  # Optional, cannot be updated
+  zone = "System"
+}
+
+# After the execution of above resource block, the member would have been added to the role on the PowerScale array.
+# For more information, Please check the terraform state file.
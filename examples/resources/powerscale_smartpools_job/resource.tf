@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create and Update start the job. Delete only removes the resource from the Terraform state,
+# a running job is not cancelled.
+# After `terraform apply` of this example file, the SmartPools job will be started on the PowerScale
+# and Terraform will wait for it to finish. The progress of the job is reported in the state.
+
+# Apply the file pool policies to the whole cluster
+resource "powerscale_smartpools_job" "cluster" {
+  # Optional, acceptable values: SmartPools, SmartPoolsTree. Defaults to SmartPools.
+  job_type = "SmartPools"
+
+  # Optional, start the job even if another SmartPools job is queued or running
+  allow_dup = false
+}
+
+# Apply the file pool policies to a subtree only
+resource "powerscale_smartpools_job" "tree" {
+  job_type = "SmartPoolsTree"
+
+  # Required for SmartPoolsTree, paths to run the job on
+  paths = ["/ifs/data/projects"]
+
+  # Optional, impact policy and priority of the job
+  policy   = "LOW"
+  priority = 5
+
+  # Optional, whether to wait for the job to finish. Defaults to true.
+  wait = true
+
+  # Optional, seconds between two polls of the job. Defaults to 5.
+  poll_interval = 10
+
+  # Optional, seconds to wait for the job to finish. Defaults to 3600.
+  timeout = 7200
+
+  # Start the job once the file pool policy exists
+  depends_on = [powerscale_filepool_policy.example]
+}
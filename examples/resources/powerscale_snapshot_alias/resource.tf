@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file for the first time, you will create a snapshot alias on the PowerScale
+
+# PowerScale snapshot aliases are stable names pointing to a snapshot. NFS and SMB clients can access the alias
+# under the .snapshot directory, and the alias can be repointed to a newer snapshot without changing the clients.
+resource "powerscale_snapshot" "nightly" {
+  path = "/ifs/data"
+  name = "data_nightly"
+}
+
+resource "powerscale_snapshot_alias" "example" {
+  # Required, name of the alias
+  name = "data_latest"
+
+  # Required, name or ID of the snapshot the alias points to. Use HEAD to point the alias to the live file system.
+  # Changing the target repoints the alias to another snapshot.
+  target = powerscale_snapshot.nightly.name
+}
+
+# After the execution of above resource block, the snapshot alias would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Delete and Import
+# After `terraform apply` of this example file for the first time, you will run the ChangelistCreate job on the PowerScale,
+# which records the files and directories changed between the two snapshots.
+
+# The two snapshots must be taken on the same path.
+resource "powerscale_snapshot" "previous" {
+  path = "/ifs/data"
+  name = "data_index_previous"
+}
+
+resource "powerscale_snapshot" "current" {
+  path = "/ifs/data"
+  name = "data_index_current"
+}
+
+resource "powerscale_snapshot_changelist" "example" {
+  # Required, name or ID of the older snapshot. Changing it recreates the changelist.
+  older_snapshot = powerscale_snapshot.previous.name
+
+  # Required, name or ID of the newer snapshot. Changing it recreates the changelist.
+  newer_snapshot = powerscale_snapshot.current.name
+
+  # Optional, seconds between two polls of the ChangelistCreate job. Defaults to 5.
+  poll_interval = 5
+
+  # Optional, seconds to wait for the ChangelistCreate job to finish. Defaults to 3600.
+  # Creating the changelist fails if the job is cancelled or paused.
+  timeout = 3600
+}
+
+# After the execution of above resource block, the changelist would have been created on the PowerScale array.
+# The entries of the changelist can be read with the powerscale_snapshot_changelist_entry datasource.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file for the first time, you will create a snapshot lock on the PowerScale
+
+# A locked snapshot cannot be deleted until all its locks are removed, for example
+# backup tooling can hold a snapshot while it copies data out of it.
+resource "powerscale_snapshot" "backup" {
+  path = "/ifs/data"
+  name = "data_backup"
+}
+
+resource "powerscale_snapshot_lock" "example" {
+  # Required, name or ID of the snapshot to lock. Changing it recreates the lock.
+  snapshot = powerscale_snapshot.backup.name
+
+  # Optional, comment of the lock. Changing it recreates the lock.
+  comment = "Held by the backup job"
+
+  # Optional, Unix Epoch time the lock expires, 0 if it never expires.
+  # An expired lock is removed by PowerScale, and the next plan will recreate it.
+  expires = 2145916800
+}
+
+# After the execution of above resource block, the snapshot lock would have been created on the PowerScale array.
+# Destroying the resource removes the lock, so the snapshot can be deleted again.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load snapshot settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load snapshot settings (if not loaded) and update the settings.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting snapshot settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale Snapshot Settings allow you to configure the global snapshot settings on PowerScale,
+# including the visibility and accessibility of the .snapshot directory for NFS, SMB and local clients.
+resource "powerscale_snapshot_settings" "example" {
+  # Optional fields both for creating and updating
+  #  service = true
+  #  autocreate = true
+  #  autodelete = true
+  #  reserve = 0
+  #  global_visible_accessible = true
+  #  local_root_accessible = true
+  #  local_root_visible = false
+  #  local_subdir_accessible = true
+  #  nfs_root_accessible = true
+  #  nfs_root_visible = true
+  #  nfs_subdir_accessible = true
+
+  # Hide the .snapshot directory from SMB clients
+  smb_root_accessible   = false
+  smb_root_visible      = false
+  smb_subdir_accessible = false
+}
+
+# After the execution of above resource block, snapshot settings would have been cached in terraform state file, or
+# snapshot settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
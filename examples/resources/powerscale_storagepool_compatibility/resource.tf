@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Delete and Import
+# After `terraform apply` of this example file it will create a node class compatibility on the PowerScale Array.
+# Any change to the compatibility recreates it.
+# For more information, Please check the terraform state file.
+
+# PowerScale node class compatibilities let the nodes of two compatible node classes,
+# such as the nodes of a hardware refresh, be provisioned into the same node pool.
+resource "powerscale_storagepool_compatibility" "example" {
+  # Required
+  class_1 = "S200"
+  class_2 = "S210"
+}
+
+# After the execution of above resource block, a node class compatibility would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file it will create a manual node pool on the PowerScale Array.
+# The node pools provisioned automatically by the array can be imported to manage their settings,
+# `terraform destroy` only removes them from the terraform state file.
+# For more information, Please check the terraform state file.
+
+# PowerScale node pools group nodes of the same node type, so that data is protected and tiered across them.
+resource "powerscale_storagepool_nodepool" "example" {
+  # Required
+  name = "example_nodepool"
+  # The logical node numbers of the nodes in the node pool, only manual node pools can update their nodes
+  lnns = [1, 2, 3]
+
+  # Optional fields both for creating and updating
+  # l3 = true
+  # protection_policy = "+2d:1n"
+  # tier = "example_tier"
+  # transfer_limit_pct = 90
+  # transfer_limit_state = "user"
+}
+
+# After the execution of above resource block, a node pool would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create and Update run the failover or failback sequence. Delete only removes the resource from the Terraform state.
+# After `terraform apply` of this example file, the SyncIQ policy will be in the configured failover state.
+# Each step starts a replication job and waits for it to finish, so an apply can take a long time.
+# If a step fails, the completed steps are recorded in completed_steps and the next apply resumes from the failed step.
+# When the resource is replaced after a failed create, the progress of an interrupted failback is read from the clusters.
+
+# The resource must use the provider alias of the secondary (target) cluster.
+# The peer block holds the connection details of the primary (source) cluster,
+# usually the same values as its provider configuration.
+# Note: the peer block, including the password, is stored in the Terraform state. Protect the state accordingly.
+resource "powerscale_synciq_failover" "example" {
+  provider = powerscale.secondary
+
+  # Required, name of the SyncIQ policy
+  policy = "policy1"
+
+  # Required, acceptable values: source_active, failed_over
+  # failed_over: run allow_write on the secondary cluster, it becomes writable.
+  # source_active: fail back to the primary cluster according to failback_mode.
+  failover_state = "failed_over"
+
+  # Optional, acceptable values: resync, revert. Defaults to resync.
+  # resync keeps the changes made on the secondary cluster by replicating them back with the mirror policy.
+  # revert runs allow_write_revert on the secondary cluster and discards the changes made there.
+  failback_mode = "resync"
+
+  # Optional, seconds between two polls of a running job. Defaults to 10.
+  poll_interval = 10
+
+  # Optional, seconds to wait for each job. Defaults to 3600.
+  timeout = 3600
+
+  # Optional, required to fail back with failback_mode resync
+  peer = {
+    endpoint = var.endpoint
+    username = var.username
+    password = var.password
+    insecure = var.insecure
+  }
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+# Available actions: Create and Update perform the action. Delete only removes the resource from the Terraform state.
+# After `terraform apply` of this example file, the action will be performed on the SyncIQ policy of the PowerScale
+# and Terraform will wait for the policy to be no longer conflicted.
+
+# Clear the conflicted flag of a SyncIQ policy, keeping its incremental sync state
+resource "powerscale_synciq_policy_action" "resolve" {
+  # Required, name or ID of the policy
+  policy = "policy1"
+
+  # Required, acceptable values: reset, resolve
+  action = "resolve"
+}
+
+# Reset a SyncIQ policy.
+# Note: the incremental sync state is discarded and the next job of the policy runs a full replication.
+resource "powerscale_synciq_policy_action" "reset" {
+  policy = "policy2"
+  action = "reset"
+
+  # Optional, seconds between two polls of the policy. Defaults to 5.
+  poll_interval = 5
+
+  # Optional, seconds to wait for the policy to be no longer conflicted. Defaults to 300.
+  timeout = 300
+}
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create and Update perform the action. Delete only removes the resource from the Terraform state.
+# After `terraform apply` of this example file, the action will be performed on the SyncIQ target policy of the PowerScale
+
+# Cancel the running job of a SyncIQ policy from the target cluster
+resource "powerscale_synciq_target_policy_action" "cancel" {
+  # Required, name or ID of the target policy
+  policy = "policy1"
+
+  # Required, acceptable values: break_association, cancel
+  action = "cancel"
+}
+
+# Break the association of a SyncIQ policy on the target cluster.
+# Note: the target directory becomes writable and the next job of the policy on the source cluster runs a full replication.
+resource "powerscale_synciq_target_policy_action" "break" {
+  policy = "policy2"
+  action = "break_association"
+
+  # Optional, break the association even if the source cluster cannot be contacted
+  force = true
+}
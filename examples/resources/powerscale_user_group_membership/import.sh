@@ -0,0 +1,23 @@
+# Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+
+#     http://mozilla.org/MPL/2.0/
+
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# The command is
+# terraform import powerscale_user_group_membership.example [<zoneID>:]<groupName>:<member1>,<member2>
+# Example1, <zoneID> is Optional, defaults to System:
+terraform import powerscale_user_group_membership.example groupName:user1,user2
+# Example2:
+terraform import powerscale_user_group_membership.example zoneID:groupName:user1,wellKnown1
+# after running this command, only the given members of the user group are imported, other members of the user group are left unmanaged.
+# Note: running "terraform show" after importing shows the current config/state of the resource. You can copy/paste that config to make it easier to manage the resource.
@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file for the first time, you will add the members to an existing User Group on the PowerScale
+
+# PowerScale User Group Membership allows different configurations to add their members to the same User Group, without overwriting each other's members.
+resource "powerscale_user_group_membership" "example" {
+  # Required, the name of an existing User Group, cannot be updated
+  group = "example_group"
+
+  # Optional, cannot be updated
+  zone = "System"
+
+  # Optional, only the members listed here are managed by this resource
+  users       = ["example_user"]
+  groups      = ["example_member_group"]
+  well_knowns = ["Everyone"]
+}
+
+# After the execution of above resource block, the members would have been added to the User Group on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update and Import
+# After `terraform apply` of this example file for the first time, you will create a SmartLock WORM domain on the PowerScale
+
+# PowerScale SmartLock WORM domains protect the files in a directory from being modified or deleted until their retention period expires.
+# Note: WORM domains cannot be deleted from PowerScale. Destroying this resource only removes it from the Terraform state.
+# Irreversible changes, like the path, type and disabling privileged delete, are reported when running `terraform plan`.
+resource "powerscale_worm_domain" "example" {
+  # Required, the directory must exist and be empty. Cannot be updated.
+  path = "/ifs/archive"
+
+  # Optional, acceptable values: enterprise, compliance. Defaults to enterprise. Cannot be updated.
+  type = "enterprise"
+
+  # Optional, retention periods in the format <integer><unit>, where unit is one of Y, M, W, D, H, m, s
+  default_retention = "1Y"
+  min_retention     = "6M"
+  max_retention     = "7Y"
+
+  # Optional, files not modified for this period in seconds are committed automatically
+  autocommit_offset = 86400
+
+  # Optional, unix epoch timestamp until which committed files are retained at least
+  # override_date = 1893456000
+
+  # Optional, acceptable values: on, off, disabled. Note that, disabled is permanent.
+  privileged_delete = "off"
+}
+
+# After the execution of above resource block, the WORM domain would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
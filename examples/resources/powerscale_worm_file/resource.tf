@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create and Update. Delete only removes the resource from the Terraform state.
+# After `terraform apply` of this example file for the first time, you will commit files to WORM on the PowerScale
+
+# PowerScale WORM files are protected from being modified or deleted until their retention date has passed.
+# The files must be in a WORM domain, see powerscale_worm_domain.
+# Note: committed files cannot be uncommitted and retention dates cannot be shortened. Such changes are reported when running `terraform plan`.
+resource "powerscale_worm_file" "legal_hold" {
+  # Required, absolute paths of the files
+  paths = [
+    "/ifs/archive/case-1234/contract.pdf",
+    "/ifs/archive/case-1234/emails.pst",
+  ]
+
+  # Optional, defaults to true. Cannot be changed from true to false.
+  commit = true
+
+  # Optional, RFC3339 format, defaults to the default retention of the WORM domain. Can only be extended.
+  retention_date = "2030-01-01T00:00:00Z"
+}
+
+# After the execution of above resource block, the files would have been committed to WORM on the PowerScale array.
+# The current WORM state of the files is exposed by the files attribute. If a file is found uncommitted or retained
+# shorter than retention_date, the next apply commits it or extends its retention date again.
+# For more information, Please check the terraform state file.
@@ -464,6 +464,9 @@ const (
 	// ReadSnapshotRestoreJobErrorMsg specifies error details occurred while reading snapshot restore job.
 	ReadSnapshotRestoreJobErrorMsg = "Could not read snapshot restore job "
 
+	// ReadJobErrorMsg specifies error details occurred while reading job.
+	ReadJobErrorMsg = "Could not read job "
+
 	// ReadSnapshotRestoreJobReportErrorMsg specifies error details occurred while reading snapshot restore job reports.
 	ReadSnapshotRestoreJobReportErrorMsg = "Could not read snapshot restore job reports "
 
@@ -511,4 +514,238 @@ const (
 
 	// DeleteStoragepoolTierErrorMsg specifies error details occurred while deleting Storage pool Tier.
 	DeleteStoragepoolTierErrorMsg = "Could not delete storagepool tier "
+
+	// CreateAuthMappingErrorMsg specifies error details occurred while creating identity mapping.
+	CreateAuthMappingErrorMsg = "Could not create identity mapping "
+
+	// ReadAuthMappingErrorMsg specifies error details occurred while reading identity mapping.
+	ReadAuthMappingErrorMsg = "Could not read identity mapping "
+
+	// DeleteAuthMappingErrorMsg specifies error details occurred while deleting identity mapping.
+	DeleteAuthMappingErrorMsg = "Could not delete identity mapping "
+
+	// ImportAuthMappingErrorMsg specifies error details occurred while importing identity mappings.
+	ImportAuthMappingErrorMsg = "Could not import identity mappings "
+
+	// FlushAuthMappingErrorMsg specifies error details occurred while flushing identity mappings.
+	FlushAuthMappingErrorMsg = "Could not flush identity mappings "
+
+	// ReadIdentityErrorMsg specifies error details occurred while resolving identity.
+	ReadIdentityErrorMsg = "Could not resolve identity "
+
+	// ReadAuthProviderStatusErrorMsg specifies error details occurred while reading auth provider status.
+	ReadAuthProviderStatusErrorMsg = "Could not read auth provider status "
+
+	// CreateWormDomainErrorMsg specifies error details occurred while creating worm domain.
+	CreateWormDomainErrorMsg = "Could not create worm domain "
+
+	// ReadWormDomainErrorMsg specifies error details occurred while reading worm domain.
+	ReadWormDomainErrorMsg = "Could not read worm domain "
+
+	// UpdateWormDomainErrorMsg specifies error details occurred while updating worm domain.
+	UpdateWormDomainErrorMsg = "Could not update worm domain "
+
+	// ReadWormFileErrorMsg specifies error details occurred while reading worm file properties.
+	ReadWormFileErrorMsg = "Could not read worm file properties "
+
+	// UpdateWormFileErrorMsg specifies error details occurred while updating worm file properties.
+	UpdateWormFileErrorMsg = "Could not update worm file properties "
+
+	// ReadSyncIQTargetPolicyErrorMsg specifies error details occurred while reading SyncIQ target policies.
+	ReadSyncIQTargetPolicyErrorMsg = "Could not read SyncIQ target policies "
+
+	// BreakSyncIQTargetPolicyErrorMsg specifies error details occurred while breaking SyncIQ target association.
+	BreakSyncIQTargetPolicyErrorMsg = "Could not break SyncIQ target association "
+
+	// CancelSyncIQTargetPolicyErrorMsg specifies error details occurred while canceling SyncIQ target job.
+	CancelSyncIQTargetPolicyErrorMsg = "Could not cancel SyncIQ target job "
+
+	// RunSyncIQFailoverStepErrorMsg specifies error details occurred while running a SyncIQ failover step.
+	RunSyncIQFailoverStepErrorMsg = "Could not run SyncIQ failover step "
+
+	// ConnectSyncIQFailoverPeerErrorMsg specifies error details occurred while connecting to the SyncIQ failover peer cluster.
+	ConnectSyncIQFailoverPeerErrorMsg = "Could not connect to the SyncIQ failover peer cluster "
+
+	// ResetSyncIQPolicyErrorMsg specifies error details occurred while resetting SyncIQ policy.
+	ResetSyncIQPolicyErrorMsg = "Could not reset SyncIQ policy "
+
+	// ResolveSyncIQPolicyErrorMsg specifies error details occurred while resolving SyncIQ policy.
+	ResolveSyncIQPolicyErrorMsg = "Could not resolve SyncIQ policy "
+
+	// ReadSyncIQRPOStatusErrorMsg specifies error details occurred while reading SyncIQ RPO status.
+	ReadSyncIQRPOStatusErrorMsg = "Could not read SyncIQ RPO status "
+
+	// CreateSnapshotAliasErrorMsg specifies error details occurred while creating snapshot alias.
+	CreateSnapshotAliasErrorMsg = "Could not create snapshot alias "
+
+	// ReadSnapshotAliasErrorMsg specifies error details occurred while reading snapshot alias.
+	ReadSnapshotAliasErrorMsg = "Could not read snapshot alias "
+
+	// UpdateSnapshotAliasErrorMsg specifies error details occurred while updating snapshot alias.
+	UpdateSnapshotAliasErrorMsg = "Could not update snapshot alias "
+
+	// DeleteSnapshotAliasErrorMsg specifies error details occurred while deleting snapshot alias.
+	DeleteSnapshotAliasErrorMsg = "Could not delete snapshot alias "
+
+	// CreateSnapshotLockErrorMsg specifies error details occurred while creating snapshot lock.
+	CreateSnapshotLockErrorMsg = "Could not create snapshot lock "
+
+	// ReadSnapshotLockErrorMsg specifies error details occurred while reading snapshot lock.
+	ReadSnapshotLockErrorMsg = "Could not read snapshot lock "
+
+	// UpdateSnapshotLockErrorMsg specifies error details occurred while updating snapshot lock.
+	UpdateSnapshotLockErrorMsg = "Could not update snapshot lock "
+
+	// DeleteSnapshotLockErrorMsg specifies error details occurred while deleting snapshot lock.
+	DeleteSnapshotLockErrorMsg = "Could not delete snapshot lock "
+
+	// CreateSnapshotChangelistErrorMsg specifies error details occurred while creating snapshot changelist.
+	CreateSnapshotChangelistErrorMsg = "Could not create snapshot changelist "
+
+	// ReadSnapshotChangelistErrorMsg specifies error details occurred while reading snapshot changelist.
+	ReadSnapshotChangelistErrorMsg = "Could not read snapshot changelist "
+
+	// DeleteSnapshotChangelistErrorMsg specifies error details occurred while deleting snapshot changelist.
+	DeleteSnapshotChangelistErrorMsg = "Could not delete snapshot changelist "
+
+	// ReadSnapshotChangelistEntriesErrorMsg specifies error details occurred while reading snapshot changelist entries.
+	ReadSnapshotChangelistEntriesErrorMsg = "Could not read snapshot changelist entries "
+
+	// ReadSnapshotSettingsErrorMsg specifies error details occurred while reading snapshot settings.
+	ReadSnapshotSettingsErrorMsg = "Could not read snapshot settings "
+
+	// UpdateSnapshotSettingsErrorMsg specifies error details occurred while updating snapshot settings.
+	UpdateSnapshotSettingsErrorMsg = "Could not update snapshot settings "
+
+	// CreateCloudAccountErrorMsg specifies error details occurred while creating cloud account.
+	CreateCloudAccountErrorMsg = "Could not create cloud account "
+
+	// ReadCloudAccountErrorMsg specifies error details occurred while reading cloud account.
+	ReadCloudAccountErrorMsg = "Could not read cloud account "
+
+	// UpdateCloudAccountErrorMsg specifies error details occurred while updating cloud account.
+	UpdateCloudAccountErrorMsg = "Could not update cloud account "
+
+	// DeleteCloudAccountErrorMsg specifies error details occurred while deleting cloud account.
+	DeleteCloudAccountErrorMsg = "Could not delete cloud account "
+
+	// CreateCloudPoolErrorMsg specifies error details occurred while creating cloud pool.
+	CreateCloudPoolErrorMsg = "Could not create cloud pool "
+
+	// ReadCloudPoolErrorMsg specifies error details occurred while reading cloud pool.
+	ReadCloudPoolErrorMsg = "Could not read cloud pool "
+
+	// UpdateCloudPoolErrorMsg specifies error details occurred while updating cloud pool.
+	UpdateCloudPoolErrorMsg = "Could not update cloud pool "
+
+	// DeleteCloudPoolErrorMsg specifies error details occurred while deleting cloud pool.
+	DeleteCloudPoolErrorMsg = "Could not delete cloud pool "
+
+	// ReadCloudSettingsErrorMsg specifies error details occurred while reading cloud settings.
+	ReadCloudSettingsErrorMsg = "Could not read cloud settings "
+
+	// UpdateCloudSettingsErrorMsg specifies error details occurred while updating cloud settings.
+	UpdateCloudSettingsErrorMsg = "Could not update cloud settings "
+
+	// CreateCloudJobErrorMsg specifies error details occurred while creating cloud job.
+	CreateCloudJobErrorMsg = "Could not create cloud job "
+
+	// ReadCloudJobErrorMsg specifies error details occurred while reading cloud job.
+	ReadCloudJobErrorMsg = "Could not read cloud job "
+
+	// CreateQuotaNotificationErrorMsg specifies error details occurred while creating quota notification rule.
+	CreateQuotaNotificationErrorMsg = "Could not create quota notification rule "
+
+	// ReadQuotaNotificationErrorMsg specifies error details occurred while reading quota notification rule.
+	ReadQuotaNotificationErrorMsg = "Could not read quota notification rule "
+
+	// UpdateQuotaNotificationErrorMsg specifies error details occurred while updating quota notification rule.
+	UpdateQuotaNotificationErrorMsg = "Could not update quota notification rule "
+
+	// DeleteQuotaNotificationErrorMsg specifies error details occurred while deleting quota notification rule.
+	DeleteQuotaNotificationErrorMsg = "Could not delete quota notification rule "
+
+	// CreateQuotaDefaultNotificationErrorMsg specifies error details occurred while creating default quota notification rule.
+	CreateQuotaDefaultNotificationErrorMsg = "Could not create default quota notification rule "
+
+	// ReadQuotaDefaultNotificationErrorMsg specifies error details occurred while reading default quota notification rule.
+	ReadQuotaDefaultNotificationErrorMsg = "Could not read default quota notification rule "
+
+	// UpdateQuotaDefaultNotificationErrorMsg specifies error details occurred while updating default quota notification rule.
+	UpdateQuotaDefaultNotificationErrorMsg = "Could not update default quota notification rule "
+
+	// DeleteQuotaDefaultNotificationErrorMsg specifies error details occurred while deleting default quota notification rule.
+	DeleteQuotaDefaultNotificationErrorMsg = "Could not delete default quota notification rule "
+
+	// ReadQuotaReportSettingsErrorMsg specifies error details occurred while reading quota report settings.
+	ReadQuotaReportSettingsErrorMsg = "Could not read quota report settings "
+
+	// UpdateQuotaReportSettingsErrorMsg specifies error details occurred while updating quota report settings.
+	UpdateQuotaReportSettingsErrorMsg = "Could not update quota report settings "
+
+	// ReadQuotaReportErrorMsg specifies error details occurred while reading quota reports.
+	ReadQuotaReportErrorMsg = "Could not read quota reports "
+
+	// CreateQuotaOverrideErrorMsg specifies error details occurred while creating quota override.
+	CreateQuotaOverrideErrorMsg = "Could not create quota override "
+
+	// ReadQuotaOverrideErrorMsg specifies error details occurred while reading quota override.
+	ReadQuotaOverrideErrorMsg = "Could not read quota override "
+
+	// UpdateQuotaOverrideErrorMsg specifies error details occurred while updating quota override.
+	UpdateQuotaOverrideErrorMsg = "Could not update quota override "
+
+	// DeleteQuotaOverrideErrorMsg specifies error details occurred while deleting quota override.
+	DeleteQuotaOverrideErrorMsg = "Could not delete quota override "
+
+	// CreateStoragepoolNodepoolErrorMsg specifies error details occurred while creating storagepool nodepool.
+	CreateStoragepoolNodepoolErrorMsg = "Could not create storagepool nodepool "
+
+	// ReadStoragepoolNodepoolErrorMsg specifies error details occurred while reading storagepool nodepool.
+	ReadStoragepoolNodepoolErrorMsg = "Could not read storagepool nodepool "
+
+	// UpdateStoragepoolNodepoolErrorMsg specifies error details occurred while updating storagepool nodepool.
+	UpdateStoragepoolNodepoolErrorMsg = "Could not update storagepool nodepool "
+
+	// DeleteStoragepoolNodepoolErrorMsg specifies error details occurred while deleting storagepool nodepool.
+	DeleteStoragepoolNodepoolErrorMsg = "Could not delete storagepool nodepool "
+
+	// CreateStoragepoolCompatibilityErrorMsg specifies error details occurred while creating storagepool compatibility.
+	CreateStoragepoolCompatibilityErrorMsg = "Could not create storagepool compatibility "
+
+	// ReadStoragepoolCompatibilityErrorMsg specifies error details occurred while reading storagepool compatibility.
+	ReadStoragepoolCompatibilityErrorMsg = "Could not read storagepool compatibility "
+
+	// DeleteStoragepoolCompatibilityErrorMsg specifies error details occurred while deleting storagepool compatibility.
+	DeleteStoragepoolCompatibilityErrorMsg = "Could not delete storagepool compatibility "
+
+	// ReadFilePoolPolicyPreviewErrorMsg specifies error details occurred while previewing file pool policy.
+	ReadFilePoolPolicyPreviewErrorMsg = "Could not preview file pool policy "
+
+	// CreateSmartPoolsJobErrorMsg specifies error details occurred while creating SmartPools job.
+	CreateSmartPoolsJobErrorMsg = "Could not create SmartPools job "
+
+	// ReadSmartPoolsJobErrorMsg specifies error details occurred while reading SmartPools job.
+	ReadSmartPoolsJobErrorMsg = "Could not read SmartPools job "
+
+	// ReadDedupeSettingsErrorMsg specifies error details occurred while reading dedupe settings.
+	ReadDedupeSettingsErrorMsg = "Could not read dedupe settings "
+
+	// UpdateDedupeSettingsErrorMsg specifies error details occurred while updating dedupe settings.
+	UpdateDedupeSettingsErrorMsg = "Could not update dedupe settings "
+
+	// CreateDedupeJobErrorMsg specifies error details occurred while starting dedupe job.
+	CreateDedupeJobErrorMsg = "Could not start dedupe job "
+
+	// ReadDedupeReportErrorMsg specifies error details occurred while reading dedupe reports.
+	ReadDedupeReportErrorMsg = "Could not read dedupe reports "
+
+	// ReadDedupeSummaryErrorMsg specifies error details occurred while reading dedupe summary.
+	ReadDedupeSummaryErrorMsg = "Could not read dedupe summary "
+
+	// ReadDataReductionStatisticsErrorMsg specifies error details occurred while reading data reduction statistics.
+	ReadDataReductionStatisticsErrorMsg = "Could not read data reduction statistics "
+
+	// ReadStoragePoolsErrorMsg specifies error details occurred while reading storage pools.
+	ReadStoragePoolsErrorMsg = "Could not read storage pools "
 )
@@ -33,6 +33,22 @@ func AdsProviderDetailMapper(ctx context.Context, adsProvider *powerscale.V14Pro
 	return model, err
 }
 
+// GetAllAdsProviders retrieve the list of Ads Providers.
+func GetAllAdsProviders(ctx context.Context, client *client.Client, scope string) (*powerscale.V14ProvidersAds, error) {
+	queryParam := client.PscaleOpenAPIClient.AuthApi.ListAuthv14ProvidersAds(ctx)
+	if scope != "" {
+		queryParam = queryParam.Scope(scope)
+	}
+	result, _, err := queryParam.Execute()
+	return result, err
+}
+
+// GetAdsProviderControllers retrieve the domain controllers an Ads Provider is connected to.
+func GetAdsProviderControllers(ctx context.Context, client *client.Client, adsID string) (*powerscale.V1ProvidersAdsIdControllers, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.GetAuthv1ProvidersAdsIdControllers(ctx, adsID).Execute()
+	return result, err
+}
+
 // CreateAdsProvider Create an Ads Provider.
 func CreateAdsProvider(ctx context.Context, client *client.Client, ads powerscale.V14ProvidersAdsItem) (*powerscale.CreateResponse, error) {
 	adsID, _, err := client.PscaleOpenAPIClient.AuthApi.CreateAuthv14ProvidersAdsItem(ctx).V14ProvidersAdsItem(ads).Execute()
@@ -0,0 +1,270 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// AuthMappingItemType returns the object type of an identity mapping entry.
+func AuthMappingItemType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"source":  types.StringType,
+		"target":  types.StringType,
+		"two_way": types.BoolType,
+		"on_disk": types.BoolType,
+	}
+}
+
+// GetAuthMappingIdentity returns the identity mappings of a source persona in specific zone.
+func GetAuthMappingIdentity(ctx context.Context, client *client.Client, source, zone string) (*powerscale.V1MappingIdentities, error) {
+	getParam := client.PscaleOpenAPIClient.AuthApi.GetAuthv1MappingIdentity(ctx, source).Nocreate(true)
+	if zone != "" {
+		getParam = getParam.Zone(zone)
+	}
+	result, _, err := getParam.Execute()
+	if err != nil {
+		errStr := constants.ReadAuthMappingErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting identity mapping - %s : %s", source, message)
+	}
+	return result, nil
+}
+
+// CreateAuthMapping creates an identity mapping from source to target in specific zone.
+// An existing mapping of the source persona is never replaced, PowerScale returns an error instead.
+func CreateAuthMapping(ctx context.Context, client *client.Client, item models.AuthMappingItemModel, zone string) error {
+	body := powerscale.V1MappingIdentity{
+		Var2way: item.TwoWay.ValueBoolPointer(),
+		Replace: New(false),
+		Source: powerscale.V1AuthAccessAccessItemFileGroup{
+			Id: item.Source.ValueStringPointer(),
+		},
+		Target: &powerscale.V1MappingIdentityTarget{
+			Id:     item.Target.ValueString(),
+			OnDisk: item.OnDisk.ValueBoolPointer(),
+		},
+	}
+	createParam := client.PscaleOpenAPIClient.AuthApi.CreateAuthv1MappingIdentity(ctx).V1MappingIdentity(body)
+	if zone != "" {
+		createParam = createParam.Zone(zone)
+	}
+	if _, err := createParam.Execute(); err != nil {
+		errStr := constants.CreateAuthMappingErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error creating identity mapping - %s to %s : %s", item.Source.ValueString(), item.Target.ValueString(), message)
+	}
+	return nil
+}
+
+// DeleteAuthMapping deletes an identity mapping from source to target in specific zone.
+func DeleteAuthMapping(ctx context.Context, client *client.Client, item models.AuthMappingItemModel, zone string) error {
+	deleteParam := client.PscaleOpenAPIClient.AuthApi.DeleteAuthv1MappingIdentity(ctx, item.Source.ValueString()).
+		Target(item.Target.ValueString()).
+		Var2way(item.TwoWay.ValueBool())
+	if zone != "" {
+		deleteParam = deleteParam.Zone(zone)
+	}
+	if _, err := deleteParam.Execute(); err != nil {
+		errStr := constants.DeleteAuthMappingErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error deleting identity mapping - %s to %s : %s", item.Source.ValueString(), item.Target.ValueString(), message)
+	}
+	return nil
+}
+
+// UpdateAuthMappings adds and removes identity mappings according to the changes between state and plan.
+func UpdateAuthMappings(ctx context.Context, client *client.Client, state *models.AuthMappingResourceModel, plan *models.AuthMappingResourceModel) (diags diag.Diagnostics) {
+	toAdd, toRemove := GetElementsChanges(state.Mappings.Elements(), plan.Mappings.Elements())
+
+	// remove the obsolete mappings firstly, so that a changed target of the same source can be applied.
+	for _, i := range toRemove {
+		var item models.AuthMappingItemModel
+		if objDiags := i.(types.Object).As(ctx, &item, basetypes.ObjectAsOptions{}); objDiags.HasError() {
+			diags.Append(objDiags...)
+			return
+		}
+		if err := DeleteAuthMapping(ctx, client, item, state.Zone.ValueString()); err != nil {
+			diags.AddError(fmt.Sprintf("Error removing identity mapping - %s", item.Source.ValueString()), err.Error())
+		}
+	}
+
+	for _, i := range toAdd {
+		var item models.AuthMappingItemModel
+		if objDiags := i.(types.Object).As(ctx, &item, basetypes.ObjectAsOptions{}); objDiags.HasError() {
+			diags.Append(objDiags...)
+			return
+		}
+		if err := CreateAuthMapping(ctx, client, item, plan.Zone.ValueString()); err != nil {
+			diags.AddError(fmt.Sprintf("Error adding identity mapping - %s", item.Source.ValueString()), err.Error())
+		}
+	}
+	return
+}
+
+// UpdateAuthMappingResourceState refreshes the managed identity mappings from PowerScale.
+// Mappings which no longer exist on PowerScale are dropped from the state, so that they are recreated on next apply.
+func UpdateAuthMappingResourceState(ctx context.Context, client *client.Client, model *models.AuthMappingResourceModel) (diags diag.Diagnostics) {
+	var items []models.AuthMappingItemModel
+	if diags = model.Mappings.ElementsAs(ctx, &items, false); diags.HasError() {
+		return
+	}
+
+	var mappingAttrs []attr.Value
+	for _, item := range items {
+		identities, err := GetAuthMappingIdentity(ctx, client, item.Source.ValueString(), model.Zone.ValueString())
+		if err != nil {
+			diags.AddError("Error reading identity mapping", err.Error())
+			return
+		}
+
+		target := findAuthMappingTarget(identities, item.Target.ValueString())
+		if target == nil {
+			continue
+		}
+		if target.OnDisk != nil {
+			item.OnDisk = types.BoolValue(*target.OnDisk)
+		}
+
+		itemObject, objDiags := types.ObjectValueFrom(ctx, AuthMappingItemType(), item)
+		if objDiags.HasError() {
+			diags.Append(objDiags...)
+			return
+		}
+		mappingAttrs = append(mappingAttrs, itemObject)
+	}
+
+	model.Mappings, diags = types.SetValue(types.ObjectType{AttrTypes: AuthMappingItemType()}, mappingAttrs)
+	if diags.HasError() {
+		return
+	}
+
+	model.ID = types.StringValue("System")
+	if model.Zone.ValueString() != "" {
+		model.ID = types.StringValue(model.Zone.ValueString())
+	}
+	return
+}
+
+// findAuthMappingTarget returns the mapping target matching the given target persona ID.
+func findAuthMappingTarget(identities *powerscale.V1MappingIdentities, targetID string) *powerscale.V1MappingIdentitiesIdentityTarget {
+	if identities == nil {
+		return nil
+	}
+	for _, identity := range identities.Identities {
+		for _, target := range identity.Targets {
+			if target.Target.Id != nil && strings.EqualFold(*target.Target.Id, targetID) {
+				matched := target
+				return &matched
+			}
+		}
+	}
+	return nil
+}
+
+// AuthMappingDetailMapper maps the identity mappings of a source persona to the datasource model.
+func AuthMappingDetailMapper(identity powerscale.V1MappingIdentitiesIdentity) models.AuthMappingDetailModel {
+	detail := models.AuthMappingDetailModel{
+		Source:     types.StringPointerValue(identity.Source.Id),
+		SourceName: types.StringPointerValue(identity.Source.Name),
+		SourceType: types.StringPointerValue(identity.Source.Type),
+		Targets:    []models.AuthMappingTargetModel{},
+	}
+	for _, target := range identity.Targets {
+		detail.Targets = append(detail.Targets, models.AuthMappingTargetModel{
+			ID:          types.StringPointerValue(target.Target.Id),
+			Name:        types.StringPointerValue(target.Target.Name),
+			Type:        types.StringPointerValue(target.Target.Type),
+			OnDisk:      types.BoolPointerValue(target.OnDisk),
+			MappingType: types.StringPointerValue(target.Type),
+		})
+	}
+	return detail
+}
+
+// ImportAuthMappings imports identity mappings from a file on the cluster.
+func ImportAuthMappings(ctx context.Context, client *client.Client, plan models.AuthMappingActionResourceModel) error {
+	body := powerscale.V1MappingImport{
+		File:    plan.File.ValueString(),
+		Replace: plan.Replace.ValueBoolPointer(),
+	}
+	importParam := client.PscaleOpenAPIClient.AuthApi.CreateAuthv1MappingImport(ctx).V1MappingImport(body)
+	if !plan.Zone.IsNull() {
+		importParam = importParam.Zone(plan.Zone.ValueString())
+	}
+	if _, err := importParam.Execute(); err != nil {
+		errStr := constants.ImportAuthMappingErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error importing identity mappings from %s : %s", plan.File.ValueString(), message)
+	}
+	return nil
+}
+
+// FlushAuthMappings flushes the identity mappings of a zone.
+func FlushAuthMappings(ctx context.Context, client *client.Client, plan models.AuthMappingActionResourceModel) error {
+	flushParam := client.PscaleOpenAPIClient.AuthApi.DeleteAuthv1MappingIdentities(ctx)
+	if !plan.Zone.IsNull() {
+		flushParam = flushParam.Zone(plan.Zone.ValueString())
+	}
+	if !plan.Filter.IsNull() {
+		flushParam = flushParam.Filter(plan.Filter.ValueString())
+	}
+	if !plan.Remove.IsNull() {
+		flushParam = flushParam.Remove(plan.Remove.ValueBool())
+	}
+	if _, err := flushParam.Execute(); err != nil {
+		errStr := constants.FlushAuthMappingErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error flushing identity mappings: %s", message)
+	}
+	return nil
+}
+
+// ManageAuthMappingAction performs the import or flush action of identity mappings.
+func ManageAuthMappingAction(ctx context.Context, client *client.Client, plan models.AuthMappingActionResourceModel) (state models.AuthMappingActionResourceModel, diags diag.Diagnostics) {
+	state = plan
+	switch plan.Action.ValueString() {
+	case "import":
+		if plan.File.ValueString() == "" {
+			diags.AddError("Error importing identity mappings", "file is required when action is import")
+			return
+		}
+		if err := ImportAuthMappings(ctx, client, plan); err != nil {
+			diags.AddError("Error importing identity mappings", err.Error())
+			return
+		}
+	case "flush":
+		if err := FlushAuthMappings(ctx, client, plan); err != nil {
+			diags.AddError("Error flushing identity mappings", err.Error())
+			return
+		}
+	}
+	state.ID = types.StringValue("auth_mapping_action")
+	return
+}
@@ -0,0 +1,221 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// authProviderTypePrefixes maps the provider types to the prefixes used by access zones to reference the providers.
+var authProviderTypePrefixes = map[string]string{
+	"ads":   "lsa-activedirectory-provider",
+	"ldap":  "lsa-ldap-provider",
+	"nis":   "lsa-nis-provider",
+	"file":  "lsa-file-provider",
+	"local": "lsa-local-provider",
+}
+
+// newAuthProviderStatus builds the status model of a provider.
+// File and local providers are served by the cluster itself, so they are considered online unless reported otherwise.
+func newAuthProviderStatus(providerType, name, zoneName, status string, servers []string) models.AuthProviderStatusDetailModel {
+	online := strings.EqualFold(status, "online")
+	if status == "" && (providerType == "file" || providerType == "local") {
+		online = true
+	}
+	serverList := make([]types.String, 0, len(servers))
+	for _, server := range servers {
+		serverList = append(serverList, types.StringValue(server))
+	}
+	return models.AuthProviderStatusDetailModel{
+		ID:        types.StringValue(authProviderTypePrefixes[providerType] + ":" + name),
+		Name:      types.StringValue(name),
+		Type:      types.StringValue(providerType),
+		ZoneName:  types.StringValue(zoneName),
+		Status:    types.StringValue(status),
+		Online:    types.BoolValue(online),
+		Servers:   serverList,
+		LastError: types.StringNull(),
+	}
+}
+
+// GetAdsProvidersStatus returns the status of all Ads Providers, including the domain controllers they are connected to.
+// A failure while querying the domain controllers of a provider is reported as its last error.
+func GetAdsProvidersStatus(ctx context.Context, client *client.Client) ([]models.AuthProviderStatusDetailModel, error) {
+	result, err := GetAllAdsProviders(ctx, client, "")
+	if err != nil {
+		errStr := constants.ReadAdsProviderErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting the list of ads providers: %s", message)
+	}
+
+	providers := []models.AuthProviderStatusDetailModel{}
+	for _, ads := range result.Ads {
+		var controllers []string
+		controllerResp, err := GetAdsProviderControllers(ctx, client, ads.GetId())
+		if err == nil {
+			for _, controller := range controllerResp.Controllers {
+				controllers = append(controllers, controller.GetName())
+			}
+		}
+		provider := newAuthProviderStatus("ads", ads.GetName(), ads.GetZoneName(), ads.GetStatus(), controllers)
+		if err != nil {
+			provider.LastError = types.StringValue(GetErrorString(err, "error getting the domain controllers: "))
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
+
+// GetLdapProvidersStatus returns the status of all Ldap Providers, including the servers they are configured with.
+func GetLdapProvidersStatus(ctx context.Context, client *client.Client) ([]models.AuthProviderStatusDetailModel, error) {
+	result, err := GetAllLdapProvidersWithFilter(ctx, client, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []models.AuthProviderStatusDetailModel{}
+	switch v := result.(type) {
+	case *powerscale.V16ProvidersLdap:
+		for _, ldap := range v.GetLdap() {
+			providers = append(providers, newAuthProviderStatus("ldap", ldap.GetName(), ldap.GetZoneName(), ldap.GetStatus(), ldap.GetServerUris()))
+		}
+	case *powerscale.V11ProvidersLdap:
+		for _, ldap := range v.GetLdap() {
+			providers = append(providers, newAuthProviderStatus("ldap", ldap.GetName(), ldap.GetZoneName(), ldap.GetStatus(), ldap.GetServerUris()))
+		}
+	default:
+		return nil, fmt.Errorf("error getting the list of ldap providers - Unexpected type: %T", v)
+	}
+	return providers, nil
+}
+
+// GetNisProvidersStatus returns the status of all Nis Providers, including the servers they are configured with.
+func GetNisProvidersStatus(ctx context.Context, client *client.Client) ([]models.AuthProviderStatusDetailModel, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.ListAuthv11ProvidersNis(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadAuthProviderStatusErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting the list of nis providers: %s", message)
+	}
+
+	providers := []models.AuthProviderStatusDetailModel{}
+	for _, nis := range result.Nis {
+		providers = append(providers, newAuthProviderStatus("nis", nis.GetName(), nis.GetZoneName(), nis.GetStatus(), nis.GetServers()))
+	}
+	return providers, nil
+}
+
+// GetFileProvidersStatus returns the status of all File Providers.
+func GetFileProvidersStatus(ctx context.Context, client *client.Client) ([]models.AuthProviderStatusDetailModel, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.ListAuthv1ProvidersFile(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadAuthProviderStatusErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting the list of file providers: %s", message)
+	}
+
+	providers := []models.AuthProviderStatusDetailModel{}
+	for _, file := range result.File {
+		providers = append(providers, newAuthProviderStatus("file", file.GetName(), file.GetZoneName(), file.GetStatus(), nil))
+	}
+	return providers, nil
+}
+
+// GetLocalProvidersStatus returns the status of all Local Providers.
+func GetLocalProvidersStatus(ctx context.Context, client *client.Client) ([]models.AuthProviderStatusDetailModel, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.ListAuthv1ProvidersLocal(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadAuthProviderStatusErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting the list of local providers: %s", message)
+	}
+
+	providers := []models.AuthProviderStatusDetailModel{}
+	for _, local := range result.Local {
+		providers = append(providers, newAuthProviderStatus("local", local.GetName(), local.GetZoneName(), local.GetStatus(), nil))
+	}
+	return providers, nil
+}
+
+// GetAuthProvidersStatus returns the status of the authentication providers of the given types.
+// All provider types are listed if no type is given.
+func GetAuthProvidersStatus(ctx context.Context, client *client.Client, providerTypes []string) ([]models.AuthProviderStatusDetailModel, error) {
+	listFuncs := []struct {
+		providerType string
+		list         func(context.Context, *client.Client) ([]models.AuthProviderStatusDetailModel, error)
+	}{
+		{"ads", GetAdsProvidersStatus},
+		{"ldap", GetLdapProvidersStatus},
+		{"nis", GetNisProvidersStatus},
+		{"file", GetFileProvidersStatus},
+		{"local", GetLocalProvidersStatus},
+	}
+
+	providers := []models.AuthProviderStatusDetailModel{}
+	for _, listFunc := range listFuncs {
+		if len(providerTypes) > 0 && !slices.Contains(providerTypes, listFunc.providerType) {
+			continue
+		}
+		result, err := listFunc.list(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, result...)
+	}
+	return providers, nil
+}
+
+// GetAccessZoneAuthProviders returns the IDs of the authentication providers of an access zone.
+func GetAccessZoneAuthProviders(ctx context.Context, client *client.Client, zoneName string) ([]string, error) {
+	zones, err := GetAllAccessZones(ctx, client)
+	if err != nil {
+		errStr := constants.ReadAccessZoneErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting the list of access zones: %s", message)
+	}
+	for _, zone := range zones.Zones {
+		if zone.GetName() == zoneName {
+			return zone.AuthProviders, nil
+		}
+	}
+	return nil, fmt.Errorf("access zone %s not found", zoneName)
+}
+
+// FilterAuthProvidersStatus filters the providers by names and by the provider IDs of an access zone.
+func FilterAuthProvidersStatus(providers []models.AuthProviderStatusDetailModel, names []string, zoneProviders []string) []models.AuthProviderStatusDetailModel {
+	filtered := []models.AuthProviderStatusDetailModel{}
+	for _, provider := range providers {
+		if len(names) > 0 && !slices.Contains(names, provider.Name.ValueString()) {
+			continue
+		}
+		if zoneProviders != nil && !slices.Contains(zoneProviders, provider.ID.ValueString()) {
+			continue
+		}
+		filtered = append(filtered, provider)
+	}
+	return filtered
+}
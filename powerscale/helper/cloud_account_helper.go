@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetCloudAccount retrieves the cloud account by ID or name.
+func GetCloudAccount(ctx context.Context, client *client.Client, id string) (*powerscale.V4CloudAccountExtended, error) {
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.GetCloudv4CloudAccount(ctx, id).Execute()
+	if err != nil {
+		errStr := constants.ReadCloudAccountErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting cloud account %s : %s", id, message)
+	}
+	if len(result.Accounts) == 0 {
+		return nil, fmt.Errorf("error getting cloud account %s : cloud account not found", id)
+	}
+	return &result.Accounts[0], nil
+}
+
+// ListCloudAccounts retrieves all the cloud accounts.
+func ListCloudAccounts(ctx context.Context, client *client.Client) ([]powerscale.V4CloudAccountExtended, error) {
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.ListCloudv4CloudAccounts(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadCloudAccountErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting cloud accounts : %s", message)
+	}
+	items := result.Accounts
+	for result.Resume != nil && *result.Resume != "" {
+		result, _, err = client.PscaleOpenAPIClient.CloudApi.ListCloudv4CloudAccounts(ctx).Resume(*result.Resume).Execute()
+		if err != nil {
+			errStr := constants.ReadCloudAccountErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return nil, fmt.Errorf("error getting cloud accounts : %s", message)
+		}
+		items = append(items, result.Accounts...)
+	}
+	return items, nil
+}
+
+// CreateCloudAccount creates the cloud account and returns its ID.
+func CreateCloudAccount(ctx context.Context, client *client.Client, plan models.CloudAccountResourceModel) (string, error) {
+	var createBody powerscale.V4CloudAccount
+	if err := ReadFromState(ctx, &plan, &createBody); err != nil {
+		errStr := constants.CreateCloudAccountErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error reading cloud account plan : %s", message)
+	}
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.CreateCloudv4CloudAccount(ctx).V4CloudAccount(createBody).Execute()
+	if err != nil {
+		errStr := constants.CreateCloudAccountErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error creating cloud account %s : %s", plan.Name.ValueString(), message)
+	}
+	return result.Id, nil
+}
+
+// UpdateCloudAccount updates the cloud account.
+func UpdateCloudAccount(ctx context.Context, client *client.Client, state, plan models.CloudAccountResourceModel) error {
+	var updateBody powerscale.V4CloudAccountExtendedExtended
+	if err := ReadFromState(ctx, &plan, &updateBody); err != nil {
+		errStr := constants.UpdateCloudAccountErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error reading cloud account plan : %s", message)
+	}
+	_, err := client.PscaleOpenAPIClient.CloudApi.UpdateCloudv4CloudAccount(ctx, state.ID.ValueString()).V4CloudAccount(updateBody).Execute()
+	if err != nil {
+		errStr := constants.UpdateCloudAccountErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error updating cloud account %s : %s", state.ID.ValueString(), message)
+	}
+	return nil
+}
+
+// DeleteCloudAccount deletes the cloud account.
+func DeleteCloudAccount(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.CloudApi.DeleteCloudv4CloudAccount(ctx, id).Execute()
+	if err != nil {
+		errStr := constants.DeleteCloudAccountErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error deleting cloud account %s : %s", id, message)
+	}
+	return nil
+}
+
+// UpdateCloudAccountState updates the resource state from the cloud account.
+// The key is write-only, so it is never kept in the state.
+func UpdateCloudAccountState(ctx context.Context, state *models.CloudAccountResourceModel, account *powerscale.V4CloudAccountExtended) error {
+	if err := CopyFieldsToNonNestedModel(ctx, account, state); err != nil {
+		return err
+	}
+	state.Key = types.StringNull()
+	return nil
+}
+
+// CloudAccountDetailMapper maps the cloud account to the datasource model.
+//
+//go:noinline
+func CloudAccountDetailMapper(ctx context.Context, account *powerscale.V4CloudAccountExtended) (models.CloudAccountDetailModel, error) {
+	model := models.CloudAccountDetailModel{}
+	err := CopyFields(ctx, account, &model)
+	return model, err
+}
+
+// ManageDataSourceCloudAccount gets the cloud accounts matching the filter and sets the state.
+func ManageDataSourceCloudAccount(ctx context.Context, client *client.Client, state *models.CloudAccountDataSourceModel) (diags diag.Diagnostics) {
+	var items []powerscale.V4CloudAccountExtended
+	if state.Filter != nil && len(state.Filter.Names) > 0 {
+		for _, name := range state.Filter.Names {
+			item, err := GetCloudAccount(ctx, client, name.ValueString())
+			if err != nil {
+				diags.AddError("Error getting the cloud account", err.Error())
+				return
+			}
+			items = append(items, *item)
+		}
+	} else {
+		var err error
+		items, err = ListCloudAccounts(ctx, client)
+		if err != nil {
+			diags.AddError("Error getting the cloud accounts", err.Error())
+			return
+		}
+	}
+
+	state.CloudAccounts = []models.CloudAccountDetailModel{}
+	for i := range items {
+		detail, err := CloudAccountDetailMapper(ctx, &items[i])
+		if err != nil {
+			errStr := constants.ReadCloudAccountErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			diags.AddError("Error mapping the cloud accounts", message)
+			return
+		}
+		state.CloudAccounts = append(state.CloudAccounts, detail)
+	}
+	state.ID = types.StringValue("cloud_account_datasource")
+	return
+}
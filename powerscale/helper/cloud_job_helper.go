@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateCloudJob starts the cloud archive or recall job and returns its ID.
+func CreateCloudJob(ctx context.Context, client *client.Client, plan models.CloudJobResourceModel) (string, error) {
+	var createBody powerscale.V3CloudJob
+	if err := ReadFromState(ctx, &plan, &createBody); err != nil {
+		errStr := constants.CreateCloudJobErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error reading cloud job plan : %s", message)
+	}
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.CreateCloudv3CloudJob(ctx).V3CloudJob(createBody).Execute()
+	if err != nil {
+		errStr := constants.CreateCloudJobErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error creating cloud %s job : %s", plan.Type.ValueString(), message)
+	}
+	return fmt.Sprintf("%v", result.Id), nil
+}
+
+// GetCloudJob retrieves the cloud job by ID.
+func GetCloudJob(ctx context.Context, client *client.Client, id string) (*powerscale.V3CloudJobExtended, error) {
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.GetCloudv3CloudJob(ctx, id).Execute()
+	if err != nil {
+		errStr := constants.ReadCloudJobErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting cloud job %s : %s", id, message)
+	}
+	if len(result.Jobs) == 0 {
+		return nil, fmt.Errorf("error getting cloud job %s : cloud job not found", id)
+	}
+	return &result.Jobs[0], nil
+}
+
+// cloudJobFailedStates lists the job states after which a cloud job no longer runs without completing.
+var cloudJobFailedStates = map[string]bool{
+	"cancelled": true,
+	"failed":    true,
+	"error":     true,
+	"paused":    true,
+}
+
+// IsCloudJobFailed reports whether a cloud job stopped without completing.
+func IsCloudJobFailed(job *powerscale.V3CloudJobExtended) bool {
+	return cloudJobFailedStates[job.GetState()] || cloudJobFailedStates[job.GetEffectiveState()]
+}
+
+// WaitForCloudJob polls the cloud job until it is completed and returns its last state.
+// A job which fails, is cancelled or paused stops the wait with the details of the job.
+func WaitForCloudJob(ctx context.Context, client *client.Client, id string, interval, timeout time.Duration) (string, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		job, err := GetCloudJob(ctx, client, id)
+		if err != nil {
+			return "", err
+		}
+		if job.GetState() == "completed" {
+			return job.GetState(), nil
+		}
+		if IsCloudJobFailed(job) {
+			files := job.GetFiles()
+			return job.GetState(), fmt.Errorf("cloud job %s ended in state %s (effective state %s), %d files failed",
+				id, job.GetState(), job.GetEffectiveState(), files.GetTotalFailed())
+		}
+		if time.Now().After(deadline) {
+			return job.GetState(), fmt.Errorf("cloud job %s is still %s after %s", id, job.GetState(), timeout)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// ManageCloudJob starts the cloud job and optionally waits for it to complete.
+func ManageCloudJob(ctx context.Context, client *client.Client, plan models.CloudJobResourceModel) (state models.CloudJobResourceModel, diags diag.Diagnostics) {
+	state = plan
+	jobID, err := CreateCloudJob(ctx, client, plan)
+	if err != nil {
+		diags.AddError("Error creating the cloud job", err.Error())
+		return
+	}
+	state.ID = types.StringValue(jobID)
+
+	if !plan.WaitForCompletion.ValueBool() {
+		job, err := GetCloudJob(ctx, client, jobID)
+		if err != nil {
+			diags.AddError("Error getting the cloud job", err.Error())
+			return
+		}
+		state.State = types.StringValue(job.GetState())
+		return
+	}
+
+	interval := time.Duration(plan.PollInterval.ValueInt64()) * time.Second
+	timeout := time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	jobState, err := WaitForCloudJob(ctx, client, jobID, interval, timeout)
+	if err != nil {
+		diags.AddError("Error waiting for the cloud job to complete", err.Error())
+		return
+	}
+	state.State = types.StringValue(jobState)
+	return
+}
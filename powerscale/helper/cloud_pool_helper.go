@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetCloudPool retrieves the cloud pool by ID or name.
+func GetCloudPool(ctx context.Context, client *client.Client, id string) (*powerscale.V4CloudPoolExtended, error) {
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.GetCloudv4CloudPool(ctx, id).Execute()
+	if err != nil {
+		errStr := constants.ReadCloudPoolErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting cloud pool %s : %s", id, message)
+	}
+	if len(result.Pools) == 0 {
+		return nil, fmt.Errorf("error getting cloud pool %s : cloud pool not found", id)
+	}
+	return &result.Pools[0], nil
+}
+
+// ListCloudPools retrieves all the cloud pools.
+func ListCloudPools(ctx context.Context, client *client.Client) ([]powerscale.V4CloudPoolExtended, error) {
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.ListCloudv4CloudPools(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadCloudPoolErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting cloud pools : %s", message)
+	}
+	items := result.Pools
+	for result.Resume != nil && *result.Resume != "" {
+		result, _, err = client.PscaleOpenAPIClient.CloudApi.ListCloudv4CloudPools(ctx).Resume(*result.Resume).Execute()
+		if err != nil {
+			errStr := constants.ReadCloudPoolErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return nil, fmt.Errorf("error getting cloud pools : %s", message)
+		}
+		items = append(items, result.Pools...)
+	}
+	return items, nil
+}
+
+// CreateCloudPool creates the cloud pool and returns its ID.
+func CreateCloudPool(ctx context.Context, client *client.Client, plan models.CloudPoolResourceModel) (string, error) {
+	var createBody powerscale.V4CloudPool
+	if err := ReadFromState(ctx, &plan, &createBody); err != nil {
+		errStr := constants.CreateCloudPoolErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error reading cloud pool plan : %s", message)
+	}
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.CreateCloudv4CloudPool(ctx).V4CloudPool(createBody).Execute()
+	if err != nil {
+		errStr := constants.CreateCloudPoolErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error creating cloud pool %s : %s", plan.Name.ValueString(), message)
+	}
+	return result.Id, nil
+}
+
+// UpdateCloudPool updates the cloud pool.
+func UpdateCloudPool(ctx context.Context, client *client.Client, state, plan models.CloudPoolResourceModel) error {
+	var updateBody powerscale.V4CloudPoolExtendedExtended
+	if err := ReadFromState(ctx, &plan, &updateBody); err != nil {
+		errStr := constants.UpdateCloudPoolErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error reading cloud pool plan : %s", message)
+	}
+	_, err := client.PscaleOpenAPIClient.CloudApi.UpdateCloudv4CloudPool(ctx, state.ID.ValueString()).V4CloudPool(updateBody).Execute()
+	if err != nil {
+		errStr := constants.UpdateCloudPoolErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error updating cloud pool %s : %s", state.ID.ValueString(), message)
+	}
+	return nil
+}
+
+// DeleteCloudPool deletes the cloud pool.
+func DeleteCloudPool(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.CloudApi.DeleteCloudv4CloudPool(ctx, id).Execute()
+	if err != nil {
+		errStr := constants.DeleteCloudPoolErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error deleting cloud pool %s : %s", id, message)
+	}
+	return nil
+}
+
+// UpdateCloudPoolState updates the resource state from the cloud pool.
+func UpdateCloudPoolState(ctx context.Context, state *models.CloudPoolResourceModel, pool *powerscale.V4CloudPoolExtended) error {
+	return CopyFieldsToNonNestedModel(ctx, pool, state)
+}
+
+// CloudPoolDetailMapper maps the cloud pool to the datasource model.
+//
+//go:noinline
+func CloudPoolDetailMapper(ctx context.Context, pool *powerscale.V4CloudPoolExtended) (models.CloudPoolDetailModel, error) {
+	model := models.CloudPoolDetailModel{}
+	err := CopyFields(ctx, pool, &model)
+	return model, err
+}
+
+// ManageDataSourceCloudPool gets the cloud pools matching the filter and sets the state.
+func ManageDataSourceCloudPool(ctx context.Context, client *client.Client, state *models.CloudPoolDataSourceModel) (diags diag.Diagnostics) {
+	var items []powerscale.V4CloudPoolExtended
+	if state.Filter != nil && len(state.Filter.Names) > 0 {
+		for _, name := range state.Filter.Names {
+			item, err := GetCloudPool(ctx, client, name.ValueString())
+			if err != nil {
+				diags.AddError("Error getting the cloud pool", err.Error())
+				return
+			}
+			items = append(items, *item)
+		}
+	} else {
+		var err error
+		items, err = ListCloudPools(ctx, client)
+		if err != nil {
+			diags.AddError("Error getting the cloud pools", err.Error())
+			return
+		}
+	}
+
+	state.CloudPools = []models.CloudPoolDetailModel{}
+	for i := range items {
+		detail, err := CloudPoolDetailMapper(ctx, &items[i])
+		if err != nil {
+			errStr := constants.ReadCloudPoolErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			diags.AddError("Error mapping the cloud pools", message)
+			return
+		}
+		state.CloudPools = append(state.CloudPools, detail)
+	}
+	state.ID = types.StringValue("cloud_pool_datasource")
+	return
+}
@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"terraform-provider-powerscale/client"
+)
+
+// GetCloudSettings retrieve cloud settings.
+func GetCloudSettings(ctx context.Context, client *client.Client) (*powerscale.V4CloudSettings, error) {
+	cloudSettings, _, err := client.PscaleOpenAPIClient.CloudApi.GetCloudv4CloudSettings(ctx).Execute()
+	return cloudSettings, err
+}
+
+// UpdateCloudSettings update cloud settings.
+func UpdateCloudSettings(ctx context.Context, client *client.Client, v4CloudSettings powerscale.V4CloudSettingsExtended) error {
+	_, err := client.PscaleOpenAPIClient.CloudApi.UpdateCloudv4CloudSettings(ctx).V4CloudSettings(v4CloudSettings).Execute()
+	return err
+}
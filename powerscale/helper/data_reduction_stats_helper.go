@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DataReductionStatisticsDefaultKeys are the statistics keys queried when none are configured.
+var DataReductionStatisticsDefaultKeys = []string{
+	"cluster.dedupe.logical.deduplicated.bytes",
+	"cluster.dedupe.logical.saved.bytes",
+	"cluster.dedupe.estimated.deduplicated.bytes",
+	"cluster.dedupe.estimated.saved.bytes",
+	"ifs.bytes.total",
+	"ifs.bytes.used",
+	"ifs.bytes.avail",
+}
+
+// DataReductionStatisticsKeyPrefixes are the prefixes of the compression and data reduction keys, which are
+// queried by default along with DataReductionStatisticsDefaultKeys when the array knows them.
+var DataReductionStatisticsKeyPrefixes = []string{
+	"cluster.compression.",
+	"cluster.data.reduce.",
+}
+
+// ListStatisticsKeys retrieves the names of the statistics keys known by the array.
+func ListStatisticsKeys(ctx context.Context, client *client.Client) ([]string, error) {
+	result, _, err := client.PscaleOpenAPIClient.StatisticsApi.GetStatisticsv1StatisticsKeys(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadDataReductionStatisticsErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting statistics keys : %s", message)
+	}
+	keys := []string{}
+	for _, key := range result.Keys {
+		keys = append(keys, key.GetKey())
+	}
+	for result.Resume != nil && *result.Resume != "" {
+		result, _, err = client.PscaleOpenAPIClient.StatisticsApi.GetStatisticsv1StatisticsKeys(ctx).Resume(*result.Resume).Execute()
+		if err != nil {
+			errStr := constants.ReadDataReductionStatisticsErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return nil, fmt.Errorf("error getting statistics keys : %s", message)
+		}
+		for _, key := range result.Keys {
+			keys = append(keys, key.GetKey())
+		}
+	}
+	return keys, nil
+}
+
+// getDataReductionStatisticsDefaultKeys returns the default keys, along with the compression and data reduction keys
+// of the array. The names of these keys vary with the OneFS version, so they are looked up rather than hardcoded.
+func getDataReductionStatisticsDefaultKeys(knownKeys []string) []string {
+	keys := append([]string{}, DataReductionStatisticsDefaultKeys...)
+	for _, key := range knownKeys {
+		for _, prefix := range DataReductionStatisticsKeyPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				keys = append(keys, key)
+				break
+			}
+		}
+	}
+	return keys
+}
+
+// GetDataReductionStatistics retrieves the current value of the statistics keys.
+func GetDataReductionStatistics(ctx context.Context, client *client.Client, keys []string) ([]powerscale.V1StatisticsCurrentStat, error) {
+	result, _, err := client.PscaleOpenAPIClient.StatisticsApi.GetStatisticsv1StatisticsCurrent(ctx).Keys(keys).Execute()
+	if err != nil {
+		errStr := constants.ReadDataReductionStatisticsErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting statistics : %s", message)
+	}
+	return result.Stats, nil
+}
+
+// GetAllStoragePools retrieves the node pools and tiers with their usage.
+func GetAllStoragePools(ctx context.Context, client *client.Client) ([]powerscale.V16StoragepoolStoragepool, error) {
+	result, _, err := client.PscaleOpenAPIClient.StoragepoolApi.GetStoragepoolv16StoragepoolStoragepools(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadStoragePoolsErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting storage pools : %s", message)
+	}
+	return result.Storagepools, nil
+}
+
+// statisticValueString renders the value of a statistics key, which may be a number, a string or a structure.
+func statisticValueString(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	var str string
+	if err := json.Unmarshal(valueBytes, &str); err == nil {
+		return str, nil
+	}
+	return string(valueBytes), nil
+}
+
+// ManageDataSourceDataReductionStats gets the statistics and the usage of the storage pools matching the filter and sets the state.
+func ManageDataSourceDataReductionStats(ctx context.Context, client *client.Client, state *models.DataReductionStatsDataSourceModel) (diags diag.Diagnostics) {
+	var keys []string
+	if len(state.StatisticsKeys) > 0 {
+		for _, key := range state.StatisticsKeys {
+			keys = append(keys, key.ValueString())
+		}
+	} else {
+		knownKeys, err := ListStatisticsKeys(ctx, client)
+		if err != nil {
+			diags.AddError("Error getting the statistics keys", err.Error())
+			return
+		}
+		keys = getDataReductionStatisticsDefaultKeys(knownKeys)
+	}
+
+	stats, err := GetDataReductionStatistics(ctx, client, keys)
+	if err != nil {
+		diags.AddError("Error getting the data reduction statistics", err.Error())
+		return
+	}
+	state.Statistics = []models.DataReductionStatisticModel{}
+	for _, stat := range stats {
+		value, err := statisticValueString(stat.Value)
+		if err != nil {
+			diags.AddError("Error mapping the data reduction statistics", fmt.Sprintf("unexpected value for key %s: %s", stat.GetKey(), err.Error()))
+			return
+		}
+		statError := types.StringNull()
+		if stat.GetError() != "" {
+			statError = types.StringValue(stat.GetError())
+		}
+		state.Statistics = append(state.Statistics, models.DataReductionStatisticModel{
+			Key:   types.StringValue(stat.GetKey()),
+			Devid: types.Int64Value(int64(stat.GetDevid())),
+			Time:  types.Int64Value(int64(stat.GetTime())),
+			Value: types.StringValue(value),
+			Error: statError,
+		})
+	}
+
+	pools, err := GetAllStoragePools(ctx, client)
+	if err != nil {
+		diags.AddError("Error getting the storage pools", err.Error())
+		return
+	}
+
+	names := map[string]bool{}
+	poolType := ""
+	if state.Filter != nil {
+		for _, name := range state.Filter.Names {
+			names[name.ValueString()] = true
+		}
+		poolType = state.Filter.Type.ValueString()
+	}
+
+	state.StoragePools = []models.DataReductionStoragePoolModel{}
+	for i := range pools {
+		if len(names) > 0 && !names[pools[i].GetName()] {
+			continue
+		}
+		if poolType != "" && pools[i].GetType() != poolType {
+			continue
+		}
+		pool := models.DataReductionStoragePoolModel{
+			Lnns:     types.ListNull(types.Int64Type),
+			Children: types.ListNull(types.StringType),
+		}
+		if err := CopyFields(ctx, &pools[i], &pool); err != nil {
+			diags.AddError("Error mapping the storage pools", err.Error())
+			return
+		}
+		state.StoragePools = append(state.StoragePools, pool)
+	}
+	state.ID = types.StringValue("data_reduction_stats_datasource")
+	return
+}
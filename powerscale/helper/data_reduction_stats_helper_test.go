@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDataReductionStatisticsDefaultKeys(t *testing.T) {
+	keys := getDataReductionStatisticsDefaultKeys([]string{
+		"cluster.compression.overall.ratio",
+		"cluster.cpu.user.avg",
+		"cluster.data.reduce.overall.ratio",
+		"node.compression.overall.ratio",
+	})
+	assert.Equal(t, len(DataReductionStatisticsDefaultKeys)+2, len(keys))
+	assert.Equal(t, DataReductionStatisticsDefaultKeys, keys[:len(DataReductionStatisticsDefaultKeys)])
+	assert.Contains(t, keys, "cluster.compression.overall.ratio")
+	assert.Contains(t, keys, "cluster.data.reduce.overall.ratio")
+	assert.NotContains(t, keys, "cluster.cpu.user.avg")
+
+	// the defaults are not modified
+	keys = getDataReductionStatisticsDefaultKeys(nil)
+	assert.Equal(t, DataReductionStatisticsDefaultKeys, keys)
+}
@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetDedupeSettings retrieve dedupe settings.
+func GetDedupeSettings(ctx context.Context, client *client.Client) (*powerscale.V1DedupeSettings, error) {
+	dedupeSettings, _, err := client.PscaleOpenAPIClient.DedupeApi.GetDedupev1DedupeSettings(ctx).Execute()
+	return dedupeSettings, err
+}
+
+// UpdateDedupeSettings update dedupe settings.
+func UpdateDedupeSettings(ctx context.Context, client *client.Client, v1DedupeSettings powerscale.V1DedupeSettingsExtended) error {
+	_, err := client.PscaleOpenAPIClient.DedupeApi.UpdateDedupev1DedupeSettings(ctx).V1DedupeSettings(v1DedupeSettings).Execute()
+	return err
+}
+
+// StartDedupeJob starts a Dedupe or DedupeAssessment job on the configured paths.
+func StartDedupeJob(ctx context.Context, client *client.Client, jobType string) (int32, error) {
+	response, err := CreateSnapshotRestoreJob(ctx, client, powerscale.V10JobJob{Type: jobType})
+	if err != nil {
+		return 0, err
+	}
+	return response.Id, nil
+}
+
+// ManageDedupeSettings applies the dedupe settings and returns the new state.
+// The requested job is started only when the applied settings or the job type differ from the prior state, which is nil on create.
+// The returned state is nil if the settings could not be applied, otherwise it is returned along with any error of starting the job.
+func ManageDedupeSettings(ctx context.Context, client *client.Client, plan models.DedupeSettingsModel, prior *models.DedupeSettingsModel) (*models.DedupeSettingsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var toUpdate powerscale.V1DedupeSettingsExtended
+	// Get param from tf input
+	if err := ReadFromState(ctx, &plan, &toUpdate); err != nil {
+		errStr := constants.UpdateDedupeSettingsErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError("Error updating dedupe settings", fmt.Sprintf("Could not read dedupe settings param with error: %s", message))
+		return nil, diags
+	}
+
+	if err := UpdateDedupeSettings(ctx, client, toUpdate); err != nil {
+		errStr := constants.UpdateDedupeSettingsErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError("Error updating dedupe settings", message)
+		return nil, diags
+	}
+
+	settings, err := GetDedupeSettings(ctx, client)
+	if err != nil {
+		errStr := constants.ReadDedupeSettingsErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError("Error reading dedupe settings", message)
+		return nil, diags
+	}
+
+	state := plan
+	if err := CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state); err != nil {
+		diags.AddError("Error copying fields of dedupe settings resource", err.Error())
+		return nil, diags
+	}
+	state.ID = types.StringValue("dedupe_settings")
+
+	if state.JobType.IsNull() {
+		state.JobID = types.Int64Null()
+		return &state, diags
+	}
+	if prior != nil && state.JobType.Equal(prior.JobType) && state.Paths.Equal(prior.Paths) && state.AssessPaths.Equal(prior.AssessPaths) {
+		state.JobID = prior.JobID
+		return &state, diags
+	}
+
+	jobID, err := StartDedupeJob(ctx, client, state.JobType.ValueString())
+	if err != nil {
+		errStr := constants.CreateDedupeJobErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError(fmt.Sprintf("Error starting the %s job", state.JobType.ValueString()), message)
+		// keep the prior job, so that the job is started again by the next apply
+		state.JobType = types.StringNull()
+		state.JobID = types.Int64Null()
+		if prior != nil {
+			state.JobType = prior.JobType
+			state.JobID = prior.JobID
+		}
+		return &state, diags
+	}
+	state.JobID = types.Int64Value(int64(jobID))
+	return &state, diags
+}
+
+// GetDedupeSummary retrieves the dedupe savings of the cluster.
+func GetDedupeSummary(ctx context.Context, client *client.Client) (*models.DedupeSummaryModel, error) {
+	result, _, err := client.PscaleOpenAPIClient.DedupeApi.GetDedupev1DedupeDedupeSummary(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadDedupeSummaryErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting dedupe summary : %s", message)
+	}
+	summary := result.GetSummary()
+	model := models.DedupeSummaryModel{}
+	if err := CopyFields(ctx, &summary, &model); err != nil {
+		return nil, fmt.Errorf("error mapping dedupe summary : %s", err.Error())
+	}
+	model.LogicalSavedBytes = types.Int64Value(model.SavedLogicalBlocks.ValueInt64() * model.BlockSize.ValueInt64())
+	model.EstimatedPhysicalSavedBytes = types.Int64Value(model.EstimatedSavedBlocks.ValueInt64() * model.BlockSize.ValueInt64())
+	return &model, nil
+}
+
+// ListDedupeReports retrieves all the dedupe reports.
+func ListDedupeReports(ctx context.Context, client *client.Client) ([]powerscale.V1DedupeReport, error) {
+	result, _, err := client.PscaleOpenAPIClient.DedupeApi.GetDedupev1DedupeReports(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadDedupeReportErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting dedupe reports : %s", message)
+	}
+	reports := result.Reports
+	for result.Resume != nil && *result.Resume != "" {
+		result, _, err = client.PscaleOpenAPIClient.DedupeApi.GetDedupev1DedupeReports(ctx).Resume(*result.Resume).Execute()
+		if err != nil {
+			errStr := constants.ReadDedupeReportErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return nil, fmt.Errorf("error getting dedupe reports : %s", message)
+		}
+		reports = append(reports, result.Reports...)
+	}
+	return reports, nil
+}
+
+// GetDedupeReportCounters retrieves the contents of a dedupe report and returns its counters, like scanned_blocks or deduped_blocks.
+// The counters are kept in the results of the report, so they are read from the response body.
+func GetDedupeReportCounters(ctx context.Context, client *client.Client, reportID string) (map[string]string, error) {
+	_, httpResp, err := client.PscaleOpenAPIClient.DedupeApi.GetDedupev1DedupeReport(ctx, reportID).Execute()
+	if err != nil && (httpResp == nil || httpResp.StatusCode >= 300) {
+		errStr := constants.ReadDedupeReportErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting dedupe report %s : %s", reportID, message)
+	}
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading dedupe report %s : %s", reportID, err.Error())
+	}
+	return parseDedupeReportCounters(body)
+}
+
+// parseDedupeReportCounters collects the counters of a dedupe report response.
+// A counter may be a JSON field, a name and value object, or a "Name = value" line of the report results.
+func parseDedupeReportCounters(body []byte) (map[string]string, error) {
+	var report interface{}
+	if err := json.Unmarshal(body, &report); err != nil {
+		return nil, fmt.Errorf("error parsing dedupe report : %s", err.Error())
+	}
+	counters := map[string]string{}
+	var collect func(value interface{})
+	collect = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if name, ok := v["name"].(string); ok {
+				if field, ok := v["value"]; ok {
+					counters[dedupeReportCounterName(name)] = fmt.Sprint(field)
+				}
+			}
+			for key, field := range v {
+				switch f := field.(type) {
+				case string:
+					collect(f)
+					counters[dedupeReportCounterName(key)] = f
+				case float64:
+					counters[dedupeReportCounterName(key)] = strconv.FormatFloat(f, 'f', -1, 64)
+				default:
+					collect(f)
+				}
+			}
+		case []interface{}:
+			for _, item := range v {
+				collect(item)
+			}
+		case string:
+			for _, line := range strings.Split(v, "\n") {
+				if name, field, ok := strings.Cut(line, "="); ok {
+					counters[dedupeReportCounterName(name)] = strings.TrimSpace(field)
+				}
+			}
+		}
+	}
+	collect(report)
+	return counters, nil
+}
+
+// dedupeReportCounterName converts a counter name like "Scanned blocks" to scanned_blocks.
+func dedupeReportCounterName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "_")
+}
+
+// getDedupeReportInt64 returns the integral counter of a dedupe report, null if the report has no such counter.
+func getDedupeReportInt64(counters map[string]string, name string) types.Int64 {
+	value, err := strconv.ParseInt(strings.ReplaceAll(counters[name], ",", ""), 10, 64)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
+
+// getDedupeReportFloat64 returns the decimal counter of a dedupe report, null if the report has no such counter.
+func getDedupeReportFloat64(counters map[string]string, name string) types.Float64 {
+	value, err := strconv.ParseFloat(strings.TrimSuffix(strings.ReplaceAll(counters[name], ",", ""), "%"), 64)
+	if err != nil {
+		return types.Float64Null()
+	}
+	return types.Float64Value(value)
+}
+
+// UpdateDedupeReportResults sets the results of a dedupe report from its counters.
+// The saved bytes are estimated from the deduped blocks and the block size of the cluster.
+func UpdateDedupeReportResults(report *models.DedupeReportDetailModel, counters map[string]string, blockSize int64) {
+	report.ScannedBlocks = getDedupeReportInt64(counters, "scanned_blocks")
+	report.SampledBlocks = getDedupeReportInt64(counters, "sampled_blocks")
+	report.DedupedBlocks = getDedupeReportInt64(counters, "deduped_blocks")
+	report.DedupePercent = getDedupeReportFloat64(counters, "dedupe_percent")
+	report.SavedBytes = types.Int64Null()
+	if !report.DedupedBlocks.IsNull() {
+		report.SavedBytes = types.Int64Value(report.DedupedBlocks.ValueInt64() * blockSize)
+	}
+}
+
+// ManageDataSourceDedupeReport gets the dedupe summary and the reports matching the filter and sets the state.
+func ManageDataSourceDedupeReport(ctx context.Context, client *client.Client, state *models.DedupeReportDataSourceModel) (diags diag.Diagnostics) {
+	summary, err := GetDedupeSummary(ctx, client)
+	if err != nil {
+		diags.AddError("Error getting the dedupe summary", err.Error())
+		return
+	}
+
+	reports, err := ListDedupeReports(ctx, client)
+	if err != nil {
+		diags.AddError("Error getting the dedupe reports", err.Error())
+		return
+	}
+
+	jobIDs := map[int64]bool{}
+	jobType := ""
+	if state.Filter != nil {
+		for _, id := range state.Filter.JobIDs {
+			jobIDs[id.ValueInt64()] = true
+		}
+		jobType = state.Filter.JobType.ValueString()
+	}
+
+	state.DedupeReports = []models.DedupeReportDetailModel{}
+	for i := range reports {
+		if len(jobIDs) > 0 && !jobIDs[int64(reports[i].GetJobId())] {
+			continue
+		}
+		if jobType != "" && reports[i].GetJobType() != jobType {
+			continue
+		}
+		var report models.DedupeReportDetailModel
+		if err := CopyFields(ctx, &reports[i], &report); err != nil {
+			diags.AddError("Error mapping the dedupe reports", err.Error())
+			return
+		}
+		counters, err := GetDedupeReportCounters(ctx, client, reports[i].GetId())
+		if err != nil {
+			diags.AddError("Error getting the dedupe report results", err.Error())
+			return
+		}
+		UpdateDedupeReportResults(&report, counters, summary.BlockSize.ValueInt64())
+		state.DedupeReports = append(state.DedupeReports, report)
+	}
+	state.Summary = summary
+	state.ID = types.StringValue("dedupe_report_datasource")
+	return
+}
@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"terraform-provider-powerscale/powerscale/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDedupeReportCounters(t *testing.T) {
+	// counters as JSON fields
+	counters, err := parseDedupeReportCounters([]byte(`{"reports":[{"id":"1","job_id":5,"summary":{"scanned_blocks":1000,"deduped_blocks":100}}]}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "1000", counters["scanned_blocks"])
+	assert.Equal(t, "100", counters["deduped_blocks"])
+
+	// counters as name and value objects
+	counters, err = parseDedupeReportCounters([]byte(`{"reports":[{"fields":[{"name":"Sampled blocks","value":250},{"name":"Dedupe percent","value":"12.5%"}]}]}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "250", counters["sampled_blocks"])
+	assert.Equal(t, "12.5%", counters["dedupe_percent"])
+
+	// counters in the text of the report results
+	counters, err = parseDedupeReportCounters([]byte(`{"reports":[{"reports":[{"results":"Dedupe job report:{\n    Scanned blocks = 1,234\n    Deduped blocks = 56\n}"}]}]}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "1,234", counters["scanned_blocks"])
+	assert.Equal(t, "56", counters["deduped_blocks"])
+
+	_, err = parseDedupeReportCounters([]byte(`invalid`))
+	assert.NotNil(t, err)
+}
+
+func TestUpdateDedupeReportResults(t *testing.T) {
+	var report models.DedupeReportDetailModel
+	UpdateDedupeReportResults(&report, map[string]string{
+		"scanned_blocks": "1,234",
+		"deduped_blocks": "56",
+		"dedupe_percent": "4.5%",
+	}, 8192)
+	assert.Equal(t, types.Int64Value(1234), report.ScannedBlocks)
+	assert.Equal(t, types.Int64Null(), report.SampledBlocks)
+	assert.Equal(t, types.Int64Value(56), report.DedupedBlocks)
+	assert.Equal(t, types.Float64Value(4.5), report.DedupePercent)
+	assert.Equal(t, types.Int64Value(56*8192), report.SavedBytes)
+
+	// no saved bytes without deduped blocks
+	UpdateDedupeReportResults(&report, map[string]string{}, 8192)
+	assert.True(t, report.SavedBytes.IsNull())
+}
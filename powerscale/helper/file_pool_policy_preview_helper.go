@@ -0,0 +1,378 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// filePoolPreviewDetail lists the namespace attributes needed to evaluate criteria.
+	filePoolPreviewDetail = "name,type,size,nlink,atime_val,btime_val,mtime_val,ctime_val"
+	// filePoolPreviewPageSize is the page size used when listing directories.
+	filePoolPreviewPageSize = 1000
+	// FilePoolPolicyPreviewDefaultMaxFiles is the default number of files scanned by a preview.
+	FilePoolPolicyPreviewDefaultMaxFiles = 10000
+	// FilePoolPolicyPreviewDefaultMaxDirectories is the default number of directories listed by a preview.
+	FilePoolPolicyPreviewDefaultMaxDirectories = 1000
+	// FilePoolPolicyPreviewDefaultSampleSize is the default number of matching files returned by a preview.
+	FilePoolPolicyPreviewDefaultSampleSize = 10
+)
+
+// filePoolSizeUnits maps size criteria units to bytes.
+var filePoolSizeUnits = map[string]int64{
+	"B":  1,
+	"KB": 1 << 10,
+	"MB": 1 << 20,
+	"GB": 1 << 30,
+	"TB": 1 << 40,
+	"PB": 1 << 50,
+	"EB": 1 << 60,
+}
+
+// filePoolPreviewDir is a directory queued for scanning.
+type filePoolPreviewDir struct {
+	path  string
+	depth int64
+}
+
+// PreviewFilePoolPolicy evaluates a file pool policy, or a candidate file matching pattern,
+// against the files below state.Path and updates the state with the results.
+func PreviewFilePoolPolicy(ctx context.Context, client *client.Client, state *models.FilePoolPolicyPreviewDataSourceModel) error {
+	pattern, matchedPool, err := getFilePoolPreviewPattern(ctx, client, state)
+	if err != nil {
+		return err
+	}
+	if pattern == nil || len(pattern.OrCriteria) == 0 {
+		return fmt.Errorf("no file matching pattern to evaluate")
+	}
+	for _, orCriteria := range pattern.OrCriteria {
+		for _, andCriteria := range orCriteria.AndCriteria {
+			if andCriteria.Type == "custom_attribute" {
+				return fmt.Errorf("criteria type custom_attribute cannot be previewed")
+			}
+		}
+	}
+
+	defaultPolicy, err := GetFilePoolDefaultPolicy(ctx, client)
+	if err != nil {
+		return err
+	}
+	defaultActions, err := parseActionParams(ctx, defaultPolicy.Actions)
+	if err != nil {
+		return err
+	}
+	defaultPool := getDataStoragePool(defaultActions)
+	if matchedPool == "" {
+		matchedPool = defaultPool
+	}
+
+	maxFiles := int64(FilePoolPolicyPreviewDefaultMaxFiles)
+	if !state.MaxFiles.IsNull() && !state.MaxFiles.IsUnknown() {
+		maxFiles = state.MaxFiles.ValueInt64()
+	}
+	maxDirectories := int64(FilePoolPolicyPreviewDefaultMaxDirectories)
+	if !state.MaxDirectories.IsNull() && !state.MaxDirectories.IsUnknown() {
+		maxDirectories = state.MaxDirectories.ValueInt64()
+	}
+	sampleSize := int64(FilePoolPolicyPreviewDefaultSampleSize)
+	if !state.SampleSize.IsNull() && !state.SampleSize.IsUnknown() {
+		sampleSize = state.SampleSize.ValueInt64()
+	}
+	maxDepth := int64(-1)
+	if !state.MaxDepth.IsNull() && !state.MaxDepth.IsUnknown() {
+		maxDepth = state.MaxDepth.ValueInt64()
+	}
+
+	var scanned, scannedDirectories, matched, matchedBytes int64
+	truncated := false
+	samples := make([]models.FilePoolPolicyPreviewMatchModel, 0)
+	now := time.Now().Unix()
+
+	root := "/" + strings.Trim(state.Path.ValueString(), "/")
+	queue := []filePoolPreviewDir{{path: root, depth: 0}}
+scan:
+	for len(queue) > 0 {
+		if scannedDirectories >= maxDirectories {
+			truncated = true
+			break
+		}
+		scannedDirectories++
+		dir := queue[0]
+		queue = queue[1:]
+		resume := ""
+		for {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("preview of %s stopped: %s", root, err.Error())
+			}
+			listReq := client.PscaleOpenAPIClient.NamespaceApi.GetDirectoryContents(ctx, strings.TrimLeft(dir.path, "/"))
+			if resume != "" {
+				listReq = listReq.Resume(resume)
+			} else {
+				listReq = listReq.Detail(filePoolPreviewDetail).Limit(filePoolPreviewPageSize)
+			}
+			contents, _, err := listReq.Execute()
+			if err != nil {
+				return fmt.Errorf("could not list directory %s: %s", dir.path, err.Error())
+			}
+			for _, child := range contents.Children {
+				childPath := path.Join(dir.path, child.GetName())
+				if child.GetType() == "container" {
+					if maxDepth < 0 || dir.depth < maxDepth {
+						queue = append(queue, filePoolPreviewDir{path: childPath, depth: dir.depth + 1})
+					}
+					continue
+				}
+				if scanned >= maxFiles {
+					truncated = true
+					break scan
+				}
+				scanned++
+				ok, err := matchFilePoolPattern(pattern, child, dir.path, now)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				matched++
+				matchedBytes += int64(child.GetSize())
+				if int64(len(samples)) < sampleSize {
+					samples = append(samples, models.FilePoolPolicyPreviewMatchModel{
+						Path: types.StringValue(childPath),
+						Size: types.Int64Value(int64(child.GetSize())),
+					})
+				}
+			}
+			if contents.GetResume() == "" {
+				break
+			}
+			resume = contents.GetResume()
+		}
+	}
+
+	state.ID = types.StringValue("filepool_policy_preview_datasource")
+	state.MatchedStoragePool = types.StringValue(matchedPool)
+	state.DefaultStoragePool = types.StringValue(defaultPool)
+	state.ScannedFiles = types.Int64Value(scanned)
+	state.MatchedFiles = types.Int64Value(matched)
+	state.MatchedBytes = types.Int64Value(matchedBytes)
+	state.Truncated = types.BoolValue(truncated)
+	state.SampleMatches = samples
+	return nil
+}
+
+// getFilePoolPreviewPattern returns the file matching pattern to preview and the storage pool it targets.
+func getFilePoolPreviewPattern(ctx context.Context, client *client.Client, state *models.FilePoolPolicyPreviewDataSourceModel) (*powerscale.V1FilepoolPolicyFileMatchingPattern, string, error) {
+	if !state.PolicyName.IsNull() {
+		policy, err := GetFilePoolPolicy(ctx, client, state.PolicyName.ValueString())
+		if err != nil {
+			return nil, "", err
+		}
+		actions, err := parseActionParams(ctx, policy.Actions)
+		if err != nil {
+			return nil, "", err
+		}
+		return policy.FileMatchingPattern, getDataStoragePool(actions), nil
+	}
+	if state.FileMatchingPattern == nil {
+		return nil, "", fmt.Errorf("either policy_name or file_matching_pattern must be set")
+	}
+	pattern, err := buildFileMatchPattern(ctx, &models.FilePoolPolicyModel{FileMatchingPattern: state.FileMatchingPattern})
+	if err != nil {
+		return nil, "", err
+	}
+	return pattern, state.MatchedStoragePool.ValueString(), nil
+}
+
+// getDataStoragePool returns the storage pool of the apply_data_storage_policy action, if any.
+func getDataStoragePool(actions []models.V1FilepoolDefaultPolicyAction) string {
+	for _, action := range actions {
+		if action.ActionType.ValueString() == FilePoolPolicyActionApplyDataStoragePolicyType && action.DataStoragePolicyAction != nil {
+			return action.DataStoragePolicyAction.StoragePool.ValueString()
+		}
+	}
+	return ""
+}
+
+// matchFilePoolPattern reports whether a file matches any of the or_criteria of the pattern.
+func matchFilePoolPattern(pattern *powerscale.V1FilepoolPolicyFileMatchingPattern, file powerscale.NamespaceObject, dirPath string, now int64) (bool, error) {
+	for _, orCriteria := range pattern.OrCriteria {
+		matched := true
+		for _, andCriteria := range orCriteria.AndCriteria {
+			ok, err := matchFilePoolCriteria(andCriteria, file, dirPath, now)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchFilePoolCriteria evaluates a single and_criteria against a file.
+func matchFilePoolCriteria(criteria powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem, file powerscale.NamespaceObject, dirPath string, now int64) (bool, error) {
+	operator := criteria.GetOperator()
+	if operator == "" {
+		operator = "=="
+	}
+	switch criteria.Type {
+	case "name":
+		return matchFilePoolString(file.GetName(), criteria, operator)
+	case "path":
+		value := "/" + strings.Trim(fmt.Sprint(criteria.Value), "/")
+		actual, expected := dirPath, value
+		if !criteria.GetCaseSensitive() {
+			actual, expected = strings.ToLower(actual), strings.ToLower(expected)
+		}
+		var ok bool
+		if criteria.GetBeginsWith() {
+			ok = actual == expected || strings.HasPrefix(actual, expected+"/")
+		} else {
+			ok, _ = path.Match(expected, actual)
+		}
+		return applyFilePoolEquality(ok, operator)
+	case "file_type":
+		fileType := "other"
+		switch file.GetType() {
+		case "object":
+			fileType = "file"
+		case "container":
+			fileType = "directory"
+		}
+		return applyFilePoolEquality(fileType == fmt.Sprint(criteria.Value), operator)
+	case "link_count":
+		value, err := filePoolCriteriaInt(criteria)
+		if err != nil {
+			return false, err
+		}
+		return compareFilePoolInt(int64(file.GetNlink()), operator, value)
+	case "size":
+		value, err := filePoolCriteriaInt(criteria)
+		if err != nil {
+			return false, err
+		}
+		units := criteria.GetUnits()
+		if units == "" {
+			units = "B"
+		}
+		multiplier, ok := filePoolSizeUnits[strings.ToUpper(units)]
+		if !ok {
+			return false, fmt.Errorf("unsupported size units: %s", units)
+		}
+		return compareFilePoolInt(int64(file.GetSize()), operator, value*multiplier)
+	case "accessed_time", "birth_time", "changed_time", "metadata_changed_time":
+		value, err := filePoolCriteriaInt(criteria)
+		if err != nil {
+			return false, err
+		}
+		var fileTime int64
+		switch criteria.Type {
+		case "accessed_time":
+			fileTime = int64(file.GetAtimeVal())
+		case "birth_time":
+			fileTime = int64(file.GetBtimeVal())
+		case "changed_time":
+			fileTime = int64(file.GetMtimeVal())
+		default:
+			fileTime = int64(file.GetCtimeVal())
+		}
+		// A relative time is compared against the age of the file rather than its timestamp.
+		if criteria.GetUseRelativeTime() {
+			return compareFilePoolInt(now-fileTime, operator, value)
+		}
+		return compareFilePoolInt(fileTime, operator, value)
+	default:
+		return false, fmt.Errorf("unsupported criteria type: %s", criteria.Type)
+	}
+}
+
+// matchFilePoolString matches a name against the glob pattern of a criteria.
+func matchFilePoolString(actual string, criteria powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem, operator string) (bool, error) {
+	expected := fmt.Sprint(criteria.Value)
+	if !criteria.GetCaseSensitive() {
+		actual, expected = strings.ToLower(actual), strings.ToLower(expected)
+	}
+	ok, err := path.Match(expected, actual)
+	if err != nil {
+		return false, fmt.Errorf("invalid name pattern %s: %s", fmt.Sprint(criteria.Value), err.Error())
+	}
+	return applyFilePoolEquality(ok, operator)
+}
+
+// applyFilePoolEquality applies an equality operator to the result of a match.
+func applyFilePoolEquality(ok bool, operator string) (bool, error) {
+	switch operator {
+	case "==":
+		return ok, nil
+	case "!=":
+		return !ok, nil
+	default:
+		return false, fmt.Errorf("unsupported operator %s for this criteria type", operator)
+	}
+}
+
+// compareFilePoolInt compares two integers with the given operator.
+func compareFilePoolInt(actual int64, operator string, expected int64) (bool, error) {
+	switch operator {
+	case "==":
+		return actual == expected, nil
+	case "!=":
+		return actual != expected, nil
+	case ">":
+		return actual > expected, nil
+	case ">=":
+		return actual >= expected, nil
+	case "<":
+		return actual < expected, nil
+	case "<=":
+		return actual <= expected, nil
+	default:
+		return false, fmt.Errorf("unsupported operator: %s", operator)
+	}
+}
+
+// filePoolCriteriaInt returns the integral value of a criteria.
+func filePoolCriteriaInt(criteria powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem) (int64, error) {
+	switch value := criteria.Value.(type) {
+	case int64:
+		return value, nil
+	case float64:
+		return int64(value), nil
+	case string:
+		return strconv.ParseInt(value, 10, 64)
+	default:
+		return 0, fmt.Errorf("unexpected value: %v for criteria type: %s", criteria.Value, criteria.Type)
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type filePoolCriteriaOption func(*powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem)
+
+func newFilePoolCriteria(criteriaType string, operator string, value interface{}, options ...filePoolCriteriaOption) powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem {
+	criteria := powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem{Type: criteriaType, Value: value}
+	if operator != "" {
+		criteria.SetOperator(operator)
+	}
+	for _, option := range options {
+		option(&criteria)
+	}
+	return criteria
+}
+
+func caseSensitive(c *powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem) {
+	c.SetCaseSensitive(true)
+}
+
+func beginsWith(c *powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem) {
+	c.SetBeginsWith(true)
+}
+
+func relativeTime(c *powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem) {
+	c.SetUseRelativeTime(true)
+}
+
+func units(u string) filePoolCriteriaOption {
+	return func(c *powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem) {
+		c.SetUnits(u)
+	}
+}
+
+const filePoolTestNow = 10000
+
+func newFilePoolTestFile() powerscale.NamespaceObject {
+	var file powerscale.NamespaceObject
+	file.SetName("Report.TXT")
+	file.SetType("object")
+	file.SetSize(2048)
+	file.SetNlink(2)
+	file.SetAtimeVal(filePoolTestNow - 100)
+	file.SetBtimeVal(1000)
+	file.SetMtimeVal(2000)
+	file.SetCtimeVal(3000)
+	return file
+}
+
+func TestMatchFilePoolCriteria(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem
+		want     bool
+		wantErr  bool
+	}{
+		// name
+		{"name glob ignores case by default", newFilePoolCriteria("name", "", "*.txt"), true, false},
+		{"name glob with case sensitive", newFilePoolCriteria("name", "==", "*.txt", caseSensitive), false, false},
+		{"name not equal", newFilePoolCriteria("name", "!=", "*.txt"), false, false},
+		{"name not equal other", newFilePoolCriteria("name", "!=", "*.log"), true, false},
+		{"name character class", newFilePoolCriteria("name", "==", "report.[tx]xt"), true, false},
+		{"name invalid pattern", newFilePoolCriteria("name", "==", "[report"), false, true},
+		{"name unsupported operator", newFilePoolCriteria("name", ">", "*.txt"), false, true},
+		// path
+		{"path begins with", newFilePoolCriteria("path", "==", "/ifs/data", beginsWith), true, false},
+		{"path begins with the directory itself", newFilePoolCriteria("path", "==", "/ifs/data/projects/", beginsWith), true, false},
+		{"path begins with partial name", newFilePoolCriteria("path", "==", "/ifs/dat", beginsWith), false, false},
+		{"path glob", newFilePoolCriteria("path", "==", "/ifs/data/*"), true, false},
+		{"path glob does not match parent", newFilePoolCriteria("path", "==", "/ifs/*"), false, false},
+		{"path ignores case by default", newFilePoolCriteria("path", "==", "/IFS/DATA", beginsWith), true, false},
+		{"path with case sensitive", newFilePoolCriteria("path", "==", "/IFS/DATA", beginsWith, caseSensitive), false, false},
+		{"path not equal", newFilePoolCriteria("path", "!=", "/ifs/home", beginsWith), true, false},
+		// file_type
+		{"file type file", newFilePoolCriteria("file_type", "==", "file"), true, false},
+		{"file type directory", newFilePoolCriteria("file_type", "==", "directory"), false, false},
+		{"file type not directory", newFilePoolCriteria("file_type", "!=", "directory"), true, false},
+		// link_count
+		{"link count equal", newFilePoolCriteria("link_count", "==", int64(2)), true, false},
+		{"link count from string", newFilePoolCriteria("link_count", "==", "2"), true, false},
+		{"link count from float", newFilePoolCriteria("link_count", ">=", float64(2)), true, false},
+		{"link count less than", newFilePoolCriteria("link_count", "<", int64(2)), false, false},
+		{"link count invalid value", newFilePoolCriteria("link_count", "==", true), false, true},
+		{"link count invalid string", newFilePoolCriteria("link_count", "==", "two"), false, true},
+		// size
+		{"size in bytes by default", newFilePoolCriteria("size", ">", int64(2047)), true, false},
+		{"size greater than KB", newFilePoolCriteria("size", ">", int64(1), units("KB")), true, false},
+		{"size equal KB", newFilePoolCriteria("size", "==", int64(2), units("KB")), true, false},
+		{"size lower case units", newFilePoolCriteria("size", "<=", int64(1), units("kb")), false, false},
+		{"size less than MB", newFilePoolCriteria("size", "<", int64(1), units("MB")), true, false},
+		{"size unsupported units", newFilePoolCriteria("size", ">", int64(1), units("XB")), false, true},
+		{"size unsupported operator", newFilePoolCriteria("size", "~", int64(1)), false, true},
+		// times
+		{"accessed time relative", newFilePoolCriteria("accessed_time", "<", int64(200), relativeTime), true, false},
+		{"accessed time relative older", newFilePoolCriteria("accessed_time", ">", int64(200), relativeTime), false, false},
+		{"accessed time absolute", newFilePoolCriteria("accessed_time", ">", int64(filePoolTestNow-200)), true, false},
+		{"birth time absolute", newFilePoolCriteria("birth_time", ">", int64(500)), true, false},
+		{"birth time relative", newFilePoolCriteria("birth_time", ">=", int64(filePoolTestNow-1000), relativeTime), true, false},
+		{"changed time equal", newFilePoolCriteria("changed_time", "==", int64(2000)), true, false},
+		{"changed time not equal", newFilePoolCriteria("changed_time", "!=", int64(2000)), false, false},
+		{"metadata changed time less than", newFilePoolCriteria("metadata_changed_time", "<", int64(3000)), false, false},
+		{"metadata changed time less or equal", newFilePoolCriteria("metadata_changed_time", "<=", int64(3000)), true, false},
+		// unsupported
+		{"unsupported criteria type", newFilePoolCriteria("custom_attribute", "==", "value"), false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchFilePoolCriteria(tt.criteria, newFilePoolTestFile(), "/ifs/data/projects", filePoolTestNow)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompareFilePoolInt(t *testing.T) {
+	tests := []struct {
+		operator string
+		actual   int64
+		expected int64
+		want     bool
+	}{
+		{"==", 1, 1, true},
+		{"==", 1, 2, false},
+		{"!=", 1, 2, true},
+		{"!=", 1, 1, false},
+		{">", 2, 1, true},
+		{">", 1, 1, false},
+		{">=", 1, 1, true},
+		{">=", 0, 1, false},
+		{"<", 0, 1, true},
+		{"<", 1, 1, false},
+		{"<=", 1, 1, true},
+		{"<=", 2, 1, false},
+	}
+	for _, tt := range tests {
+		got, err := compareFilePoolInt(tt.actual, tt.operator, tt.expected)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.want, got, "%d %s %d", tt.actual, tt.operator, tt.expected)
+	}
+	_, err := compareFilePoolInt(1, "=~", 1)
+	assert.NotNil(t, err)
+}
+
+func TestMatchFilePoolPattern(t *testing.T) {
+	pattern := &powerscale.V1FilepoolPolicyFileMatchingPattern{
+		OrCriteria: []powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItem{
+			{AndCriteria: []powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem{
+				newFilePoolCriteria("name", "==", "*.log"),
+				newFilePoolCriteria("size", ">", int64(1)),
+			}},
+			{AndCriteria: []powerscale.V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem{
+				newFilePoolCriteria("name", "==", "*.txt"),
+				newFilePoolCriteria("size", ">", int64(1), units("KB")),
+			}},
+		},
+	}
+	file := newFilePoolTestFile()
+
+	// the second or_criteria matches all of its and_criteria
+	ok, err := matchFilePoolPattern(pattern, file, "/ifs/data", filePoolTestNow)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// no or_criteria matches all of its and_criteria
+	pattern.OrCriteria[1].AndCriteria[1] = newFilePoolCriteria("size", ">", int64(1), units("MB"))
+	ok, err = matchFilePoolPattern(pattern, file, "/ifs/data", filePoolTestNow)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	// errors of the evaluated criteria are returned
+	pattern.OrCriteria[0].AndCriteria[0] = newFilePoolCriteria("name", "==", "[log")
+	_, err = matchFilePoolPattern(pattern, file, "/ifs/data", filePoolTestNow)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetIdentityUser returns the user with group memberships by name in specific zone and provider.
+func GetIdentityUser(ctx context.Context, client *client.Client, name string, filter models.IdentityFilterType) (*powerscale.V1AuthUsersExtended, *http.Response, error) {
+	getParam := client.PscaleOpenAPIClient.AuthApi.GetAuthv1AuthUser(ctx, name).QueryMemberOf(true)
+	if filter.Zone.ValueString() != "" {
+		getParam = getParam.Zone(filter.Zone.ValueString())
+	}
+	if filter.Provider.ValueString() != "" {
+		getParam = getParam.Provider(filter.Provider.ValueString())
+	}
+	result, httpResp, err := getParam.Execute()
+	if err != nil {
+		errStr := constants.ReadUserErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, httpResp, fmt.Errorf("error getting user - %s : %s", name, message)
+	}
+	return result, httpResp, nil
+}
+
+// GetIdentityGroup returns the group with group memberships by name in specific zone and provider.
+func GetIdentityGroup(ctx context.Context, client *client.Client, name string, filter models.IdentityFilterType) (*powerscale.V1AuthGroupsExtended, *http.Response, error) {
+	getParam := client.PscaleOpenAPIClient.AuthApi.GetAuthv1AuthGroup(ctx, name).QueryMemberOf(true)
+	if filter.Zone.ValueString() != "" {
+		getParam = getParam.Zone(filter.Zone.ValueString())
+	}
+	if filter.Provider.ValueString() != "" {
+		getParam = getParam.Provider(filter.Provider.ValueString())
+	}
+	result, httpResp, err := getParam.Execute()
+	if err != nil {
+		errStr := constants.ReadUserGroupErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, httpResp, fmt.Errorf("error getting user group - %s : %s", name, message)
+	}
+	return result, httpResp, nil
+}
+
+// IdentityUserDetailMapper maps the user to the identity datasource model.
+func IdentityUserDetailMapper(name string, user powerscale.V1AuthUserExtended) models.IdentityDetailModel {
+	return models.IdentityDetailModel{
+		Lookup:           types.StringValue(name),
+		Name:             types.StringValue(user.Name),
+		Type:             types.StringValue(user.Type),
+		Provider:         types.StringValue(user.Provider),
+		Domain:           types.StringValue(user.Domain),
+		SID:              types.StringPointerValue(user.Sid.Id),
+		UID:              types.StringPointerValue(user.Uid.Id),
+		GID:              types.StringPointerValue(user.Gid.Id),
+		PrimaryGroupSID:  types.StringPointerValue(user.PrimaryGroupSid.Id),
+		MemberOf:         identityMemberOfMapper(user.MemberOf),
+		MappedIdentities: []models.AuthMappingTargetModel{},
+	}
+}
+
+// IdentityGroupDetailMapper maps the group to the identity datasource model.
+func IdentityGroupDetailMapper(name string, group powerscale.V1AuthGroupExtended) models.IdentityDetailModel {
+	return models.IdentityDetailModel{
+		Lookup:           types.StringValue(name),
+		Name:             types.StringValue(group.Name),
+		Type:             types.StringValue(group.Type),
+		Provider:         types.StringValue(group.Provider),
+		Domain:           types.StringValue(group.Domain),
+		SID:              types.StringPointerValue(group.Sid.Id),
+		UID:              types.StringNull(),
+		GID:              types.StringPointerValue(group.Gid.Id),
+		PrimaryGroupSID:  types.StringNull(),
+		MemberOf:         identityMemberOfMapper(group.MemberOf),
+		MappedIdentities: []models.AuthMappingTargetModel{},
+	}
+}
+
+// identityMemberOfMapper maps the group memberships of an identity.
+func identityMemberOfMapper(memberOf []powerscale.V1AuthAccessAccessItemFileGroup) []models.V1AuthAccessAccessItemFileGroup {
+	groups := []models.V1AuthAccessAccessItemFileGroup{}
+	for _, group := range memberOf {
+		groups = append(groups, models.V1AuthAccessAccessItemFileGroup{
+			ID:   types.StringPointerValue(group.Id),
+			Name: types.StringPointerValue(group.Name),
+			Type: types.StringPointerValue(group.Type),
+		})
+	}
+	return groups
+}
+
+// GetIdentityDetails resolves a name to all the matching users and groups across the authentication providers,
+// with their group memberships and mapped identities.
+// A name which is not found as a user or as a group is not a match, other lookup errors are returned.
+func GetIdentityDetails(ctx context.Context, client *client.Client, name string, filter models.IdentityFilterType) ([]models.IdentityDetailModel, error) {
+	details := []models.IdentityDetailModel{}
+	users, httpResp, err := GetIdentityUser(ctx, client, name, filter)
+	if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+		return nil, err
+	}
+	if err == nil && users != nil {
+		for _, user := range users.Users {
+			details = append(details, IdentityUserDetailMapper(name, user))
+		}
+	}
+
+	groups, httpResp, err := GetIdentityGroup(ctx, client, name, filter)
+	if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+		return nil, err
+	}
+	if err == nil && groups != nil {
+		for _, group := range groups.Groups {
+			details = append(details, IdentityGroupDetailMapper(name, group))
+		}
+	}
+
+	if len(details) == 0 {
+		errStr := constants.ReadIdentityErrorMsg + "with error: "
+		return nil, fmt.Errorf("%s%s is neither a user nor a group in the zone", errStr, name)
+	}
+
+	for i := range details {
+		if err := getIdentityMappedIdentities(ctx, client, &details[i], filter.Zone.ValueString()); err != nil {
+			return nil, err
+		}
+	}
+	return details, nil
+}
+
+// getIdentityMappedIdentities looks up the identities mapped to an identity.
+// The mappings are looked up by SID, or UID/GID for identities without SID.
+func getIdentityMappedIdentities(ctx context.Context, client *client.Client, detail *models.IdentityDetailModel, zone string) error {
+	source := detail.SID.ValueString()
+	if source == "" {
+		source = detail.UID.ValueString()
+	}
+	if source == "" {
+		source = detail.GID.ValueString()
+	}
+	if source == "" {
+		return nil
+	}
+
+	identities, err := GetAuthMappingIdentity(ctx, client, source, zone)
+	if err != nil {
+		return err
+	}
+	for _, identity := range identities.Identities {
+		detail.MappedIdentities = append(detail.MappedIdentities, AuthMappingDetailMapper(identity).Targets...)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"time"
+)
+
+// jobFinishedStates lists the job states after which a job no longer runs.
+var jobFinishedStates = map[string]bool{
+	"succeeded":        true,
+	"failed":           true,
+	"cancelled_user":   true,
+	"cancelled_system": true,
+}
+
+// IsJobFinished reports whether a job state is terminal.
+func IsJobFinished(state string) bool {
+	return jobFinishedStates[state]
+}
+
+// isJobPaused reports whether a job is paused, e.g. paused_user or paused_system. A paused job makes no progress until it is resumed.
+func isJobPaused(state string) bool {
+	return strings.HasPrefix(state, "paused")
+}
+
+// WaitForJob polls the job until it is finished or paused.
+// The last polled job is returned along with the error if the timeout is reached or the context is done.
+func WaitForJob(ctx context.Context, client *client.Client, jobID string, interval, timeout time.Duration) (*powerscale.V10JobJobExtended, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		job, err := GetSnapshotRestoreJob(ctx, client, jobID)
+		if err != nil {
+			errStr := constants.ReadJobErrorMsg + "with error: "
+			return nil, fmt.Errorf("%s", GetErrorString(err, errStr))
+		}
+		if IsJobFinished(job.State) || isJobPaused(job.State) {
+			return job, nil
+		}
+		if time.Now().After(deadline) {
+			return job, fmt.Errorf("job %s is still %s after %s, progress: %s", jobID, job.State, timeout, job.GetProgress())
+		}
+		select {
+		case <-ctx.Done():
+			return job, fmt.Errorf("stopped waiting for job %s in state %s: %s", jobID, job.State, ctx.Err().Error())
+		case <-time.After(interval):
+		}
+	}
+}
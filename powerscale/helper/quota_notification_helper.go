@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+)
+
+// GetQuotaNotification retrieves the notification rule of a quota.
+func GetQuotaNotification(ctx context.Context, client *client.Client, quotaID, notificationID string) (*powerscale.V12QuotaQuotaNotificationExtended, *http.Response, error) {
+	result, httpResp, err := client.PscaleOpenAPIClient.QuotaApi.GetQuotav12QuotaQuotaNotification(ctx, notificationID, quotaID).Execute()
+	if err != nil {
+		errStr := constants.ReadQuotaNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, httpResp, fmt.Errorf("error getting notification rule %s of quota %s : %s", notificationID, quotaID, message)
+	}
+	if len(result.Notifications) == 0 {
+		return nil, httpResp, fmt.Errorf("error getting notification rule %s of quota %s : notification rule not found", notificationID, quotaID)
+	}
+	return &result.Notifications[0], httpResp, nil
+}
+
+// CreateQuotaNotification creates a notification rule on the quota and returns its ID.
+// PowerScale switches the notifications of the quota to custom rules.
+func CreateQuotaNotification(ctx context.Context, client *client.Client, plan models.QuotaNotificationResourceModel) (string, error) {
+	var createBody powerscale.V12QuotaQuotaNotification
+	if err := ReadFromState(ctx, &plan, &createBody); err != nil {
+		errStr := constants.CreateQuotaNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error reading quota notification rule plan : %s", message)
+	}
+	result, _, err := client.PscaleOpenAPIClient.QuotaApi.CreateQuotav12QuotaQuotaNotification(ctx, plan.QuotaID.ValueString()).V12QuotaQuotaNotification(createBody).Execute()
+	if err != nil {
+		errStr := constants.CreateQuotaNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error creating notification rule on quota %s : %s", plan.QuotaID.ValueString(), message)
+	}
+	return result.Id, nil
+}
+
+// UpdateQuotaNotification updates the notification rule of a quota.
+func UpdateQuotaNotification(ctx context.Context, client *client.Client, state, plan models.QuotaNotificationResourceModel) error {
+	var updateBody powerscale.V12QuotaQuotaNotificationExtendedExtended
+	if err := ReadFromState(ctx, &plan, &updateBody); err != nil {
+		errStr := constants.UpdateQuotaNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error reading quota notification rule plan : %s", message)
+	}
+	_, err := client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav12QuotaQuotaNotification(ctx, state.ID.ValueString(), state.QuotaID.ValueString()).V12QuotaQuotaNotification(updateBody).Execute()
+	if err != nil {
+		errStr := constants.UpdateQuotaNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error updating notification rule %s of quota %s : %s", state.ID.ValueString(), state.QuotaID.ValueString(), message)
+	}
+	return nil
+}
+
+// DeleteQuotaNotification deletes the notification rule of a quota. A rule which no longer exists is considered deleted.
+func DeleteQuotaNotification(ctx context.Context, client *client.Client, quotaID, notificationID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.QuotaApi.DeleteQuotav12QuotaQuotaNotification(ctx, notificationID, quotaID).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		errStr := constants.DeleteQuotaNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error deleting notification rule %s of quota %s : %s", notificationID, quotaID, message)
+	}
+	return nil
+}
+
+// UpdateQuotaNotificationState updates the resource state from the notification rule.
+func UpdateQuotaNotificationState(ctx context.Context, state *models.QuotaNotificationResourceModel, notification *powerscale.V12QuotaQuotaNotificationExtended) error {
+	return CopyFieldsToNonNestedModel(ctx, notification, state)
+}
+
+// GetQuotaDefaultNotification retrieves a global default quota notification rule.
+func GetQuotaDefaultNotification(ctx context.Context, client *client.Client, notificationID string) (*powerscale.V1SettingsNotificationExtended, *http.Response, error) {
+	result, httpResp, err := client.PscaleOpenAPIClient.QuotaApi.GetQuotav1SettingsNotification(ctx, notificationID).Execute()
+	if err != nil {
+		errStr := constants.ReadQuotaDefaultNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, httpResp, fmt.Errorf("error getting default notification rule %s : %s", notificationID, message)
+	}
+	if len(result.Notifications) == 0 {
+		return nil, httpResp, fmt.Errorf("error getting default notification rule %s : notification rule not found", notificationID)
+	}
+	return &result.Notifications[0], httpResp, nil
+}
+
+// CreateQuotaDefaultNotification creates a global default quota notification rule and returns its ID.
+func CreateQuotaDefaultNotification(ctx context.Context, client *client.Client, plan models.QuotaDefaultNotificationResourceModel) (string, error) {
+	var createBody powerscale.V1SettingsNotification
+	if err := ReadFromState(ctx, &plan, &createBody); err != nil {
+		errStr := constants.CreateQuotaDefaultNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error reading default quota notification rule plan : %s", message)
+	}
+	result, _, err := client.PscaleOpenAPIClient.QuotaApi.CreateQuotav1SettingsNotification(ctx).V1SettingsNotification(createBody).Execute()
+	if err != nil {
+		errStr := constants.CreateQuotaDefaultNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error creating default notification rule : %s", message)
+	}
+	return result.Id, nil
+}
+
+// UpdateQuotaDefaultNotification updates a global default quota notification rule.
+func UpdateQuotaDefaultNotification(ctx context.Context, client *client.Client, state, plan models.QuotaDefaultNotificationResourceModel) error {
+	var updateBody powerscale.V1SettingsNotificationExtendedExtended
+	if err := ReadFromState(ctx, &plan, &updateBody); err != nil {
+		errStr := constants.UpdateQuotaDefaultNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error reading default quota notification rule plan : %s", message)
+	}
+	_, err := client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav1SettingsNotification(ctx, state.ID.ValueString()).V1SettingsNotification(updateBody).Execute()
+	if err != nil {
+		errStr := constants.UpdateQuotaDefaultNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error updating default notification rule %s : %s", state.ID.ValueString(), message)
+	}
+	return nil
+}
+
+// DeleteQuotaDefaultNotification deletes a global default quota notification rule. A rule which no longer exists is considered deleted.
+func DeleteQuotaDefaultNotification(ctx context.Context, client *client.Client, notificationID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.QuotaApi.DeleteQuotav1SettingsNotification(ctx, notificationID).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		errStr := constants.DeleteQuotaDefaultNotificationErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error deleting default notification rule %s : %s", notificationID, message)
+	}
+	return nil
+}
+
+// UpdateQuotaDefaultNotificationState updates the resource state from the default notification rule.
+func UpdateQuotaDefaultNotificationState(ctx context.Context, state *models.QuotaDefaultNotificationResourceModel, notification *powerscale.V1SettingsNotificationExtended) error {
+	return CopyFieldsToNonNestedModel(ctx, notification, state)
+}
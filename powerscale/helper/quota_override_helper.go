@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// QuotaOverrideThresholdsAttrTypes returns the attribute types of the thresholds of a quota override.
+func QuotaOverrideThresholdsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"advisory":   types.Int64Type,
+		"hard":       types.Int64Type,
+		"soft":       types.Int64Type,
+		"soft_grace": types.Int64Type,
+	}
+}
+
+// GetDefaultQuotaMemberQuota gets the quota generated by a default-user or default-group quota for the given persona.
+func GetDefaultQuotaMemberQuota(ctx context.Context, client *client.Client, plan models.QuotaOverrideResourceModel) (*powerscale.V12QuotaQuotaExtended, error) {
+	quotas, err := ListQuotas(ctx, client, &models.QuotaDatasourceFilter{
+		Path:             plan.Path,
+		Type:             plan.Type,
+		Persona:          plan.Persona,
+		Zone:             plan.Zone,
+		IncludeSnapshots: plan.IncludeSnapshots,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range quotas {
+		if quotas[i].GetPath() == plan.Path.ValueString() && quotas[i].GetType() == plan.Type.ValueString() {
+			return &quotas[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no %s quota found on %s for persona %s, make sure a default-%s quota exists on the path and has generated a quota for the persona",
+		plan.Type.ValueString(), plan.Path.ValueString(), plan.Persona.ValueString(), plan.Type.ValueString())
+}
+
+// GetOverriddenQuota gets the overridden quota by ID, nil is returned when the quota does not exist.
+// The http response is returned, so that a quota deleted outside of Terraform can be told apart from other errors.
+func GetOverriddenQuota(ctx context.Context, client *client.Client, quotaID, zone string) (*powerscale.V12QuotaQuotaExtended, *http.Response, error) {
+	param := client.PscaleOpenAPIClient.QuotaApi.GetQuotav12QuotaQuota(ctx, quotaID)
+	if zone != "" {
+		param = param.Zone(zone)
+	}
+	response, httpResp, err := param.ResolveNames(true).Execute()
+	if err != nil {
+		return nil, httpResp, err
+	}
+	if len(response.Quotas) <= 0 {
+		return nil, httpResp, nil
+	}
+	return &response.Quotas[0], httpResp, nil
+}
+
+// OverrideQuota unlinks the quota from its default quota and applies the custom thresholds.
+func OverrideQuota(ctx context.Context, client *client.Client, quotaID string, plan models.QuotaOverrideResourceModel, linked bool) error {
+	var quotaToUpdate powerscale.V12QuotaQuotaExtendedExtended
+	if err := ReadFromState(ctx, &plan, &quotaToUpdate); err != nil {
+		return err
+	}
+	unlinked := false
+	quotaToUpdate.Linked = &unlinked
+	return UpdateQuota(ctx, client, quotaID, quotaToUpdate, linked)
+}
+
+// UpdateQuotaOverrideState updates the resource state from the quota.
+func UpdateQuotaOverrideState(ctx context.Context, quota powerscale.V12QuotaQuotaExtended, state *models.QuotaOverrideResourceModel) (diags diag.Diagnostics) {
+	state.ID = types.StringValue(quota.GetId())
+	state.Path = types.StringValue(quota.GetPath())
+	state.Type = types.StringValue(quota.GetType())
+	state.IncludeSnapshots = types.BoolValue(quota.GetIncludeSnapshots())
+	state.Enforced = types.BoolValue(quota.GetEnforced())
+	state.ThresholdsOn = types.StringValue(quota.GetThresholdsOn())
+	state.Linked = types.BoolValue(quota.GetLinked())
+	// keep the persona as configured, the array returns it in its own serialized form
+	if state.Persona.IsNull() || state.Persona.IsUnknown() {
+		persona := quota.GetPersona()
+		state.Persona = types.StringValue(persona.GetId())
+	}
+
+	var thresholds models.QuotaOverrideThresholds
+	if err := CopyFields(ctx, quota.GetThresholds(), &thresholds); err != nil {
+		diags.AddError("Error copying the thresholds of the quota", err.Error())
+		return
+	}
+	state.Thresholds, diags = types.ObjectValueFrom(ctx, QuotaOverrideThresholdsAttrTypes(), thresholds)
+	return
+}
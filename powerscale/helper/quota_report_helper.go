@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetQuotaReportSettings retrieve quota report settings.
+func GetQuotaReportSettings(ctx context.Context, client *client.Client) (*powerscale.V1SettingsReports, error) {
+	quotaReportSettings, _, err := client.PscaleOpenAPIClient.QuotaApi.GetQuotav1SettingsReports(ctx).Execute()
+	return quotaReportSettings, err
+}
+
+// UpdateQuotaReportSettings update quota report settings.
+func UpdateQuotaReportSettings(ctx context.Context, client *client.Client, v1SettingsReports powerscale.V1SettingsReportsExtended) error {
+	_, err := client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav1SettingsReports(ctx).V1SettingsReports(v1SettingsReports).Execute()
+	return err
+}
+
+// ListQuotaReports retrieves all the quota reports.
+func ListQuotaReports(ctx context.Context, client *client.Client) ([]powerscale.V1QuotaReport, error) {
+	result, _, err := client.PscaleOpenAPIClient.QuotaApi.ListQuotav1QuotaReports(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadQuotaReportErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting quota reports : %s", message)
+	}
+	reports := result.Reports
+	for result.Resume != nil && *result.Resume != "" {
+		result, _, err = client.PscaleOpenAPIClient.QuotaApi.ListQuotav1QuotaReports(ctx).Resume(*result.Resume).Execute()
+		if err != nil {
+			errStr := constants.ReadQuotaReportErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return nil, fmt.Errorf("error getting quota reports : %s", message)
+		}
+		reports = append(reports, result.Reports...)
+	}
+	return reports, nil
+}
+
+// GetQuotaReportContents retrieves the usage of the quotas at the time the report was generated.
+func GetQuotaReportContents(ctx context.Context, client *client.Client, reportID string) ([]models.QuotaReportQuotaModel, error) {
+	quotas, err := ListQuotas(ctx, client, &models.QuotaDatasourceFilter{
+		ReportID: types.StringValue(reportID),
+	})
+	if err != nil {
+		errStr := constants.ReadQuotaReportErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting the contents of quota report %s : %s", reportID, message)
+	}
+	contents := []models.QuotaReportQuotaModel{}
+	for i := range quotas {
+		var quota models.QuotaReportQuotaModel
+		if err := CopyFields(ctx, &quotas[i], &quota); err != nil {
+			return nil, fmt.Errorf("error mapping the contents of quota report %s : %s", reportID, err.Error())
+		}
+		contents = append(contents, quota)
+	}
+	return contents, nil
+}
+
+// ManageDataSourceQuotaReport gets the quota reports matching the filter with their contents and sets the state.
+func ManageDataSourceQuotaReport(ctx context.Context, client *client.Client, state *models.QuotaReportDataSourceModel) (diags diag.Diagnostics) {
+	reports, err := ListQuotaReports(ctx, client)
+	if err != nil {
+		diags.AddError("Error getting the quota reports", err.Error())
+		return
+	}
+
+	ids := map[string]bool{}
+	reportType := ""
+	if state.Filter != nil {
+		for _, id := range state.Filter.IDs {
+			ids[id.ValueString()] = true
+		}
+		reportType = state.Filter.Type.ValueString()
+	}
+
+	state.QuotaReports = []models.QuotaReportDetailModel{}
+	for _, report := range reports {
+		if len(ids) > 0 && !ids[report.GetId()] {
+			continue
+		}
+		if reportType != "" && report.GetType() != reportType {
+			continue
+		}
+		quotas, err := GetQuotaReportContents(ctx, client, report.GetId())
+		if err != nil {
+			diags.AddError("Error getting the quota report contents", err.Error())
+			return
+		}
+		state.QuotaReports = append(state.QuotaReports, models.QuotaReportDetailModel{
+			ID:        types.StringValue(report.GetId()),
+			Type:      types.StringValue(report.GetType()),
+			Generated: types.Int64Value(report.GetGenerated()),
+			Quotas:    quotas,
+		})
+	}
+	state.ID = types.StringValue("quota_report_datasource")
+	return
+}
@@ -20,15 +20,30 @@ package helper
 import (
 	"context"
 	powerscale "dell/powerscale-go-client"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
 	"terraform-provider-powerscale/powerscale/models"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+const (
+	// RoleMembershipModeAuthoritative the role members are exactly the members in the configuration.
+	RoleMembershipModeAuthoritative = "authoritative"
+	// RoleMembershipModeAdditive the members in the configuration are added to the role, other members are left untouched.
+	RoleMembershipModeAdditive = "additive"
+	// RoleConfiguredMembersKey the private state key of the member IDs in the role configuration.
+	RoleConfiguredMembersKey = "configured_members"
+)
+
 // GetRoles Get a list of Roles.
 func GetRoles(ctx context.Context, client *client.Client, state models.RoleDataSourceModel) (*powerscale.V14AuthRoles, error) {
 	roleParams := client.PscaleOpenAPIClient.AuthApi.ListAuthv14AuthRoles(ctx)
@@ -194,3 +209,204 @@ func ReorderRolePrivileges(localPrivileges types.List, remotePrivileges types.Li
 	orderedPrivilegeList, _ := types.ListValue(types.ObjectType{AttrTypes: rolePrivilegesType}, orderedPrivileges)
 	return orderedPrivilegeList, nil
 }
+
+// ReorderRoleMembersWithMode Reorder role members according to the membership mode of the role.
+// In the additive membership mode, only the locally managed members are kept.
+func ReorderRoleMembersWithMode(membershipMode types.String, localMembers types.List, remoteMembers types.List) (types.List, error) {
+	if membershipMode.ValueString() == RoleMembershipModeAdditive {
+		return FilterRoleMembers(localMembers, remoteMembers)
+	}
+	return ReorderRoleMembers(localMembers, remoteMembers)
+}
+
+// FilterRoleMembers Keeps only the remote role members that are managed locally, in the local order.
+// It is used for the additive membership mode, where members added outside of the role resource are ignored.
+func FilterRoleMembers(localMembers types.List, remoteMembers types.List) (types.List, error) {
+	orderedMemberList, err := ReorderRoleMembers(localMembers, remoteMembers)
+	if err != nil {
+		return types.List{}, err
+	}
+
+	localMemberIDs, err := getRoleMemberIDs(localMembers)
+	if err != nil {
+		return types.List{}, err
+	}
+
+	var managedMembers []attr.Value
+	for _, member := range orderedMemberList.Elements() {
+		memberObj, ok := member.(basetypes.ObjectValue)
+		if !ok {
+			return types.List{}, errors.New("failed to filter role members")
+		}
+		memberID, ok := memberObj.Attributes()["id"].(basetypes.StringValue)
+		if ok && slices.ContainsFunc(localMemberIDs, func(id string) bool { return strings.EqualFold(id, memberID.ValueString()) }) {
+			managedMembers = append(managedMembers, member)
+		}
+	}
+
+	roleMembersType := map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+		"type": types.StringType,
+	}
+
+	managedMemberList, _ := types.ListValue(types.ObjectType{AttrTypes: roleMembersType}, managedMembers)
+	return managedMemberList, nil
+}
+
+// getRoleMemberIDs returns the persona IDs of the role members.
+func getRoleMemberIDs(members types.List) ([]string, error) {
+	var memberIDs []string
+	for _, member := range members.Elements() {
+		memberObj, ok := member.(basetypes.ObjectValue)
+		if !ok || memberObj.IsNull() || memberObj.IsUnknown() {
+			return nil, errors.New("failed to read role members")
+		}
+		memberID, ok := memberObj.Attributes()["id"].(basetypes.StringValue)
+		if !ok || memberID.IsNull() || memberID.IsUnknown() {
+			continue
+		}
+		memberIDs = append(memberIDs, memberID.ValueString())
+	}
+	return memberIDs, nil
+}
+
+// AddRoleMember Adds a member to role in specific zone.
+func AddRoleMember(ctx context.Context, client *client.Client, roleID string, member powerscale.V1AuthAccessAccessItemFileGroup, zone string) error {
+	roleParam := client.PscaleOpenAPIClient.AuthRolesApi.CreateAuthRolesv7RoleMember(ctx, roleID).V7RoleMember(member)
+	if zone != "" {
+		roleParam = roleParam.Zone(zone)
+	}
+	if _, _, err := roleParam.Execute(); err != nil {
+		errStr := constants.AddRoleMemberErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error assign member - %s to role - %s: %s", getPersonaString(member), roleID, message)
+	}
+	return nil
+}
+
+// RemoveRoleMember Removes a member from role in specific zone.
+func RemoveRoleMember(ctx context.Context, client *client.Client, roleID, memberID, zone string) error {
+	roleParam := client.PscaleOpenAPIClient.AuthApi.DeleteAuthv7RolesRoleMember(ctx, memberID, roleID)
+	if zone != "" {
+		roleParam = roleParam.Zone(zone)
+	}
+	if _, err := roleParam.Execute(); err != nil {
+		errStr := constants.DeleteRoleMemberErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error remove member - %s from role - %s: %s", memberID, roleID, message)
+	}
+	return nil
+}
+
+// getPersonaString returns the persona ID, or the persona name if ID is absent.
+func getPersonaString(persona powerscale.V1AuthAccessAccessItemFileGroup) string {
+	if persona.Id != nil {
+		return *persona.Id
+	}
+	if persona.Name != nil {
+		return *persona.Name
+	}
+	return ""
+}
+
+// GetConfiguredRoleMembers returns the persona IDs of the configured role members, encoded to be kept in the private state.
+func GetConfiguredRoleMembers(members types.List) ([]byte, error) {
+	memberIDs, err := getRoleMemberIDs(members)
+	if err != nil {
+		return nil, err
+	}
+	if memberIDs == nil {
+		memberIDs = []string{}
+	}
+	return json.Marshal(memberIDs)
+}
+
+// GetPreviousRoleMemberIDs returns the persona IDs of the role members managed before the update.
+// The state of an authoritative role holds every member of the role, including the members added outside of the resource,
+// so the configured members kept in the private state are used instead.
+func GetPreviousRoleMemberIDs(state models.RoleResourceModel, configuredMembers []byte) ([]string, error) {
+	if state.MembershipMode.ValueString() == RoleMembershipModeAdditive {
+		return getRoleMemberIDs(state.Members)
+	}
+	var memberIDs []string
+	if len(configuredMembers) == 0 {
+		return memberIDs, nil
+	}
+	if err := json.Unmarshal(configuredMembers, &memberIDs); err != nil {
+		return nil, fmt.Errorf("failed to read configured role members: %s", err.Error())
+	}
+	return memberIDs, nil
+}
+
+// UpdateRoleMembers Adds and removes role members according to the changes between the previously managed members and plan.
+// Members of the role which are not managed by the plan or previously are left untouched.
+func UpdateRoleMembers(ctx context.Context, client *client.Client, stateMemberIDs []string, plan models.RoleResourceModel) (diags diag.Diagnostics) {
+	planMemberIDs, err := getRoleMemberIDs(plan.Members)
+	if err != nil {
+		diags.AddError("Error updating role members", err.Error())
+		return
+	}
+
+	roleID := plan.ID.ValueString()
+	for _, memberID := range stateMemberIDs {
+		if slices.ContainsFunc(planMemberIDs, func(id string) bool { return strings.EqualFold(id, memberID) }) {
+			continue
+		}
+		if err := RemoveRoleMember(ctx, client, roleID, memberID, plan.Zone.ValueString()); err != nil {
+			diags.AddError(fmt.Sprintf("Error removing member - %s from role", memberID), err.Error())
+		}
+	}
+
+	for _, memberID := range planMemberIDs {
+		if slices.ContainsFunc(stateMemberIDs, func(id string) bool { return strings.EqualFold(id, memberID) }) {
+			continue
+		}
+		member := powerscale.V1AuthAccessAccessItemFileGroup{Id: New(memberID)}
+		if err := AddRoleMember(ctx, client, roleID, member, plan.Zone.ValueString()); err != nil {
+			diags.AddError(fmt.Sprintf("Error adding member - %s to role", memberID), err.Error())
+		}
+	}
+	return
+}
+
+// FindRoleMember Returns the member of role matching the persona ID or name.
+func FindRoleMember(role powerscale.V14AuthRoleExtended, memberID, memberName string) *powerscale.V1AuthAccessAccessItemFileGroup {
+	for _, member := range role.Members {
+		if memberID != "" && member.Id != nil && strings.EqualFold(*member.Id, memberID) {
+			matched := member
+			return &matched
+		}
+		if memberID == "" && memberName != "" && member.Name != nil && strings.EqualFold(*member.Name, memberName) {
+			matched := member
+			return &matched
+		}
+	}
+	return nil
+}
+
+// GetRoleMember Retrieves the member of a role. A nil member is returned if the role or the member does not exist.
+func GetRoleMember(ctx context.Context, client *client.Client, model models.RoleMemberResourceModel) (*powerscale.V1AuthAccessAccessItemFileGroup, error) {
+	roleModel := models.RoleResourceModel{
+		ID:   model.RoleID,
+		Zone: model.Zone,
+	}
+	roleRes, err := GetRole(ctx, client, roleModel)
+	if err != nil {
+		errStr := constants.ReadRoleErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting role - %s: %s", model.RoleID.ValueString(), message)
+	}
+	if roleRes == nil || len(roleRes.Roles) <= 0 {
+		return nil, nil
+	}
+	return FindRoleMember(roleRes.Roles[0], model.MemberID.ValueString(), model.MemberName.ValueString()), nil
+}
+
+// UpdateRoleMemberResourceState Updates the role member resource state from the member of the role.
+func UpdateRoleMemberResourceState(model *models.RoleMemberResourceModel, member *powerscale.V1AuthAccessAccessItemFileGroup) {
+	model.MemberID = types.StringPointerValue(member.Id)
+	model.MemberName = types.StringPointerValue(member.Name)
+	model.MemberType = types.StringPointerValue(member.Type)
+	model.ID = types.StringValue(fmt.Sprintf("%s/%s", model.RoleID.ValueString(), model.MemberID.ValueString()))
+}
@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"strconv"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpdateSmartPoolsJobState updates the resource state with the job progress.
+func UpdateSmartPoolsJobState(state *models.SmartPoolsJobResourceModel, job *powerscale.V10JobJobExtended) {
+	state.State = types.StringValue(job.State)
+	state.Progress = types.StringValue(job.GetProgress())
+	state.CurrentPhase = types.Int64Value(int64(job.GetCurrentPhase()))
+	state.TotalPhases = types.Int64Value(int64(job.GetTotalPhases()))
+	state.StartTime = types.Int64Null()
+	if startTime := int64(job.GetStartTime()); startTime > 0 {
+		state.StartTime = types.Int64Value(startTime)
+	}
+	state.EndTime = types.Int64Null()
+	if endTime := int64(job.GetEndTime()); endTime > 0 {
+		state.EndTime = types.Int64Value(endTime)
+	}
+}
+
+// ManageSmartPoolsJob starts a SmartPools or SmartPoolsTree job and optionally waits for it to finish.
+// The returned state carries the job ID whenever the job was started, even if waiting for it failed.
+func ManageSmartPoolsJob(ctx context.Context, client *client.Client, plan models.SmartPoolsJobResourceModel) (state models.SmartPoolsJobResourceModel, diags diag.Diagnostics) {
+	state = plan
+	state.ID = types.StringNull()
+	state.JobID = types.Int64Null()
+	state.State = types.StringNull()
+	state.Progress = types.StringNull()
+	state.CurrentPhase = types.Int64Null()
+	state.TotalPhases = types.Int64Null()
+	state.StartTime = types.Int64Null()
+	state.EndTime = types.Int64Null()
+	state.ErrorMessage = types.StringNull()
+
+	payload := powerscale.V10JobJob{
+		Type:     plan.JobType.ValueString(),
+		AllowDup: plan.AllowDup.ValueBoolPointer(),
+	}
+	if !plan.Paths.IsNull() && !plan.Paths.IsUnknown() {
+		var paths []string
+		if diags = plan.Paths.ElementsAs(ctx, &paths, false); diags.HasError() {
+			return
+		}
+		payload.Paths = paths
+	}
+	if len(payload.Paths) == 0 && payload.Type == "SmartPoolsTree" {
+		diags.AddError("Error starting the SmartPools job", "paths must be set for a SmartPoolsTree job")
+		return
+	}
+	if !plan.Policy.IsNull() && !plan.Policy.IsUnknown() {
+		payload.Policy = plan.Policy.ValueStringPointer()
+	}
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		payload.Priority = New(int32(plan.Priority.ValueInt64()))
+	}
+
+	createResponse, err := CreateSnapshotRestoreJob(ctx, client, payload)
+	if err != nil {
+		errStr := constants.CreateSmartPoolsJobErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError(fmt.Sprintf("Error starting the %s job", payload.Type), message)
+		return
+	}
+	jobID := strconv.Itoa(int(createResponse.Id))
+	state.ID = types.StringValue(jobID)
+	state.JobID = types.Int64Value(int64(createResponse.Id))
+
+	job, err := GetSnapshotRestoreJob(ctx, client, jobID)
+	if err != nil {
+		errStr := constants.ReadSmartPoolsJobErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		state.ErrorMessage = types.StringValue(message)
+		diags.AddError(fmt.Sprintf("Error getting the %s job", payload.Type), message)
+		return
+	}
+	UpdateSmartPoolsJobState(&state, job)
+	if !plan.Wait.ValueBool() {
+		return
+	}
+
+	interval := time.Duration(plan.PollInterval.ValueInt64()) * time.Second
+	timeout := time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	job, err = WaitForJob(ctx, client, jobID, interval, timeout)
+	if job != nil {
+		UpdateSmartPoolsJobState(&state, job)
+	}
+	if err != nil {
+		state.ErrorMessage = types.StringValue(err.Error())
+		diags.AddError(fmt.Sprintf("Error waiting for the %s job", payload.Type), err.Error())
+		return
+	}
+	if job.State != "succeeded" {
+		message := fmt.Sprintf("job %s ended in state %s, progress: %s", jobID, job.State, job.GetProgress())
+		state.ErrorMessage = types.StringValue(message)
+		diags.AddError(fmt.Sprintf("The %s job did not succeed", payload.Type), message)
+	}
+	return
+}
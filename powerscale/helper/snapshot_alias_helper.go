@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"math"
+	"slices"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SnapshotAliasLiveTarget is the target of a snapshot alias pointing to the live file system.
+const SnapshotAliasLiveTarget = "HEAD"
+
+// GetSnapshotAlias retrieves the snapshot alias by ID or name.
+func GetSnapshotAlias(ctx context.Context, client *client.Client, aliasID string) (*powerscale.V1SnapshotAliasExtended, error) {
+	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.GetSnapshotv1SnapshotAlias(ctx, aliasID).Execute()
+	if err != nil {
+		errStr := constants.ReadSnapshotAliasErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting snapshot alias - %s : %s", aliasID, message)
+	}
+	if len(result.Aliases) == 0 {
+		return nil, fmt.Errorf("error getting snapshot alias - %s : snapshot alias not found", aliasID)
+	}
+	return &result.Aliases[0], nil
+}
+
+// ListSnapshotAliases retrieves all the snapshot aliases.
+func ListSnapshotAliases(ctx context.Context, client *client.Client) ([]powerscale.V1SnapshotAliasExtended, error) {
+	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotAliases(ctx).Execute()
+	if err != nil {
+		errStr := constants.ReadSnapshotAliasErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting the list of snapshot aliases: %s", message)
+	}
+	aliases := result.Aliases
+	for result.Resume != nil && *result.Resume != "" {
+		result, _, err = client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotAliases(ctx).Resume(*result.Resume).Execute()
+		if err != nil {
+			errStr := constants.ReadSnapshotAliasErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return nil, fmt.Errorf("error getting the list of snapshot aliases: %s", message)
+		}
+		aliases = append(aliases, result.Aliases...)
+	}
+	return aliases, nil
+}
+
+// CreateSnapshotAlias creates the snapshot alias and returns its ID.
+func CreateSnapshotAlias(ctx context.Context, client *client.Client, plan models.SnapshotAliasResourceModel) (string, error) {
+	createBody := powerscale.V1SnapshotAlias{
+		Name:   plan.Name.ValueString(),
+		Target: plan.Target.ValueString(),
+	}
+	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.CreateSnapshotv1SnapshotAlias(ctx).V1SnapshotAlias(createBody).Execute()
+	if err != nil {
+		errStr := constants.CreateSnapshotAliasErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error creating snapshot alias - %s : %s", plan.Name.ValueString(), message)
+	}
+	return fmt.Sprint(result.Id), nil
+}
+
+// UpdateSnapshotAlias renames the snapshot alias or points it to another snapshot.
+func UpdateSnapshotAlias(ctx context.Context, client *client.Client, state, plan models.SnapshotAliasResourceModel) error {
+	var updateBody powerscale.V1SnapshotAliasExtendedExtended
+	if !plan.Name.Equal(state.Name) {
+		updateBody.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.Target.Equal(state.Target) {
+		updateBody.Target = plan.Target.ValueStringPointer()
+	}
+	if updateBody.Name == nil && updateBody.Target == nil {
+		return nil
+	}
+	_, err := client.PscaleOpenAPIClient.SnapshotApi.UpdateSnapshotv1SnapshotAlias(ctx, state.ID.ValueString()).V1SnapshotAlias(updateBody).Execute()
+	if err != nil {
+		errStr := constants.UpdateSnapshotAliasErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error updating snapshot alias - %s : %s", state.ID.ValueString(), message)
+	}
+	return nil
+}
+
+// DeleteSnapshotAlias deletes the snapshot alias.
+func DeleteSnapshotAlias(ctx context.Context, client *client.Client, aliasID string) error {
+	_, err := client.PscaleOpenAPIClient.SnapshotApi.DeleteSnapshotv1SnapshotAlias(ctx, aliasID).Execute()
+	if err != nil {
+		errStr := constants.DeleteSnapshotAliasErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error deleting snapshot alias - %s : %s", aliasID, message)
+	}
+	return nil
+}
+
+// snapshotAliasTargetID returns the target ID of the alias, -1 when the alias points to the live file system.
+func snapshotAliasTargetID(alias *powerscale.V1SnapshotAliasExtended) int64 {
+	// Max uint64 is returned when aliasing to live filesystem
+	if uint64(alias.GetTargetId()) == math.MaxUint64 {
+		return -1
+	}
+	return int64(alias.GetTargetId()) // #nosec G115 --- validated, set to -1 if targetID is max uint64
+}
+
+// SnapshotAliasDetailMapper maps the snapshot alias to the datasource model.
+func SnapshotAliasDetailMapper(alias *powerscale.V1SnapshotAliasExtended) models.SnapshotAliasDetailModel {
+	return models.SnapshotAliasDetailModel{
+		ID:         types.StringValue(fmt.Sprint(alias.GetId())),
+		Name:       types.StringValue(alias.GetName()),
+		TargetID:   types.Int64Value(snapshotAliasTargetID(alias)),
+		TargetName: types.StringValue(alias.GetTargetName()),
+	}
+}
+
+// UpdateSnapshotAliasState updates the resource state from the snapshot alias.
+// The configured target is kept as long as it still designates the snapshot the alias points to.
+func UpdateSnapshotAliasState(state *models.SnapshotAliasResourceModel, alias *powerscale.V1SnapshotAliasExtended) {
+	detail := SnapshotAliasDetailMapper(alias)
+	state.ID = detail.ID
+	state.Name = detail.Name
+	state.TargetID = detail.TargetID
+	state.TargetName = detail.TargetName
+
+	target := state.Target.ValueString()
+	if detail.TargetID.ValueInt64() == -1 {
+		if target != SnapshotAliasLiveTarget {
+			state.Target = types.StringValue(SnapshotAliasLiveTarget)
+		}
+		return
+	}
+	if target != detail.TargetName.ValueString() && target != fmt.Sprint(detail.TargetID.ValueInt64()) {
+		state.Target = detail.TargetName
+	}
+}
+
+// ManageDataSourceSnapshotAlias gets the snapshot aliases matching the filter and sets the state.
+func ManageDataSourceSnapshotAlias(ctx context.Context, client *client.Client, state *models.SnapshotAliasDataSourceModel) (diags diag.Diagnostics) {
+	aliases, err := ListSnapshotAliases(ctx, client)
+	if err != nil {
+		diags.AddError("Error getting the snapshot aliases", err.Error())
+		return
+	}
+
+	var names []string
+	if state.Filter != nil {
+		for _, name := range state.Filter.Names {
+			names = append(names, name.ValueString())
+		}
+	}
+
+	state.SnapshotAliases = []models.SnapshotAliasDetailModel{}
+	for i := range aliases {
+		if len(names) > 0 && !slices.Contains(names, aliases[i].GetName()) {
+			continue
+		}
+		state.SnapshotAliases = append(state.SnapshotAliases, SnapshotAliasDetailMapper(&aliases[i]))
+	}
+	state.ID = types.StringValue("snapshot_alias_datasource")
+	return
+}
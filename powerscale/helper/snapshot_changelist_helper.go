@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// snapshotChangelistPageSize is the maximum number of changelist entries fetched per request.
+	snapshotChangelistPageSize = 1000
+	// SnapshotChangelistDefaultLimit is the default maximum number of changelist entries kept in the state.
+	SnapshotChangelistDefaultLimit = 1000
+	// SnapshotChangelistDefaultPollInterval is the default number of seconds between two polls of the ChangelistCreate job.
+	SnapshotChangelistDefaultPollInterval = 5
+	// SnapshotChangelistDefaultTimeout is the default number of seconds to wait for the ChangelistCreate job.
+	SnapshotChangelistDefaultTimeout = 3600
+)
+
+// SnapshotChangelistChangeTypes maps the changelist change type names to the bits of the change_types bitmask.
+var SnapshotChangelistChangeTypes = map[string]int32{
+	"added":        0x1,
+	"removed":      0x2,
+	"path_changed": 0x4,
+	"modified":     0x8,
+}
+
+// snapshotChangelistChangeTypeOrder is the order in which the change types are reported.
+var snapshotChangelistChangeTypeOrder = []string{"added", "removed", "path_changed", "modified"}
+
+// CreateSnapshotChangelist runs the ChangelistCreate job between the two snapshots, waits for it to finish and returns the job ID.
+// Waiting stops with an error when the job is cancelled, paused or does not finish within the timeout.
+func CreateSnapshotChangelist(ctx context.Context, client *client.Client, olderSnapshotID, newerSnapshotID int64, interval, timeout time.Duration) (int64, error) {
+	payload := powerscale.V10JobJob{
+		Type: "ChangelistCreate",
+		ChangelistcreateParams: &powerscale.V1JobJobChangelistcreateParams{
+			OlderSnapid: int32(olderSnapshotID), // #nosec G115 --- snapshot IDs are 32 bit on PowerScale
+			NewerSnapid: int32(newerSnapshotID), // #nosec G115 --- snapshot IDs are 32 bit on PowerScale
+		},
+	}
+	createResponse, err := CreateSnapshotRestoreJob(ctx, client, payload)
+	if err != nil {
+		errStr := constants.CreateSnapshotChangelistErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return 0, fmt.Errorf("error creating the ChangelistCreate job : %s", message)
+	}
+	jobID := fmt.Sprint(createResponse.Id)
+	jobResponse, err := WaitForJob(ctx, client, jobID, interval, timeout)
+	if err != nil {
+		return 0, fmt.Errorf("error waiting for the ChangelistCreate job %s : %s", jobID, err.Error())
+	}
+	if jobResponse.State != "succeeded" {
+		return 0, fmt.Errorf("the ChangelistCreate job %s stopped in state %s", jobID, jobResponse.State)
+	}
+	return int64(createResponse.Id), nil
+}
+
+// GetSnapshotChangelistID returns the ID of the changelist between the two snapshots.
+func GetSnapshotChangelistID(olderSnapshotID, newerSnapshotID int64) string {
+	return fmt.Sprintf("%d_%d", olderSnapshotID, newerSnapshotID)
+}
+
+// GetSnapshotChangelist retrieves the changelist.
+func GetSnapshotChangelist(ctx context.Context, client *client.Client, changelistID string) (*powerscale.V1SnapshotChangelistExtended, *http.Response, error) {
+	result, httpResp, err := client.PscaleOpenAPIClient.SnapshotApi.GetSnapshotv1SnapshotChangelist(ctx, changelistID).Execute()
+	if err != nil {
+		errStr := constants.ReadSnapshotChangelistErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, httpResp, fmt.Errorf("error getting snapshot changelist %s : %s", changelistID, message)
+	}
+	if len(result.Changelists) == 0 {
+		return nil, httpResp, fmt.Errorf("error getting snapshot changelist %s : snapshot changelist not found", changelistID)
+	}
+	return &result.Changelists[0], httpResp, nil
+}
+
+// DeleteSnapshotChangelist deletes the changelist. A changelist which no longer exists is considered deleted.
+func DeleteSnapshotChangelist(ctx context.Context, client *client.Client, changelistID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.SnapshotApi.DeleteSnapshotv1SnapshotChangelist(ctx, changelistID).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		errStr := constants.DeleteSnapshotChangelistErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error deleting snapshot changelist %s : %s", changelistID, message)
+	}
+	return nil
+}
+
+// UpdateSnapshotChangelistState updates the resource state from the changelist.
+func UpdateSnapshotChangelistState(state *models.SnapshotChangelistResourceModel, changelist *powerscale.V1SnapshotChangelistExtended) error {
+	var olderSnapshotID, newerSnapshotID int64
+	if _, err := fmt.Sscanf(changelist.GetId(), "%d_%d", &olderSnapshotID, &newerSnapshotID); err != nil {
+		return fmt.Errorf("unexpected snapshot changelist ID %s", changelist.GetId())
+	}
+	state.ID = types.StringValue(changelist.GetId())
+	state.OlderSnapshotID = types.Int64Value(olderSnapshotID)
+	state.NewerSnapshotID = types.Int64Value(newerSnapshotID)
+	state.NumEntries = types.Int64Value(int64(changelist.GetNumEntries()))
+	state.Root = types.StringValue(changelist.GetRoot())
+	if state.OlderSnapshot.IsNull() || state.OlderSnapshot.IsUnknown() {
+		state.OlderSnapshot = types.StringValue(fmt.Sprint(olderSnapshotID))
+	}
+	if state.NewerSnapshot.IsNull() || state.NewerSnapshot.IsUnknown() {
+		state.NewerSnapshot = types.StringValue(fmt.Sprint(newerSnapshotID))
+	}
+	if state.JobID.IsUnknown() {
+		state.JobID = types.Int64Null()
+	}
+	if state.PollInterval.IsNull() || state.PollInterval.IsUnknown() {
+		state.PollInterval = types.Int64Value(SnapshotChangelistDefaultPollInterval)
+	}
+	if state.Timeout.IsNull() || state.Timeout.IsUnknown() {
+		state.Timeout = types.Int64Value(SnapshotChangelistDefaultTimeout)
+	}
+	return nil
+}
+
+// ListSnapshotChangelistEntries retrieves a page of changelist entries, starting from the resume token if set.
+func ListSnapshotChangelistEntries(ctx context.Context, client *client.Client, changelistID string, limit int32, resume string) (*powerscale.V1ChangelistLins, error) {
+	request := client.PscaleOpenAPIClient.SnapshotChangelistsApi.ListSnapshotChangelistsv1ChangelistLins(ctx, changelistID)
+	if resume != "" {
+		request = request.Resume(resume)
+	} else {
+		request = request.Limit(limit)
+	}
+	result, _, err := request.Execute()
+	if err != nil {
+		errStr := constants.ReadSnapshotChangelistEntriesErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting the entries of snapshot changelist %s : %s", changelistID, message)
+	}
+	return result, nil
+}
+
+// GetSnapshotChangelistChangeTypes decodes the change_types bitmask of a changelist entry.
+func GetSnapshotChangelistChangeTypes(changeTypes int32) []types.String {
+	result := []types.String{}
+	for _, name := range snapshotChangelistChangeTypeOrder {
+		if changeTypes&SnapshotChangelistChangeTypes[name] != 0 {
+			result = append(result, types.StringValue(name))
+		}
+	}
+	return result
+}
+
+// snapshotChangelistEntryMatches checks whether the changelist entry matches the filter.
+func snapshotChangelistEntryMatches(entry *powerscale.V1ChangelistLinsLin, filter *models.SnapshotChangelistEntryFilterModel) bool {
+	if filter == nil {
+		return true
+	}
+	if prefix := filter.PathPrefix.ValueString(); prefix != "" && !strings.HasPrefix(entry.GetPath(), prefix) {
+		return false
+	}
+	if len(filter.ChangeTypes) == 0 {
+		return true
+	}
+	for _, changeType := range filter.ChangeTypes {
+		if entry.GetChangeTypes()&SnapshotChangelistChangeTypes[changeType.ValueString()] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ManageDataSourceSnapshotChangelistEntry pages through the changelist entries matching the filter until the limit is reached, and sets the state.
+func ManageDataSourceSnapshotChangelistEntry(ctx context.Context, client *client.Client, state *models.SnapshotChangelistEntryDataSourceModel) (diags diag.Diagnostics) {
+	if state.Limit.IsNull() || state.Limit.IsUnknown() {
+		state.Limit = types.Int64Value(SnapshotChangelistDefaultLimit)
+	}
+	limit := state.Limit.ValueInt64()
+	changelistID := state.Changelist.ValueString()
+
+	state.Entries = []models.SnapshotChangelistEntryModel{}
+	state.Truncated = types.BoolValue(false)
+	resume := ""
+	for {
+		page, err := ListSnapshotChangelistEntries(ctx, client, changelistID, snapshotChangelistPageSize, resume)
+		if err != nil {
+			diags.AddError("Error getting the snapshot changelist entries", err.Error())
+			return
+		}
+		for i := range page.Lins {
+			entry := &page.Lins[i]
+			if !snapshotChangelistEntryMatches(entry, state.Filter) {
+				continue
+			}
+			if int64(len(state.Entries)) == limit {
+				state.Truncated = types.BoolValue(true)
+				break
+			}
+			state.Entries = append(state.Entries, models.SnapshotChangelistEntryModel{
+				Lin:         types.Int64Value(int64(entry.GetId())),
+				Path:        types.StringValue(entry.GetPath()),
+				ChangeTypes: GetSnapshotChangelistChangeTypes(entry.GetChangeTypes()),
+				Size:        types.Int64Value(int64(entry.GetSize())),
+			})
+		}
+		if state.Truncated.ValueBool() || page.Resume == nil || *page.Resume == "" {
+			break
+		}
+		resume = *page.Resume
+	}
+	state.ID = types.StringValue("snapshot_changelist_entry_datasource")
+	return
+}
@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetLockedSnapshot retrieves the ID and name of a snapshot given its name or ID.
+func GetLockedSnapshot(ctx context.Context, client *client.Client, snapshot string) (string, string, error) {
+	snap, err := GetSpecificSnapshot(ctx, client, snapshot)
+	if err != nil {
+		errStr := constants.ReadSnapshotErrorMessage + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", "", fmt.Errorf("error getting snapshot - %s : %s", snapshot, message)
+	}
+	return fmt.Sprint(snap.Id), snap.Name, nil
+}
+
+// GetSnapshotLock retrieves the lock of a snapshot.
+func GetSnapshotLock(ctx context.Context, client *client.Client, snapshotID, lockID string) (*powerscale.V1SnapshotLockExtended, *http.Response, error) {
+	result, httpResp, err := client.PscaleOpenAPIClient.SnapshotApi.GetSnapshotv1SnapshotLock(ctx, lockID, snapshotID).Execute()
+	if err != nil {
+		errStr := constants.ReadSnapshotLockErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, httpResp, fmt.Errorf("error getting lock %s of snapshot %s : %s", lockID, snapshotID, message)
+	}
+	if len(result.Locks) == 0 {
+		return nil, httpResp, fmt.Errorf("error getting lock %s of snapshot %s : snapshot lock not found", lockID, snapshotID)
+	}
+	return &result.Locks[0], httpResp, nil
+}
+
+// ListSnapshotLocks retrieves all the locks of a snapshot.
+func ListSnapshotLocks(ctx context.Context, client *client.Client, snapshotID string) ([]powerscale.V1SnapshotLockExtended, error) {
+	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotLocks(ctx, snapshotID).Execute()
+	if err != nil {
+		errStr := constants.ReadSnapshotLockErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting the locks of snapshot %s : %s", snapshotID, message)
+	}
+	locks := result.Locks
+	for result.Resume != nil && *result.Resume != "" {
+		result, _, err = client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotLocks(ctx, snapshotID).Resume(*result.Resume).Execute()
+		if err != nil {
+			errStr := constants.ReadSnapshotLockErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return nil, fmt.Errorf("error getting the locks of snapshot %s : %s", snapshotID, message)
+		}
+		locks = append(locks, result.Locks...)
+	}
+	return locks, nil
+}
+
+// CreateSnapshotLock creates a lock on the snapshot and returns its ID.
+func CreateSnapshotLock(ctx context.Context, client *client.Client, snapshotID string, plan models.SnapshotLockResourceModel) (string, error) {
+	createBody := powerscale.V1SnapshotLock{
+		Comment: plan.Comment.ValueStringPointer(),
+	}
+	if expires := plan.Expires.ValueInt64(); expires != 0 {
+		createBody.Expires = New(int32(expires)) // #nosec G115 --- validated by the schema
+	}
+	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.CreateSnapshotv1SnapshotLock(ctx, snapshotID).V1SnapshotLock(createBody).Execute()
+	if err != nil {
+		errStr := constants.CreateSnapshotLockErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error creating lock on snapshot %s : %s", snapshotID, message)
+	}
+	return fmt.Sprint(result.Id), nil
+}
+
+// UpdateSnapshotLock updates the expiration of the snapshot lock.
+func UpdateSnapshotLock(ctx context.Context, client *client.Client, state, plan models.SnapshotLockResourceModel) error {
+	if plan.Expires.IsUnknown() || plan.Expires.Equal(state.Expires) {
+		return nil
+	}
+	updateBody := powerscale.V1SnapshotLockExtendedExtended{
+		Expires: New(int32(plan.Expires.ValueInt64())), // #nosec G115 --- validated by the schema
+	}
+	_, err := client.PscaleOpenAPIClient.SnapshotApi.UpdateSnapshotv1SnapshotLock(ctx, state.ID.ValueString(), state.SnapshotID.ValueString()).V1SnapshotLock(updateBody).Execute()
+	if err != nil {
+		errStr := constants.UpdateSnapshotLockErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error updating lock %s of snapshot %s : %s", state.ID.ValueString(), state.SnapshotID.ValueString(), message)
+	}
+	return nil
+}
+
+// DeleteSnapshotLock removes the lock from the snapshot. A lock which no longer exists is considered deleted.
+func DeleteSnapshotLock(ctx context.Context, client *client.Client, snapshotID, lockID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.SnapshotApi.DeleteSnapshotv1SnapshotLock(ctx, lockID, snapshotID).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		errStr := constants.DeleteSnapshotLockErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error deleting lock %s of snapshot %s : %s", lockID, snapshotID, message)
+	}
+	return nil
+}
+
+// SnapshotLockDetailMapper maps the snapshot lock to the datasource model.
+func SnapshotLockDetailMapper(snapshotID, snapshotName string, lock *powerscale.V1SnapshotLockExtended) models.SnapshotLockDetailModel {
+	return models.SnapshotLockDetailModel{
+		ID:           types.StringValue(fmt.Sprint(lock.GetId())),
+		SnapshotID:   types.StringValue(snapshotID),
+		SnapshotName: types.StringValue(snapshotName),
+		Comment:      types.StringValue(lock.GetComment()),
+		Expires:      types.Int64Value(int64(lock.GetExpires())),
+		LockCount:    types.Int64Value(int64(lock.GetCount())),
+	}
+}
+
+// UpdateSnapshotLockState updates the resource state from the snapshot lock.
+func UpdateSnapshotLockState(state *models.SnapshotLockResourceModel, snapshotID string, lock *powerscale.V1SnapshotLockExtended) {
+	state.ID = types.StringValue(fmt.Sprint(lock.GetId()))
+	state.SnapshotID = types.StringValue(snapshotID)
+	state.Comment = types.StringValue(lock.GetComment())
+	state.Expires = types.Int64Value(int64(lock.GetExpires()))
+	state.LockCount = types.Int64Value(int64(lock.GetCount()))
+	if state.Snapshot.IsNull() || state.Snapshot.IsUnknown() {
+		state.Snapshot = types.StringValue(snapshotID)
+	}
+}
+
+// ManageDataSourceSnapshotLock gets the locks of the snapshots in the filter, or of all the locked snapshots, and sets the state.
+func ManageDataSourceSnapshotLock(ctx context.Context, client *client.Client, state *models.SnapshotLockDataSourceModel) (diags diag.Diagnostics) {
+	type lockedSnapshot struct {
+		id, name string
+	}
+	var snapshots []lockedSnapshot
+	if state.Filter != nil && len(state.Filter.Snapshots) > 0 {
+		for _, snapshot := range state.Filter.Snapshots {
+			id, name, err := GetLockedSnapshot(ctx, client, snapshot.ValueString())
+			if err != nil {
+				diags.AddError("Error getting the snapshot", err.Error())
+				return
+			}
+			snapshots = append(snapshots, lockedSnapshot{id, name})
+		}
+	} else {
+		allSnapshots, err := GetAllSnapshots(ctx, client, &models.SnapshotDataSourceModel{SnapshotFilter: &models.SnapshotFilterType{}})
+		if err != nil {
+			errStr := constants.ListSnapshotErrorMessage + "with error: "
+			message := GetErrorString(err, errStr)
+			diags.AddError("Error getting the snapshots", message)
+			return
+		}
+		for _, snapshot := range allSnapshots {
+			if snapshot.HasLocks {
+				snapshots = append(snapshots, lockedSnapshot{fmt.Sprint(snapshot.Id), snapshot.Name})
+			}
+		}
+	}
+
+	state.SnapshotLocks = []models.SnapshotLockDetailModel{}
+	for _, snapshot := range snapshots {
+		locks, err := ListSnapshotLocks(ctx, client, snapshot.id)
+		if err != nil {
+			diags.AddError("Error getting the snapshot locks", err.Error())
+			return
+		}
+		for i := range locks {
+			state.SnapshotLocks = append(state.SnapshotLocks, SnapshotLockDetailMapper(snapshot.id, snapshot.name, &locks[i]))
+		}
+	}
+	state.ID = types.StringValue("snapshot_lock_datasource")
+	return
+}
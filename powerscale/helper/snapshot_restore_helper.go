@@ -47,6 +47,9 @@ func GetSnapshotRestoreJob(ctx context.Context, client *client.Client, jobID str
 	if err != nil {
 		return nil, err
 	}
+	if len(response.Jobs) == 0 {
+		return nil, fmt.Errorf("job %s not found", jobID)
+	}
 	return &response.Jobs[0], err
 }
 
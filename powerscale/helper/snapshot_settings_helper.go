@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"terraform-provider-powerscale/client"
+)
+
+// GetSnapshotSettings retrieve snapshot settings.
+func GetSnapshotSettings(ctx context.Context, client *client.Client) (*powerscale.V1SnapshotSettings, error) {
+	snapshotSettings, _, err := client.PscaleOpenAPIClient.SnapshotApi.GetSnapshotv1SnapshotSettings(ctx).Execute()
+	return snapshotSettings, err
+}
+
+// UpdateSnapshotSettings update snapshot settings.
+func UpdateSnapshotSettings(ctx context.Context, client *client.Client, v1SnapshotSettings powerscale.V1SnapshotSettingsExtended) error {
+	_, err := client.PscaleOpenAPIClient.SnapshotApi.UpdateSnapshotv1SnapshotSettings(ctx).V1SnapshotSettings(v1SnapshotSettings).Execute()
+	return err
+}
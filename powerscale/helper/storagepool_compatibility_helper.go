@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateStoragepoolCompatibility creates a node class compatibility and returns its ID.
+func CreateStoragepoolCompatibility(ctx context.Context, client *client.Client, plan models.StoragepoolCompatibilityResourceModel) (string, error) {
+	var toCreate powerscale.V16StoragepoolCompatibilitiesClassActiveItem
+	if err := ReadFromState(ctx, &plan, &toCreate); err != nil {
+		return "", err
+	}
+	resp, _, err := client.PscaleOpenAPIClient.StoragepoolApi.CreateStoragepoolv16StoragepoolCompatibilitiesClassActiveItem(ctx).V16StoragepoolCompatibilitiesClassActiveItem(toCreate).Execute()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(resp.GetId()), nil
+}
+
+// GetStoragepoolCompatibility retrieves the node class compatibility.
+func GetStoragepoolCompatibility(ctx context.Context, client *client.Client, compatibilityID string) (*powerscale.V16StoragepoolCompatibilitiesClassActiveActiveItem, *http.Response, error) {
+	resp, httpResp, err := client.PscaleOpenAPIClient.StoragepoolApi.GetStoragepoolv16StoragepoolCompatibilitiesClassActiveById(ctx, compatibilityID).Execute()
+	if err != nil {
+		return nil, httpResp, err
+	}
+	if len(resp.Active) == 0 {
+		return nil, httpResp, fmt.Errorf("compatibility %s not found", compatibilityID)
+	}
+	return &resp.Active[0], httpResp, nil
+}
+
+// DeleteStoragepoolCompatibility deletes the node class compatibility.
+func DeleteStoragepoolCompatibility(ctx context.Context, client *client.Client, compatibilityID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.StoragepoolApi.DeleteStoragepoolv16StoragepoolCompatibilitiesClassActiveById(ctx, compatibilityID).Execute()
+	if err != nil && httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// UpdateStoragepoolCompatibilityState updates the resource state from the node class compatibility.
+func UpdateStoragepoolCompatibilityState(ctx context.Context, compatibility *powerscale.V16StoragepoolCompatibilitiesClassActiveActiveItem, state *models.StoragepoolCompatibilityResourceModel) error {
+	if err := CopyFieldsToNonNestedModel(ctx, compatibility, state); err != nil {
+		return err
+	}
+	state.ID = types.StringValue(fmt.Sprint(compatibility.GetId()))
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StoragepoolNodepoolSsdUsageAttrTypes returns the attribute types of the SSD usage of a node pool.
+func StoragepoolNodepoolSsdUsageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"avail_ssd_bytes":  types.StringType,
+		"free_ssd_bytes":   types.StringType,
+		"pct_used_ssd":     types.StringType,
+		"total_ssd_bytes":  types.StringType,
+		"usable_ssd_bytes": types.StringType,
+		"used_ssd_bytes":   types.StringType,
+	}
+}
+
+// GetStoragepoolNodepool retrieves the node pool by name or ID.
+func GetStoragepoolNodepool(ctx context.Context, client *client.Client, nodepoolID string) (*powerscale.V16StoragepoolNodepoolExtended, *http.Response, error) {
+	resp, httpResp, err := client.PscaleOpenAPIClient.StoragepoolApi.GetStoragepoolv16StoragepoolNodepool(ctx, nodepoolID).Execute()
+	if err != nil {
+		return nil, httpResp, err
+	}
+	if len(resp.Nodepools) == 0 {
+		return nil, httpResp, fmt.Errorf("node pool %s not found", nodepoolID)
+	}
+	return &resp.Nodepools[0], httpResp, nil
+}
+
+// CreateStoragepoolNodepool creates a manual node pool.
+func CreateStoragepoolNodepool(ctx context.Context, client *client.Client, plan models.StoragepoolNodepoolResourceModel) error {
+	var toCreate powerscale.V16StoragepoolNodepool
+	if err := ReadFromState(ctx, &plan, &toCreate); err != nil {
+		return err
+	}
+	_, _, err := client.PscaleOpenAPIClient.StoragepoolApi.CreateStoragepoolv16StoragepoolNodepool(ctx).V16StoragepoolNodepool(toCreate).Execute()
+	return err
+}
+
+// UpdateStoragepoolNodepool updates the node pool.
+func UpdateStoragepoolNodepool(ctx context.Context, client *client.Client, nodepoolID int64, plan models.StoragepoolNodepoolResourceModel) error {
+	var toUpdate powerscale.V16StoragepoolNodepoolExtendedExtended
+	if err := ReadFromState(ctx, &plan, &toUpdate); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.StoragepoolApi.UpdateStoragepoolv16StoragepoolNodepool(ctx, strconv.FormatInt(nodepoolID, 10)).V16StoragepoolNodepool(toUpdate).Execute()
+	return err
+}
+
+// DeleteStoragepoolNodepool deletes the node pool.
+func DeleteStoragepoolNodepool(ctx context.Context, client *client.Client, nodepoolID int64) error {
+	_, err := client.PscaleOpenAPIClient.StoragepoolApi.DeleteStoragepoolv16StoragepoolNodepool(ctx, strconv.FormatInt(nodepoolID, 10)).Execute()
+	return err
+}
+
+// getStoragepoolNodepoolTier returns the tier of the node pool, null if the node pool is not in a tier.
+// The prior tier may be configured by name or ID, it is kept when it resolves to the tier of the node pool, otherwise the tier ID is returned.
+func getStoragepoolNodepoolTier(ctx context.Context, client *client.Client, prior types.String, nodepool *powerscale.V16StoragepoolNodepoolExtended) types.String {
+	tierID, ok := nodepool.GetTierOk()
+	if !ok || tierID == nil {
+		return types.StringNull()
+	}
+	tier := types.StringValue(strconv.FormatInt(int64(*tierID), 10))
+	if prior.IsNull() || prior.IsUnknown() || prior.Equal(tier) {
+		return tier
+	}
+	if tiers, err := GetStoragepoolTier(ctx, client, prior.ValueString()); err == nil && len(tiers.Tiers) > 0 && tiers.Tiers[0].Id == *tierID {
+		return prior
+	}
+	return tier
+}
+
+// UpdateStoragepoolNodepoolState updates the resource state from the node pool.
+func UpdateStoragepoolNodepoolState(ctx context.Context, client *client.Client, nodepool *powerscale.V16StoragepoolNodepoolExtended, state *models.StoragepoolNodepoolResourceModel) (diags diag.Diagnostics) {
+	priorLnns, priorTier := state.Lnns, state.Tier
+	if err := CopyFieldsToNonNestedModel(ctx, nodepool, state); err != nil {
+		diags.AddError("Error copying fields of storagepool nodepool resource", err.Error())
+		return
+	}
+	// the array does not keep the order of the nodes, so keep the configured order if the nodes are the same
+	if !priorLnns.IsNull() && !priorLnns.IsUnknown() && sameListElements(priorLnns, state.Lnns) {
+		state.Lnns = priorLnns
+	}
+	// the tier can be configured by name or ID, so the configured value is kept if it is still the tier of the node pool
+	state.Tier = getStoragepoolNodepoolTier(ctx, client, priorTier, nodepool)
+
+	usage := nodepool.GetUsage()
+	state.SsdUsage, diags = types.ObjectValueFrom(ctx, StoragepoolNodepoolSsdUsageAttrTypes(), models.StoragepoolNodepoolSsdUsage{
+		AvailSsdBytes:  types.StringValue(usage.GetAvailSsdBytes()),
+		FreeSsdBytes:   types.StringValue(usage.GetFreeSsdBytes()),
+		PctUsedSsd:     types.StringValue(usage.GetPctUsedSsd()),
+		TotalSsdBytes:  types.StringValue(usage.GetTotalSsdBytes()),
+		UsableSsdBytes: types.StringValue(usage.GetUsableSsdBytes()),
+		UsedSsdBytes:   types.StringValue(usage.GetUsedSsdBytes()),
+	})
+	return
+}
+
+// sameListElements checks if two lists hold the same elements regardless of their order.
+func sameListElements(a, b types.List) bool {
+	if len(a.Elements()) != len(b.Elements()) {
+		return false
+	}
+	toStrings := func(list types.List) []string {
+		values := make([]string, 0, len(list.Elements()))
+		for _, v := range list.Elements() {
+			values = append(values, v.String())
+		}
+		sort.Strings(values)
+		return values
+	}
+	aValues, bValues := toStrings(a), toStrings(b)
+	for i := range aValues {
+		if aValues[i] != bValues[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,274 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"slices"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// SyncIQFailoverSourceActive is the failover state where the primary cluster serves the data.
+	SyncIQFailoverSourceActive = "source_active"
+	// SyncIQFailoverFailedOver is the failover state where the secondary cluster serves the data.
+	SyncIQFailoverFailedOver = "failed_over"
+)
+
+// SyncIQFailoverStep describes a single replication job of a failover or failback sequence.
+type SyncIQFailoverStep struct {
+	// Name identifies the step in the completed_steps attribute.
+	Name string
+	// Policy is the name of the policy the job runs for.
+	Policy string
+	// Action is the replication job action.
+	Action string
+	// OnPrimary is true if the job runs on the primary cluster, false for the secondary cluster.
+	OnPrimary bool
+	// TargetSide is true if the policy is a target policy on the cluster the job runs on.
+	TargetSide bool
+}
+
+// GetSyncIQFailoverSteps returns the ordered steps to move a policy from one failover state to another.
+// Failover makes the secondary cluster writable. Failback either reverts the failover on the secondary cluster,
+// discarding the changes made there, or resyncs them back to the primary cluster through the mirror policy.
+func GetSyncIQFailoverSteps(policy, from, to, failbackMode string) []SyncIQFailoverStep {
+	if from == to {
+		return nil
+	}
+	if to == SyncIQFailoverFailedOver {
+		return []SyncIQFailoverStep{
+			{Name: "allow_write", Policy: policy, Action: "allow_write", TargetSide: true},
+		}
+	}
+	if failbackMode == "revert" {
+		return []SyncIQFailoverStep{
+			{Name: "allow_write_revert", Policy: policy, Action: "allow_write_revert", TargetSide: true},
+		}
+	}
+	mirror := policy + "_mirror"
+	return []SyncIQFailoverStep{
+		{Name: "resync_prep", Policy: policy, Action: "resync_prep", OnPrimary: true},
+		{Name: "mirror_sync", Policy: mirror, Action: "run"},
+		{Name: "mirror_allow_write", Policy: mirror, Action: "allow_write", OnPrimary: true, TargetSide: true},
+		{Name: "mirror_resync_prep", Policy: mirror, Action: "resync_prep"},
+	}
+}
+
+// GetSyncIQFailoverState returns the target policy ID and the failover state derived from its failover_failback_state.
+func GetSyncIQFailoverState(ctx context.Context, client *client.Client, policy string) (string, string, error) {
+	targetPolicy, err := GetSyncIQTargetPolicy(ctx, client, policy)
+	if err != nil {
+		return "", "", err
+	}
+	if targetPolicy.FailoverFailbackState == "writes_disabled" {
+		return targetPolicy.Id, SyncIQFailoverSourceActive, nil
+	}
+	return targetPolicy.Id, SyncIQFailoverFailedOver, nil
+}
+
+// NewSyncIQFailoverPeerClient creates the client of the primary cluster from the peer block.
+func NewSyncIQFailoverPeerClient(peer *models.SyncIQFailoverPeerModel) (*client.Client, error) {
+	if peer == nil {
+		return nil, fmt.Errorf("%sas the peer block is not configured, it is required to fail back with failback_mode resync", constants.ConnectSyncIQFailoverPeerErrorMsg)
+	}
+	authType, timeout := int64(client.SessionAuthType), int64(2000)
+	if !peer.AuthType.IsNull() && !peer.AuthType.IsUnknown() {
+		authType = peer.AuthType.ValueInt64()
+	}
+	if !peer.Timeout.IsNull() && !peer.Timeout.IsUnknown() {
+		timeout = peer.Timeout.ValueInt64()
+	}
+	peerClient, err := client.NewClient(
+		peer.Endpoint.ValueString(),
+		peer.Insecure.ValueBool(),
+		peer.Username.ValueString(),
+		peer.Password.ValueString(),
+		authType,
+		timeout,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s", GetErrorString(err, constants.ConnectSyncIQFailoverPeerErrorMsg+"with error: "))
+	}
+	return peerClient, nil
+}
+
+// getSyncIQPolicyLastJobState returns the state of the last job of a source or target policy.
+func getSyncIQPolicyLastJobState(ctx context.Context, client *client.Client, policy string, targetSide bool) (string, error) {
+	if targetSide {
+		targetPolicy, err := GetSyncIQTargetPolicy(ctx, client, policy)
+		if err != nil {
+			return "", err
+		}
+		return targetPolicy.LastJobState, nil
+	}
+	policies, err := GetAllSyncIQPolicies(ctx, client)
+	if err != nil {
+		return "", fmt.Errorf("%s", GetErrorString(err, "Could not get list of SyncIQ policies with error: "))
+	}
+	for _, item := range policies.Policies {
+		if item.Name == policy {
+			return item.GetLastJobState(), nil
+		}
+	}
+	return "", fmt.Errorf("policy by name %s not found", policy)
+}
+
+// WaitForSyncIQReplicationJob polls the replication job of a policy until it is no longer running.
+func WaitForSyncIQReplicationJob(ctx context.Context, client *client.Client, policy string, interval, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		time.Sleep(interval)
+		jobs, httpResp, err := GetSyncIQReplicationJob(ctx, client, policy)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return fmt.Errorf("%s", GetErrorString(err, "Could not read syncIQ Replication Job with error: "))
+		}
+		if len(jobs.Jobs) == 0 {
+			return nil
+		}
+		jobState := jobs.Jobs[0].State
+		tflog.Debug(ctx, fmt.Sprintf("SyncIQ job of policy %s is %s", policy, jobState))
+		if jobState == "finished" {
+			return nil
+		}
+		if slices.Contains([]string{"failed", "canceled", "needs_attention", "unknown"}, jobState) {
+			return fmt.Errorf("the job of policy %s ended in state %s", policy, jobState)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("the job of policy %s is still %s after %s", policy, jobState, timeout)
+		}
+	}
+}
+
+// RunSyncIQFailoverStep starts the replication job of a step, waits for it and checks its result.
+func RunSyncIQFailoverStep(ctx context.Context, client *client.Client, step SyncIQFailoverStep, interval, timeout time.Duration) error {
+	tflog.Info(ctx, fmt.Sprintf("Running SyncIQ failover step %s for policy %s", step.Name, step.Policy))
+	job := powerscale.V1SyncJob{
+		Id:     step.Policy,
+		Action: &step.Action,
+	}
+	if _, err := CreateSyncIQReplicationJob(ctx, client, job); err != nil {
+		return fmt.Errorf("%s", GetErrorString(err, constants.RunSyncIQFailoverStepErrorMsg+step.Name+" with error: "))
+	}
+	if err := WaitForSyncIQReplicationJob(ctx, client, step.Policy, interval, timeout); err != nil {
+		return fmt.Errorf("%s%s: %s", constants.RunSyncIQFailoverStepErrorMsg, step.Name, err.Error())
+	}
+	lastJobState, err := getSyncIQPolicyLastJobState(ctx, client, step.Policy, step.TargetSide)
+	if err != nil {
+		return fmt.Errorf("%s%s: %s", constants.RunSyncIQFailoverStepErrorMsg, step.Name, err.Error())
+	}
+	if lastJobState != "" && lastJobState != "finished" {
+		return fmt.Errorf("%s%s: the last job of policy %s is %s", constants.RunSyncIQFailoverStepErrorMsg, step.Name, step.Policy, lastJobState)
+	}
+	return nil
+}
+
+// GetSyncIQFailoverCompletedSteps returns the steps of a failback with failback_mode resync that already completed on the clusters.
+// A resource replacing a tainted one after a failed create has no completed_steps in state,
+// so the progress of the interrupted failback is read from the target policies instead.
+func GetSyncIQFailoverCompletedSteps(ctx context.Context, client *client.Client, plan models.SyncIQFailoverResourceModel, from string) ([]string, error) {
+	completed := []string{}
+	if from != SyncIQFailoverFailedOver || plan.FailoverState.ValueString() != SyncIQFailoverSourceActive || plan.FailbackMode.ValueString() != "resync" {
+		return completed, nil
+	}
+	policy := plan.Policy.ValueString()
+	targetPolicy, err := GetSyncIQTargetPolicy(ctx, client, policy)
+	if err != nil {
+		return nil, err
+	}
+	// resync_prep on the primary cluster creates the mirror policy on the secondary cluster
+	if targetPolicy.FailoverFailbackState != "resync_policy_created" {
+		return completed, nil
+	}
+	completed = append(completed, "resync_prep")
+	if plan.Peer == nil {
+		return completed, nil
+	}
+
+	peerClient, err := NewSyncIQFailoverPeerClient(plan.Peer)
+	if err != nil {
+		return nil, err
+	}
+	// allow_write of the mirror policy on the primary cluster only succeeds after the mirror policy has synced
+	mirrorTargetPolicy, err := GetSyncIQTargetPolicy(ctx, peerClient, policy+"_mirror")
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Mirror target policy of %s is not found on the primary cluster: %s", policy, err.Error()))
+		return completed, nil
+	}
+	if mirrorTargetPolicy.FailoverFailbackState == "writes_enabled" {
+		completed = append(completed, "mirror_sync", "mirror_allow_write")
+	}
+	return completed, nil
+}
+
+// ManageSyncIQFailover runs the steps moving the policy from the failover state in from to the planned one.
+// Steps listed in completedSteps are skipped. The returned state records the steps completed so far,
+// and keeps the failover state in from if a step fails, so that the next apply resumes the sequence.
+func ManageSyncIQFailover(ctx context.Context, client *client.Client, plan models.SyncIQFailoverResourceModel, from string, completedSteps []string) (state models.SyncIQFailoverResourceModel, diags diag.Diagnostics) {
+	state = plan
+	completed := append([]string{}, completedSteps...)
+	interval := time.Duration(plan.PollInterval.ValueInt64()) * time.Second
+	timeout := time.Duration(plan.Timeout.ValueInt64()) * time.Second
+
+	var peerClient *client.Client
+	for _, step := range GetSyncIQFailoverSteps(plan.Policy.ValueString(), from, plan.FailoverState.ValueString(), plan.FailbackMode.ValueString()) {
+		if slices.Contains(completed, step.Name) {
+			tflog.Info(ctx, fmt.Sprintf("Skipping completed SyncIQ failover step %s", step.Name))
+			continue
+		}
+		stepClient := client
+		if step.OnPrimary {
+			if peerClient == nil {
+				var err error
+				if peerClient, err = NewSyncIQFailoverPeerClient(plan.Peer); err != nil {
+					diags.AddError("Error connecting to the primary cluster", err.Error())
+					break
+				}
+			}
+			stepClient = peerClient
+		}
+		if err := RunSyncIQFailoverStep(ctx, stepClient, step, interval, timeout); err != nil {
+			diags.AddError(fmt.Sprintf("Error running SyncIQ failover step %s", step.Name), err.Error())
+			break
+		}
+		completed = append(completed, step.Name)
+	}
+
+	if !diags.HasError() {
+		completed = []string{}
+	} else {
+		state.FailoverState = types.StringValue(from)
+	}
+	steps, listDiags := types.ListValueFrom(ctx, types.StringType, completed)
+	diags.Append(listDiags...)
+	state.CompletedSteps = steps
+	return
+}
@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetSyncIQPolicyID returns the ID of a SyncIQ policy given its name or ID.
+func GetSyncIQPolicyID(ctx context.Context, client *client.Client, policy string) (string, error) {
+	policies, err := GetAllSyncIQPolicies(ctx, client)
+	if err != nil {
+		errStr := "Could not get list of SyncIQ policies with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error getting SyncIQ policy %s: %s", policy, message)
+	}
+	for _, item := range policies.Policies {
+		if item.Id == policy || item.Name == policy {
+			return item.Id, nil
+		}
+	}
+	return "", fmt.Errorf("policy by name or ID %s not found", policy)
+}
+
+// ResetSyncIQPolicy resets the incremental sync state of the policy, the next job runs a full replication.
+func ResetSyncIQPolicy(ctx context.Context, client *client.Client, policyID string) error {
+	if _, _, err := client.PscaleOpenAPIClient.SyncApi.CreateSyncv1PolicyResetItem(ctx, policyID).Body(map[string]interface{}{}).Execute(); err != nil {
+		errStr := constants.ResetSyncIQPolicyErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error resetting SyncIQ policy %s: %s", policyID, message)
+	}
+	return nil
+}
+
+// ResolveSyncIQPolicy clears the conflicted flag of the policy and keeps its incremental sync state.
+func ResolveSyncIQPolicy(ctx context.Context, client *client.Client, policyID string) error {
+	resolve := powerscale.V14SyncPolicyExtendedExtended{
+		Conflicted: New(false),
+	}
+	if err := UpdateSyncIQPolicy(ctx, client, policyID, resolve); err != nil {
+		errStr := constants.ResolveSyncIQPolicyErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error resolving SyncIQ policy %s: %s", policyID, message)
+	}
+	return nil
+}
+
+// WaitForSyncIQPolicyRunnable polls the policy until it is no longer conflicted.
+func WaitForSyncIQPolicyRunnable(ctx context.Context, client *client.Client, policyID string, interval, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		policy, err := GetSyncIQPolicyByID(ctx, client, policyID)
+		if err != nil {
+			errStr := "Could not get syncIQ Policy with error: "
+			return fmt.Errorf("%s", GetErrorString(err, errStr))
+		}
+		if len(policy.Policies) > 0 && !policy.Policies[0].GetConflicted() {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("SyncIQ policy %s is still conflicted after %s", policyID, timeout)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// ManageSyncIQPolicyAction performs the reset or resolve action on a SyncIQ policy and waits for it to become runnable.
+func ManageSyncIQPolicyAction(ctx context.Context, client *client.Client, plan models.SyncIQPolicyActionResourceModel) (state models.SyncIQPolicyActionResourceModel, diags diag.Diagnostics) {
+	state = plan
+	policyID, err := GetSyncIQPolicyID(ctx, client, plan.Policy.ValueString())
+	if err != nil {
+		diags.AddError("Error getting the SyncIQ policy", err.Error())
+		return
+	}
+
+	switch plan.Action.ValueString() {
+	case "reset":
+		if err := ResetSyncIQPolicy(ctx, client, policyID); err != nil {
+			diags.AddError("Error resetting the SyncIQ policy", err.Error())
+			return
+		}
+	case "resolve":
+		if err := ResolveSyncIQPolicy(ctx, client, policyID); err != nil {
+			diags.AddError("Error resolving the SyncIQ policy", err.Error())
+			return
+		}
+	}
+
+	interval := time.Duration(plan.PollInterval.ValueInt64()) * time.Second
+	timeout := time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	if err := WaitForSyncIQPolicyRunnable(ctx, client, policyID, interval, timeout); err != nil {
+		diags.AddError("Error waiting for the SyncIQ policy to become runnable", err.Error())
+		return
+	}
+	state.ID = types.StringValue(policyID)
+	return
+}
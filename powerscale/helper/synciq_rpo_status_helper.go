@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	syncIQScheduleEveryRegex   = regexp.MustCompile(`(?i)every\s+(\d+)\s+(minute|hour|day|week|month)s?`)
+	syncIQScheduleWeekdayRegex = regexp.MustCompile(`(?i)every\s+(day|weekday|monday|tuesday|wednesday|thursday|friday|saturday|sunday)`)
+	syncIQScheduleUnitSeconds  = map[string]int64{
+		"minute": 60,
+		"hour":   3600,
+		"day":    86400,
+		"week":   604800,
+		"month":  2678400,
+	}
+)
+
+// GetSyncIQScheduleInterval returns the interval in seconds between two jobs of a SyncIQ schedule,
+// e.g. "every 1 days every 4 hours between 12:00 AM and 11:59 PM" gives 4 hours.
+// Manual and event driven schedules, and schedules which cannot be parsed, give 0.
+func GetSyncIQScheduleInterval(schedule string) int64 {
+	var interval int64
+	for _, match := range syncIQScheduleEveryRegex.FindAllStringSubmatch(schedule, -1) {
+		count, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || count <= 0 {
+			continue
+		}
+		seconds := count * syncIQScheduleUnitSeconds[strings.ToLower(match[2])]
+		if interval == 0 || seconds < interval {
+			interval = seconds
+		}
+	}
+	if interval == 0 {
+		if match := syncIQScheduleWeekdayRegex.FindStringSubmatch(schedule); match != nil {
+			interval = syncIQScheduleUnitSeconds["week"]
+			if day := strings.ToLower(match[1]); day == "day" || day == "weekday" {
+				interval = syncIQScheduleUnitSeconds["day"]
+			}
+		}
+	}
+	return interval
+}
+
+// GetSyncIQLastSuccessTimes returns the end time of the last finished job of each policy from the replication reports.
+func GetSyncIQLastSuccessTimes(ctx context.Context, client *client.Client) (map[string]int64, error) {
+	reports, err := GetReplicationReports(ctx, client, models.ReplicationReportsDatasourceModel{
+		ReplicationReportFilter: &models.ReplicationReportFilterType{
+			State: types.StringValue("finished"),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	lastSuccess := map[string]int64{}
+	for _, report := range *reports {
+		if report.GetState() != "finished" {
+			continue
+		}
+		if endTime := int64(report.GetEndTime()); endTime > lastSuccess[report.GetPolicyName()] {
+			lastSuccess[report.GetPolicyName()] = endTime
+		}
+	}
+	return lastSuccess, nil
+}
+
+// EvaluateSyncIQRPOStatus evaluates the RPO compliance of a policy at the given time.
+// The threshold is the rpo_alert of the policy, or twice the schedule interval if rpo_alert is not set,
+// so that a single job running late is not reported as a breach.
+func EvaluateSyncIQRPOStatus(policy powerscale.V14SyncPolicyExtended, lastSuccess int64, now time.Time) models.SyncIQRPOStatusModel {
+	rpoAlert := int64(policy.GetRpoAlert())
+	interval := GetSyncIQScheduleInterval(policy.GetSchedule())
+	threshold := rpoAlert
+	if threshold == 0 {
+		threshold = 2 * interval
+	}
+	if policyLastSuccess := int64(policy.GetLastSuccess()); policyLastSuccess > lastSuccess {
+		lastSuccess = policyLastSuccess
+	}
+
+	status := models.SyncIQRPOStatusModel{
+		ID:                      types.StringValue(policy.Id),
+		Name:                    types.StringValue(policy.Name),
+		Enabled:                 types.BoolValue(policy.GetEnabled()),
+		Schedule:                types.StringValue(policy.GetSchedule()),
+		RpoAlert:                types.Int64Value(rpoAlert),
+		ScheduleInterval:        types.Int64Value(interval),
+		RpoThreshold:            types.Int64Value(threshold),
+		LastJobState:            types.StringValue(policy.GetLastJobState()),
+		LastSuccess:             types.Int64Null(),
+		SecondsSinceLastSuccess: types.Int64Null(),
+	}
+	if lastSuccess > 0 {
+		status.LastSuccess = types.Int64Value(lastSuccess)
+		status.SecondsSinceLastSuccess = types.Int64Value(now.Unix() - lastSuccess)
+	}
+
+	switch {
+	case !policy.GetEnabled():
+		status.Status = types.StringValue("disabled")
+	case threshold == 0:
+		status.Status = types.StringValue("not_evaluated")
+	case lastSuccess == 0:
+		status.Status = types.StringValue("never_succeeded")
+	case now.Unix()-lastSuccess > threshold:
+		status.Status = types.StringValue("breached")
+	default:
+		status.Status = types.StringValue("ok")
+	}
+	breached := status.Status.ValueString() == "breached" || status.Status.ValueString() == "never_succeeded"
+	status.Breached = types.BoolValue(breached)
+	return status
+}
+
+// ManageDataSourceSyncIQRPOStatus evaluates the RPO compliance of the SyncIQ policies matching the filter and sets the state.
+func ManageDataSourceSyncIQRPOStatus(ctx context.Context, client *client.Client, state *models.SyncIQRPOStatusDataSourceModel) (diags diag.Diagnostics) {
+	policies, err := GetAllSyncIQPolicies(ctx, client)
+	if err != nil {
+		errStr := constants.ReadSyncIQRPOStatusErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError("Error getting the SyncIQ policies", message)
+		return
+	}
+	lastSuccess, err := GetSyncIQLastSuccessTimes(ctx, client)
+	if err != nil {
+		errStr := constants.ReadSyncIQRPOStatusErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError("Error getting the SyncIQ replication reports", message)
+		return
+	}
+
+	var names []string
+	if state.Filter != nil {
+		for _, name := range state.Filter.Names {
+			names = append(names, name.ValueString())
+		}
+	}
+
+	now := time.Now()
+	state.Policies = []models.SyncIQRPOStatusModel{}
+	state.BreachedPolicies = []types.String{}
+	for _, policy := range policies.Policies {
+		if len(names) > 0 && !slices.Contains(names, policy.Name) {
+			continue
+		}
+		status := EvaluateSyncIQRPOStatus(policy, lastSuccess[policy.Name], now)
+		state.Policies = append(state.Policies, status)
+		if status.Breached.ValueBool() {
+			state.BreachedPolicies = append(state.BreachedPolicies, status.Name)
+		}
+	}
+	state.ID = types.StringValue("synciq_rpo_status_datasource")
+	return
+}
@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"slices"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetAllSyncIQTargetPolicies retrieves the SyncIQ policies targeting this cluster.
+func GetAllSyncIQTargetPolicies(ctx context.Context, client *client.Client) (*powerscale.V14TargetPolicies, error) {
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.ListSyncv14TargetPolicies(ctx).Execute()
+	if err != nil {
+		return resp, err
+	}
+	for resp.Resume != nil {
+		respAdd, _, errAdd := client.PscaleOpenAPIClient.SyncApi.ListSyncv14TargetPolicies(ctx).Resume(*resp.Resume).Execute()
+		if errAdd != nil {
+			return resp, errAdd
+		}
+		resp.Resume = respAdd.Resume
+		resp.Policies = append(resp.Policies, respAdd.Policies...)
+	}
+	return resp, err
+}
+
+// GetSyncIQTargetPolicy retrieves a SyncIQ target policy by name or ID.
+func GetSyncIQTargetPolicy(ctx context.Context, client *client.Client, policy string) (*powerscale.V14TargetPolicy, error) {
+	policies, err := GetAllSyncIQTargetPolicies(ctx, client)
+	if err != nil {
+		errStr := constants.ReadSyncIQTargetPolicyErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting SyncIQ target policy %s: %s", policy, message)
+	}
+	for _, item := range policies.Policies {
+		if item.Id == policy || item.Name == policy {
+			found := item
+			return &found, nil
+		}
+	}
+	return nil, fmt.Errorf("SyncIQ target policy %s not found", policy)
+}
+
+// SyncIQTargetPolicyDetailMapper maps the SyncIQ target policy to the datasource model.
+func SyncIQTargetPolicyDetailMapper(policy powerscale.V14TargetPolicy) models.SyncIQTargetPolicyModel {
+	return models.SyncIQTargetPolicyModel{
+		ID:                      types.StringValue(policy.Id),
+		Name:                    types.StringValue(policy.Name),
+		SourceClusterGUID:       types.StringValue(policy.SourceClusterGuid),
+		SourceClusterName:       types.StringValue(policy.SourceClusterName),
+		SourceHost:              types.StringValue(policy.SourceHost),
+		LastSourceCoordinatorIP: types.StringPointerValue(policy.LastSourceCoordinatorIp),
+		TargetPath:              types.StringValue(policy.TargetPath),
+		LastJobState:            types.StringValue(policy.LastJobState),
+		FailoverFailbackState:   types.StringValue(policy.FailoverFailbackState),
+		LastUpdateFromSource:    types.Int64PointerValue(policy.LastUpdateFromSource),
+		LegacyPolicy:            types.BoolPointerValue(policy.LegacyPolicy),
+	}
+}
+
+// ManageDataSourceSyncIQTargetPolicy gets the SyncIQ target policies matching the filter and sets the state.
+func ManageDataSourceSyncIQTargetPolicy(ctx context.Context, client *client.Client, state *models.SyncIQTargetPolicyDataSourceModel) (diags diag.Diagnostics) {
+	policies, err := GetAllSyncIQTargetPolicies(ctx, client)
+	if err != nil {
+		errStr := constants.ReadSyncIQTargetPolicyErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError("Error getting the SyncIQ target policies", message)
+		return
+	}
+
+	var names, sourceClusterNames []string
+	if state.Filter != nil {
+		for _, name := range state.Filter.Names {
+			names = append(names, name.ValueString())
+		}
+		for _, name := range state.Filter.SourceClusterNames {
+			sourceClusterNames = append(sourceClusterNames, name.ValueString())
+		}
+	}
+
+	state.TargetPolicies = []models.SyncIQTargetPolicyModel{}
+	for _, policy := range policies.Policies {
+		if len(names) > 0 && !slices.Contains(names, policy.Name) {
+			continue
+		}
+		if len(sourceClusterNames) > 0 && !slices.Contains(sourceClusterNames, policy.SourceClusterName) {
+			continue
+		}
+		state.TargetPolicies = append(state.TargetPolicies, SyncIQTargetPolicyDetailMapper(policy))
+	}
+	state.ID = types.StringValue("synciq_target_policy_datasource")
+	return
+}
+
+// BreakSyncIQTargetPolicy breaks the association between the target directory and the source policy.
+func BreakSyncIQTargetPolicy(ctx context.Context, client *client.Client, policyID string, force bool) error {
+	if _, err := client.PscaleOpenAPIClient.SyncApi.DeleteSyncv1TargetPolicy(ctx, policyID).Force(force).Execute(); err != nil {
+		errStr := constants.BreakSyncIQTargetPolicyErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error breaking the target association of SyncIQ policy %s: %s", policyID, message)
+	}
+	return nil
+}
+
+// CancelSyncIQTargetPolicyJob cancels the running job of the policy from the target side.
+func CancelSyncIQTargetPolicyJob(ctx context.Context, client *client.Client, policyID string) error {
+	if _, _, err := client.PscaleOpenAPIClient.SyncApi.CreateSyncv1TargetPolicyCancelItem(ctx, policyID).Body(map[string]interface{}{}).Execute(); err != nil {
+		errStr := constants.CancelSyncIQTargetPolicyErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error canceling the target job of SyncIQ policy %s: %s", policyID, message)
+	}
+	return nil
+}
+
+// ManageSyncIQTargetPolicyAction performs the break association or cancel action on a SyncIQ target policy.
+func ManageSyncIQTargetPolicyAction(ctx context.Context, client *client.Client, plan models.SyncIQTargetPolicyActionResourceModel) (state models.SyncIQTargetPolicyActionResourceModel, diags diag.Diagnostics) {
+	state = plan
+	policy, err := GetSyncIQTargetPolicy(ctx, client, plan.Policy.ValueString())
+	if err != nil {
+		diags.AddError("Error getting the SyncIQ target policy", err.Error())
+		return
+	}
+
+	switch plan.Action.ValueString() {
+	case "break_association":
+		if err := BreakSyncIQTargetPolicy(ctx, client, policy.Id, plan.Force.ValueBool()); err != nil {
+			diags.AddError("Error breaking the SyncIQ target association", err.Error())
+			return
+		}
+	case "cancel":
+		if err := CancelSyncIQTargetPolicyJob(ctx, client, policy.Id); err != nil {
+			diags.AddError("Error canceling the SyncIQ target job", err.Error())
+			return
+		}
+	}
+	state.ID = types.StringValue(policy.Id)
+	return
+}
@@ -426,14 +426,14 @@ func UpdateUserGroupMembers(ctx context.Context, client *client.Client, state *m
 	toAdd, toRemove := GetElementsChanges(state.Users.Elements(), plan.Users.Elements())
 	// remove users from user group by memberAuthID
 	for _, i := range toRemove {
-		memberAuthID := fmt.Sprintf("USER:%s", strings.Trim(i.String(), "\""))
+		memberAuthID := fmt.Sprintf("USER:%s", i.(types.String).ValueString())
 		if err := RemoveUserGroupMemberWithZone(ctx, client, memberAuthID, plan.Name.ValueString(), plan.QueryZone.ValueString()); err != nil {
 			diags.AddError(fmt.Sprintf("Error remove User - %s from User Group.", memberAuthID), err.Error())
 		}
 	}
 	// add users to user group by memberID
 	for _, i := range toAdd {
-		memberID := strings.Trim(i.String(), "\"")
+		memberID := i.(types.String).ValueString()
 		memberType := "user"
 		memberIdentity := powerscale.V1AuthAccessAccessItemFileGroup{Name: &memberID, Type: &memberType}
 		if err := AddUserGroupMemberWithZone(ctx, client, memberIdentity, plan.Name.ValueString(), plan.QueryZone.ValueString()); err != nil {
@@ -445,14 +445,14 @@ func UpdateUserGroupMembers(ctx context.Context, client *client.Client, state *m
 	toAdd, toRemove = GetElementsChanges(state.Groups.Elements(), plan.Groups.Elements())
 	// remove groups from user group by memberAuthID
 	for _, i := range toRemove {
-		memberAuthID := fmt.Sprintf("GROUP:%s", strings.Trim(i.String(), "\""))
+		memberAuthID := fmt.Sprintf("GROUP:%s", i.(types.String).ValueString())
 		if err := RemoveUserGroupMemberWithZone(ctx, client, memberAuthID, plan.Name.ValueString(), plan.QueryZone.ValueString()); err != nil {
 			diags.AddError(fmt.Sprintf("Error remove Group - %s from User Group.", memberAuthID), err.Error())
 		}
 	}
 	// add groups to user group by memberID
 	for _, i := range toAdd {
-		memberID := strings.Trim(i.String(), "\"")
+		memberID := i.(types.String).ValueString()
 		memberType := "group"
 		memberIdentity := powerscale.V1AuthAccessAccessItemFileGroup{Name: &memberID, Type: &memberType}
 		if err := AddUserGroupMemberWithZone(ctx, client, memberIdentity, plan.Name.ValueString(), plan.QueryZone.ValueString()); err != nil {
@@ -464,7 +464,7 @@ func UpdateUserGroupMembers(ctx context.Context, client *client.Client, state *m
 	toAdd, toRemove = GetElementsChanges(state.WellKnowns.Elements(), plan.WellKnowns.Elements())
 	// remove well-knowns from user group by wellKnownSID
 	for _, i := range toRemove {
-		wellKnownName := getWellKnownName(strings.Trim(i.String(), "\""))
+		wellKnownName := getWellKnownName(i.(types.String).ValueString())
 		wellKnownSID := ""
 		if wellKnowns, err := GetWellKnown(ctx, client, wellKnownName); err == nil {
 			wellKnownSID = *wellKnowns.Wellknowns[0].Id
@@ -478,7 +478,7 @@ func UpdateUserGroupMembers(ctx context.Context, client *client.Client, state *m
 	}
 	// add well-knowns to user group by wellKnownName
 	for _, i := range toAdd {
-		wellKnownName := getWellKnownName(strings.Trim(i.String(), "\""))
+		wellKnownName := getWellKnownName(i.(types.String).ValueString())
 		memberType := "wellknown"
 		memberIdentity := powerscale.V1AuthAccessAccessItemFileGroup{Name: &wellKnownName, Type: &memberType}
 		if err := AddUserGroupMemberWithZone(ctx, client, memberIdentity, plan.Name.ValueString(), plan.QueryZone.ValueString()); err != nil {
@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// toUserGroupModel converts the user group membership model to the user group model, so that the members can be updated in the same way.
+func toUserGroupModel(model *models.UserGroupMembershipResourceModel) *models.UserGroupResourceModel {
+	return &models.UserGroupResourceModel{
+		Name:       model.Group,
+		QueryZone:  model.Zone,
+		Users:      types.ListValueMust(types.StringType, model.Users.Elements()),
+		Groups:     types.ListValueMust(types.StringType, model.Groups.Elements()),
+		WellKnowns: types.ListValueMust(types.StringType, model.WellKnowns.Elements()),
+	}
+}
+
+// UpdateUserGroupMembership Adds and removes the managed members of a user group according to the changes between state and plan.
+// Members of the user group which are not managed by this resource are left untouched.
+func UpdateUserGroupMembership(ctx context.Context, client *client.Client, state *models.UserGroupMembershipResourceModel, plan *models.UserGroupMembershipResourceModel) diag.Diagnostics {
+	return UpdateUserGroupMembers(ctx, client, toUserGroupModel(state), toUserGroupModel(plan))
+}
+
+// isSameGroupMember checks whether the managed member name matches the member name of user group.
+// The well-known members may be configured by full name, like "NT AUTHORITY\\NETWORK", while the short name is returned.
+func isSameGroupMember(managedName, memberName string) bool {
+	if strings.EqualFold(managedName, memberName) {
+		return true
+	}
+	splitElements := strings.Split(managedName, "\\")
+	return strings.EqualFold(splitElements[len(splitElements)-1], memberName)
+}
+
+// filterGroupMembers returns the managed members which still exist in the user group.
+// If managed members are null, null is kept.
+func filterGroupMembers(managed types.Set, groupMembers []powerscale.V1AuthAccessAccessItemFileGroup, memberType string) types.Set {
+	if managed.IsNull() {
+		return managed
+	}
+
+	var members []attr.Value
+	for _, m := range groupMembers {
+		if m.Type == nil || m.Name == nil || *m.Type != memberType {
+			continue
+		}
+		for _, managedMember := range managed.Elements() {
+			managedName, ok := managedMember.(types.String)
+			if ok && isSameGroupMember(managedName.ValueString(), *m.Name) {
+				// keep the configured name to avoid the diff of well-known short name
+				members = append(members, managedMember)
+				break
+			}
+		}
+	}
+	return types.SetValueMust(types.StringType, members)
+}
+
+// setUserGroupMembershipID sets the ID of the user group membership, which is [<zoneID>:]<groupName>.
+func setUserGroupMembershipID(model *models.UserGroupMembershipResourceModel) {
+	model.ID = model.Group
+	if model.Zone.ValueString() != "" {
+		model.ID = types.StringValue(model.Zone.ValueString() + ":" + model.Group.ValueString())
+	}
+}
+
+// UpdateUserGroupMembershipState Updates the user group membership state with the members of user group.
+func UpdateUserGroupMembershipState(model *models.UserGroupMembershipResourceModel, groupMembers []powerscale.V1AuthAccessAccessItemFileGroup) {
+	model.Users = filterGroupMembers(model.Users, groupMembers, "user")
+	model.Groups = filterGroupMembers(model.Groups, groupMembers, "group")
+	model.WellKnowns = filterGroupMembers(model.WellKnowns, groupMembers, "wellknown")
+	setUserGroupMembershipID(model)
+}
+
+// ImportUserGroupMembershipState Updates the user group membership state with the given members of user group when importing.
+// Only the given members are taken into state, according to their member type. Other members of the user group are left unmanaged.
+func ImportUserGroupMembershipState(model *models.UserGroupMembershipResourceModel, groupMembers []powerscale.V1AuthAccessAccessItemFileGroup, memberNames []string) error {
+	membersByType := map[string][]attr.Value{}
+	for _, name := range memberNames {
+		found := false
+		for _, m := range groupMembers {
+			if m.Type == nil || m.Name == nil || !isSameGroupMember(name, *m.Name) {
+				continue
+			}
+			membersByType[*m.Type] = append(membersByType[*m.Type], types.StringValue(name))
+			found = true
+			break
+		}
+		if !found {
+			return fmt.Errorf("%s is not a member of user group %s", name, model.Group.ValueString())
+		}
+	}
+
+	model.Users = types.SetNull(types.StringType)
+	model.Groups = types.SetNull(types.StringType)
+	model.WellKnowns = types.SetNull(types.StringType)
+	if members, ok := membersByType["user"]; ok {
+		model.Users = types.SetValueMust(types.StringType, members)
+	}
+	if members, ok := membersByType["group"]; ok {
+		model.Groups = types.SetValueMust(types.StringType, members)
+	}
+	if members, ok := membersByType["wellknown"]; ok {
+		model.WellKnowns = types.SetValueMust(types.StringType, members)
+	}
+	setUserGroupMembershipID(model)
+	return nil
+}
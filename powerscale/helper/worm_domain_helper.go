@@ -0,0 +1,290 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WormRetentionRegex matches a retention period in the OneFS format of <integer><unit>, where unit is one of Y, M, W, D, H, m, s, e.g. 1Y6M.
+var WormRetentionRegex = regexp.MustCompile(`^(\d+[YMWDHms])+$`)
+
+var wormRetentionPartRegex = regexp.MustCompile(`(\d+)([YMWDHms])`)
+
+// ParseWormRetention converts a retention period string such as 1Y6M to the WORM domain retention object.
+func ParseWormRetention(retention types.String) (*powerscale.V1WormDomainRetention, error) {
+	if retention.IsNull() || retention.IsUnknown() {
+		return nil, nil
+	}
+	if !WormRetentionRegex.MatchString(retention.ValueString()) {
+		return nil, fmt.Errorf("invalid retention period %s, expected format is <integer><unit> where unit is one of Y, M, W, D, H, m, s", retention.ValueString())
+	}
+	result := &powerscale.V1WormDomainRetention{}
+	for _, part := range wormRetentionPartRegex.FindAllStringSubmatch(retention.ValueString(), -1) {
+		value, err := strconv.ParseInt(part[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retention period %s: %s", retention.ValueString(), err.Error())
+		}
+		number := int32(value)
+		switch part[2] {
+		case "Y":
+			result.Years = &number
+		case "M":
+			result.Months = &number
+		case "W":
+			result.Weeks = &number
+		case "D":
+			result.Days = &number
+		case "H":
+			result.Hours = &number
+		case "m":
+			result.Minutes = &number
+		case "s":
+			result.Seconds = &number
+		}
+	}
+	return result, nil
+}
+
+// FormatWormRetention converts the WORM domain retention object to a retention period string such as 1Y6M.
+func FormatWormRetention(retention *powerscale.V1WormDomainRetention) types.String {
+	if retention == nil {
+		return types.StringNull()
+	}
+	parts := []struct {
+		value *int32
+		unit  string
+	}{
+		{retention.Years, "Y"},
+		{retention.Months, "M"},
+		{retention.Weeks, "W"},
+		{retention.Days, "D"},
+		{retention.Hours, "H"},
+		{retention.Minutes, "m"},
+		{retention.Seconds, "s"},
+	}
+	var sb strings.Builder
+	for _, part := range parts {
+		if part.value != nil && *part.value != 0 {
+			sb.WriteString(fmt.Sprintf("%d%s", *part.value, part.unit))
+		}
+	}
+	if sb.Len() == 0 {
+		return types.StringValue("0s")
+	}
+	return types.StringValue(sb.String())
+}
+
+// keepWormRetention returns the prior retention period string if it is equivalent to the WORM domain retention object,
+// so that configured forms like 6M1Y or 1Y0M do not differ from the formatted response.
+func keepWormRetention(prior types.String, retention *powerscale.V1WormDomainRetention) types.String {
+	formatted := FormatWormRetention(retention)
+	if prior.IsNull() || prior.IsUnknown() || formatted.IsNull() {
+		return formatted
+	}
+	parsed, err := ParseWormRetention(prior)
+	if err != nil || !FormatWormRetention(parsed).Equal(formatted) {
+		return formatted
+	}
+	return prior
+}
+
+// GetWormDomain retrieves the WORM domain by ID or path.
+func GetWormDomain(ctx context.Context, client *client.Client, domainID string) (*powerscale.V1WormDomainExtended, error) {
+	result, _, err := client.PscaleOpenAPIClient.WormApi.GetWormv1WormDomain(ctx, domainID).Execute()
+	if err != nil {
+		errStr := constants.ReadWormDomainErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting worm domain - %s : %s", domainID, message)
+	}
+	if len(result.Domains) == 0 {
+		return nil, fmt.Errorf("error getting worm domain - %s : worm domain not found", domainID)
+	}
+	return &result.Domains[0], nil
+}
+
+// ListWormDomains retrieves all the WORM domains.
+func ListWormDomains(ctx context.Context, client *client.Client) ([]powerscale.V1WormDomainExtended, error) {
+	listParam := client.PscaleOpenAPIClient.WormApi.ListWormv1WormDomains(ctx)
+	result, _, err := listParam.Execute()
+	if err != nil {
+		errStr := constants.ReadWormDomainErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting the list of worm domains: %s", message)
+	}
+	domains := result.Domains
+	for result.Resume != nil && *result.Resume != "" {
+		result, _, err = client.PscaleOpenAPIClient.WormApi.ListWormv1WormDomains(ctx).Resume(*result.Resume).Execute()
+		if err != nil {
+			errStr := constants.ReadWormDomainErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return nil, fmt.Errorf("error getting the list of worm domains: %s", message)
+		}
+		domains = append(domains, result.Domains...)
+	}
+	return domains, nil
+}
+
+// CreateWormDomain creates a WORM domain and returns its ID.
+func CreateWormDomain(ctx context.Context, client *client.Client, plan models.WormDomainResourceModel) (string, error) {
+	body := powerscale.V1WormDomain{
+		Path:             plan.Path.ValueString(),
+		Type:             plan.Type.ValueStringPointer(),
+		PrivilegedDelete: plan.PrivilegedDelete.ValueStringPointer(),
+	}
+	if !plan.AutocommitOffset.IsNull() && !plan.AutocommitOffset.IsUnknown() {
+		body.AutocommitOffset = plan.AutocommitOffset.ValueInt64Pointer()
+	}
+	if !plan.OverrideDate.IsNull() && !plan.OverrideDate.IsUnknown() {
+		body.OverrideDate = plan.OverrideDate.ValueInt64Pointer()
+	}
+	var err error
+	if body.DefaultRetention, err = ParseWormRetention(plan.DefaultRetention); err != nil {
+		return "", err
+	}
+	if body.MinRetention, err = ParseWormRetention(plan.MinRetention); err != nil {
+		return "", err
+	}
+	if body.MaxRetention, err = ParseWormRetention(plan.MaxRetention); err != nil {
+		return "", err
+	}
+
+	result, _, err := client.PscaleOpenAPIClient.WormApi.CreateWormv1WormDomain(ctx).V1WormDomain(body).Execute()
+	if err != nil {
+		errStr := constants.CreateWormDomainErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error creating worm domain - %s : %s", plan.Path.ValueString(), message)
+	}
+	return strconv.FormatInt(result.GetId(), 10), nil
+}
+
+// UpdateWormDomain updates the changed settings of a WORM domain.
+func UpdateWormDomain(ctx context.Context, client *client.Client, state, plan models.WormDomainResourceModel) error {
+	body := powerscale.V1WormDomainExtendedExtended{}
+	if !plan.AutocommitOffset.IsUnknown() && !plan.AutocommitOffset.Equal(state.AutocommitOffset) {
+		body.AutocommitOffset = plan.AutocommitOffset.ValueInt64Pointer()
+	}
+	if !plan.OverrideDate.IsUnknown() && !plan.OverrideDate.Equal(state.OverrideDate) {
+		body.OverrideDate = plan.OverrideDate.ValueInt64Pointer()
+	}
+	if !plan.PrivilegedDelete.IsUnknown() && !plan.PrivilegedDelete.Equal(state.PrivilegedDelete) {
+		body.PrivilegedDelete = plan.PrivilegedDelete.ValueStringPointer()
+	}
+	var err error
+	if !plan.DefaultRetention.Equal(state.DefaultRetention) {
+		if body.DefaultRetention, err = ParseWormRetention(plan.DefaultRetention); err != nil {
+			return err
+		}
+	}
+	if !plan.MinRetention.Equal(state.MinRetention) {
+		if body.MinRetention, err = ParseWormRetention(plan.MinRetention); err != nil {
+			return err
+		}
+	}
+	if !plan.MaxRetention.Equal(state.MaxRetention) {
+		if body.MaxRetention, err = ParseWormRetention(plan.MaxRetention); err != nil {
+			return err
+		}
+	}
+
+	if _, err = client.PscaleOpenAPIClient.WormApi.UpdateWormv1WormDomain(ctx, state.ID.ValueString()).V1WormDomain(body).Execute(); err != nil {
+		errStr := constants.UpdateWormDomainErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error updating worm domain - %s : %s", state.Path.ValueString(), message)
+	}
+	return nil
+}
+
+// UpdateWormDomainState updates the resource state according to the WORM domain response.
+// Retention periods already in the model are kept when they are equivalent to the response.
+func UpdateWormDomainState(model *models.WormDomainResourceModel, domain *powerscale.V1WormDomainExtended) {
+	model.ID = types.StringValue(strconv.FormatInt(domain.GetId(), 10))
+	model.Path = types.StringPointerValue(domain.Path)
+	model.Type = types.StringPointerValue(domain.Type)
+	model.DefaultRetention = keepWormRetention(model.DefaultRetention, domain.DefaultRetention)
+	model.MinRetention = keepWormRetention(model.MinRetention, domain.MinRetention)
+	model.MaxRetention = keepWormRetention(model.MaxRetention, domain.MaxRetention)
+	model.AutocommitOffset = types.Int64PointerValue(domain.AutocommitOffset)
+	model.OverrideDate = types.Int64PointerValue(domain.OverrideDate)
+	model.PrivilegedDelete = types.StringPointerValue(domain.PrivilegedDelete)
+	model.Lin = types.Int64PointerValue(domain.Lin)
+	model.Incomplete = types.BoolPointerValue(domain.Incomplete)
+}
+
+// WormDomainPlanDiagnostics explains the irreversible effects of a planned WORM domain change.
+// Changes which cannot be applied at all, like moving the domain or turning privileged delete back on
+// after it has been disabled, are reported as errors so that they fail at plan time.
+func WormDomainPlanDiagnostics(state, plan *models.WormDomainResourceModel) (diags diag.Diagnostics) {
+	// destroy
+	if plan == nil {
+		diags.AddWarning("WORM domain is not deleted",
+			fmt.Sprintf("PowerScale does not allow deleting WORM domains. The WORM domain %s is only removed from the Terraform state and stays on the cluster.", state.Path.ValueString()))
+		return
+	}
+
+	// create
+	if state == nil {
+		detail := fmt.Sprintf("Creating the WORM domain %s cannot be undone: the domain cannot be deleted and files committed to it cannot be modified or deleted until their retention period expires.", plan.Path.ValueString())
+		if plan.Type.ValueString() == "compliance" {
+			detail += " Files in a compliance domain cannot be deleted before expiry even by the root user, and the retention date of committed files can only be extended."
+		}
+		if plan.PrivilegedDelete.ValueString() == "disabled" {
+			detail += " privileged_delete is set to disabled, which is permanent: committed files can never be deleted before expiry."
+		}
+		if !plan.AutocommitOffset.IsNull() && !plan.AutocommitOffset.IsUnknown() {
+			detail += fmt.Sprintf(" Files not modified for %d seconds are committed automatically.", plan.AutocommitOffset.ValueInt64())
+		}
+		diags.AddWarning("WORM domain creation is irreversible", detail)
+		return
+	}
+
+	// update
+	if !plan.Path.Equal(state.Path) {
+		diags.AddAttributeError(path.Root("path"), "WORM domain path cannot be changed",
+			fmt.Sprintf("The WORM domain %s cannot be moved or deleted, so it cannot be replaced by a domain on %s.", state.Path.ValueString(), plan.Path.ValueString()))
+	}
+	if !plan.Type.IsUnknown() && !plan.Type.Equal(state.Type) {
+		diags.AddAttributeError(path.Root("type"), "WORM domain type cannot be changed",
+			fmt.Sprintf("The type of the WORM domain %s is %s and cannot be changed.", state.Path.ValueString(), state.Type.ValueString()))
+	}
+	if state.PrivilegedDelete.ValueString() == "disabled" && !plan.PrivilegedDelete.IsUnknown() && !plan.PrivilegedDelete.Equal(state.PrivilegedDelete) {
+		diags.AddAttributeError(path.Root("privileged_delete"), "privileged_delete cannot be enabled",
+			fmt.Sprintf("privileged_delete of the WORM domain %s is permanently disabled.", state.Path.ValueString()))
+	}
+	if plan.PrivilegedDelete.ValueString() == "disabled" && state.PrivilegedDelete.ValueString() != "disabled" {
+		diags.AddWarning("Disabling privileged_delete is irreversible",
+			fmt.Sprintf("Once privileged_delete of the WORM domain %s is disabled, it can never be turned on again.", state.Path.ValueString()))
+	}
+	if !plan.AutocommitOffset.IsNull() && !plan.AutocommitOffset.IsUnknown() && !plan.AutocommitOffset.Equal(state.AutocommitOffset) {
+		diags.AddWarning("WORM domain autocommit offset changed",
+			fmt.Sprintf("Files in the WORM domain %s which have not been modified for %d seconds will be committed automatically and cannot be modified afterwards.", state.Path.ValueString(), plan.AutocommitOffset.ValueInt64()))
+	}
+	return
+}
@@ -0,0 +1,227 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// wormRetentionDateFormat is the retention date format accepted by the namespace API.
+const wormRetentionDateFormat = "2006-01-02 15:04:05 MST"
+
+// WormFileDetailType returns the object type of the WORM state of a file.
+func WormFileDetailType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"path":           types.StringType,
+		"committed":      types.BoolType,
+		"retention_date": types.StringType,
+		"domain_id":      types.Int64Type,
+		"domain_path":    types.StringType,
+	}
+}
+
+// GetWormFileProperties returns the WORM properties of a file.
+func GetWormFileProperties(ctx context.Context, client *client.Client, filePath string) (*powerscale.NamespaceWormProperties, error) {
+	result, _, err := client.PscaleOpenAPIClient.NamespaceApi.GetWormProperties(ctx, strings.TrimLeft(filePath, "/")).Worm(true).Execute()
+	if err != nil {
+		errStr := constants.ReadWormFileErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return nil, fmt.Errorf("error getting worm properties of %s : %s", filePath, message)
+	}
+	return result, nil
+}
+
+// SetWormFileProperties commits a file to WORM and/or sets its retention date.
+func SetWormFileProperties(ctx context.Context, client *client.Client, filePath string, commit bool, retentionDate *time.Time) error {
+	body := powerscale.NamespaceWormCreateParams{}
+	if commit {
+		body.CommitToWorm = &commit
+	}
+	if retentionDate != nil {
+		body.WormRetentionDate = New(retentionDate.UTC().Format(wormRetentionDateFormat))
+	}
+	setParam := client.PscaleOpenAPIClient.NamespaceApi.SetWormProperties(ctx, strings.TrimLeft(filePath, "/")).Worm(true)
+	if _, _, err := setParam.NamespaceWormCreateParams(body).Execute(); err != nil {
+		errStr := constants.UpdateWormFileErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error setting worm properties of %s : %s", filePath, message)
+	}
+	return nil
+}
+
+// getWormFileRetentionDate returns the retention date of a file, or nil if the file has no retention date.
+func getWormFileRetentionDate(properties *powerscale.NamespaceWormProperties) *time.Time {
+	if properties == nil || properties.WormRetentionDateVal == nil || *properties.WormRetentionDateVal <= 0 {
+		return nil
+	}
+	retentionDate := time.Unix(*properties.WormRetentionDateVal, 0).UTC()
+	return &retentionDate
+}
+
+// ParseWormFileRetentionDate parses the retention date of the resource, which is in RFC3339 format.
+func ParseWormFileRetentionDate(retentionDate types.String) (*time.Time, error) {
+	if retentionDate.IsNull() || retentionDate.IsUnknown() {
+		return nil, nil
+	}
+	result, err := time.Parse(time.RFC3339, retentionDate.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid retention date %s, expected RFC3339 format, e.g. 2030-01-01T00:00:00Z", retentionDate.ValueString())
+	}
+	return &result, nil
+}
+
+// ApplyWormFiles commits the files of the plan to WORM and extends their retention dates.
+// The retention date of a file is never shortened: an error is reported if a file is already retained beyond the planned date.
+func ApplyWormFiles(ctx context.Context, client *client.Client, plan models.WormFileResourceModel) (diags diag.Diagnostics) {
+	var paths []string
+	if diags = plan.Paths.ElementsAs(ctx, &paths, false); diags.HasError() {
+		return
+	}
+	retentionDate, err := ParseWormFileRetentionDate(plan.RetentionDate)
+	if err != nil {
+		diags.AddAttributeError(path.Root("retention_date"), "Invalid retention date", err.Error())
+		return
+	}
+
+	for _, filePath := range paths {
+		properties, err := GetWormFileProperties(ctx, client, filePath)
+		if err != nil {
+			diags.AddError("Error reading WORM properties", err.Error())
+			continue
+		}
+
+		var newRetentionDate *time.Time
+		if current := getWormFileRetentionDate(properties); retentionDate != nil {
+			if current != nil && current.After(*retentionDate) {
+				diags.AddError("Error setting WORM retention date",
+					fmt.Sprintf("Refusing to shorten the retention date of %s from %s to %s.", filePath, current.Format(time.RFC3339), retentionDate.Format(time.RFC3339)))
+				continue
+			}
+			if current == nil || current.Before(*retentionDate) {
+				newRetentionDate = retentionDate
+			}
+		}
+		commit := plan.Commit.ValueBool() && !properties.GetWormCommitted()
+		if !commit && newRetentionDate == nil {
+			continue
+		}
+		if err := SetWormFileProperties(ctx, client, filePath, commit, newRetentionDate); err != nil {
+			diags.AddError("Error setting WORM properties", err.Error())
+		}
+	}
+	return
+}
+
+// UpdateWormFileResourceState refreshes the WORM state of the managed files.
+// commit and retention_date are only changed in the state when a file has drifted from them, so that the next apply corrects the drift.
+func UpdateWormFileResourceState(ctx context.Context, client *client.Client, model *models.WormFileResourceModel) (diags diag.Diagnostics) {
+	var paths []string
+	if diags = model.Paths.ElementsAs(ctx, &paths, false); diags.HasError() {
+		return
+	}
+	retentionDate, err := ParseWormFileRetentionDate(model.RetentionDate)
+	if err != nil {
+		diags.AddAttributeError(path.Root("retention_date"), "Invalid retention date", err.Error())
+		return
+	}
+
+	var fileAttrs []attr.Value
+	for _, filePath := range paths {
+		properties, err := GetWormFileProperties(ctx, client, filePath)
+		if err != nil {
+			diags.AddError("Error reading WORM properties", err.Error())
+			return
+		}
+
+		detail := models.WormFileDetailModel{
+			Path:          types.StringValue(filePath),
+			Committed:     types.BoolValue(properties.GetWormCommitted()),
+			RetentionDate: types.StringNull(),
+			DomainID:      types.Int64PointerValue(properties.DomainId),
+			DomainPath:    types.StringPointerValue(properties.DomainPath),
+		}
+		current := getWormFileRetentionDate(properties)
+		if current != nil {
+			detail.RetentionDate = types.StringValue(current.Format(time.RFC3339))
+		}
+
+		if model.Commit.ValueBool() && !properties.GetWormCommitted() {
+			model.Commit = types.BoolValue(false)
+		}
+		if retentionDate != nil && (current == nil || current.Before(*retentionDate)) {
+			if current == nil {
+				model.RetentionDate = types.StringNull()
+			} else if !model.RetentionDate.IsNull() {
+				model.RetentionDate = types.StringValue(current.Format(time.RFC3339))
+			}
+		}
+
+		fileObject, objDiags := types.ObjectValueFrom(ctx, WormFileDetailType(), detail)
+		if objDiags.HasError() {
+			diags.Append(objDiags...)
+			return
+		}
+		fileAttrs = append(fileAttrs, fileObject)
+	}
+
+	model.Files, diags = types.ListValue(types.ObjectType{AttrTypes: WormFileDetailType()}, fileAttrs)
+	model.ID = types.StringValue("worm_file")
+	return
+}
+
+// WormFilePlanDiagnostics reports the planned WORM changes which cannot be applied or undone.
+func WormFilePlanDiagnostics(state, plan *models.WormFileResourceModel) (diags diag.Diagnostics) {
+	// destroy
+	if plan == nil {
+		diags.AddWarning("WORM files are not released",
+			"Committed files cannot be uncommitted and their retention dates cannot be shortened. The files are only removed from the Terraform state.")
+		return
+	}
+
+	if plan.Commit.ValueBool() && (state == nil || !state.Commit.ValueBool() || !plan.Paths.Equal(state.Paths)) {
+		diags.AddWarning("Committing files to WORM is irreversible",
+			"Once committed, the files cannot be modified or deleted until their retention date has passed.")
+	}
+
+	if state == nil {
+		return
+	}
+	if state.Commit.ValueBool() && !plan.Commit.IsUnknown() && !plan.Commit.ValueBool() {
+		diags.AddAttributeError(path.Root("commit"), "Committed files cannot be uncommitted",
+			"The files are already committed to WORM and cannot be uncommitted. Remove the files from paths to stop managing them.")
+	}
+	stateDate, _ := ParseWormFileRetentionDate(state.RetentionDate)
+	planDate, _ := ParseWormFileRetentionDate(plan.RetentionDate)
+	if stateDate != nil && planDate != nil && planDate.Before(*stateDate) {
+		diags.AddAttributeError(path.Root("retention_date"), "Retention date cannot be shortened",
+			fmt.Sprintf("The retention date cannot be shortened from %s to %s.", state.RetentionDate.ValueString(), plan.RetentionDate.ValueString()))
+	}
+	return
+}
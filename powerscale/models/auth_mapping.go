@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// AuthMappingResourceModel describes the resource data model.
+type AuthMappingResourceModel struct {
+	// Auth Mapping ID.
+	ID types.String `tfsdk:"id"`
+	// The zone to which the identity mappings apply.
+	Zone types.String `tfsdk:"zone"`
+	// Set of identity mapping entries managed by this resource.
+	Mappings types.Set `tfsdk:"mappings"`
+}
+
+// AuthMappingItemModel describes a single source to target identity mapping entry.
+type AuthMappingItemModel struct {
+	// Source persona of the mapping, e.g. SID:S-1-5-21-..., UID:1000 or GID:1000.
+	Source types.String `tfsdk:"source"`
+	// Target persona of the mapping, e.g. SID:S-1-5-21-..., UID:1000 or GID:1000.
+	Target types.String `tfsdk:"target"`
+	// Create a bi-directional mapping from source to target and target to source.
+	TwoWay types.Bool `tfsdk:"two_way"`
+	// Use the target identity as the on-disk identity.
+	OnDisk types.Bool `tfsdk:"on_disk"`
+}
+
+// AuthMappingDataSourceModel describes the data source data model.
+type AuthMappingDataSourceModel struct {
+	ID           types.String             `tfsdk:"id"`
+	AuthMappings []AuthMappingDetailModel `tfsdk:"auth_mappings_details"`
+
+	// Filters
+	AuthMappingFilter *AuthMappingFilterType `tfsdk:"filter"`
+}
+
+// AuthMappingDetailModel describes the identity mappings of a source persona.
+type AuthMappingDetailModel struct {
+	// Source persona ID.
+	Source types.String `tfsdk:"source"`
+	// Source persona name.
+	SourceName types.String `tfsdk:"source_name"`
+	// Source persona type.
+	SourceType types.String `tfsdk:"source_type"`
+	// Mapped target identities.
+	Targets []AuthMappingTargetModel `tfsdk:"targets"`
+}
+
+// AuthMappingTargetModel describes a target identity of a mapping.
+type AuthMappingTargetModel struct {
+	// Target persona ID.
+	ID types.String `tfsdk:"id"`
+	// Target persona name.
+	Name types.String `tfsdk:"name"`
+	// Target persona type.
+	Type types.String `tfsdk:"type"`
+	// Whether the target is used as the on-disk identity.
+	OnDisk types.Bool `tfsdk:"on_disk"`
+	// How the mapping was created, e.g. auto, external or manual.
+	MappingType types.String `tfsdk:"mapping_type"`
+}
+
+// AuthMappingFilterType describes the filter data model.
+type AuthMappingFilterType struct {
+	Zone    types.String   `tfsdk:"zone"`
+	Sources []types.String `tfsdk:"sources"`
+}
+
+// AuthMappingActionResourceModel describes the auth mapping action resource data model.
+type AuthMappingActionResourceModel struct {
+	// Placeholder ID.
+	ID types.String `tfsdk:"id"`
+	// The zone on which the action is performed.
+	Zone types.String `tfsdk:"zone"`
+	// The action to perform, import or flush.
+	Action types.String `tfsdk:"action"`
+	// File on the cluster to import the identity mappings from.
+	File types.String `tfsdk:"file"`
+	// Replace existing identity mappings while importing.
+	Replace types.Bool `tfsdk:"replace"`
+	// Only flush identity mappings matching this filter.
+	Filter types.String `tfsdk:"filter"`
+	// Remove the identity mappings instead of only flushing them from the cache.
+	Remove types.Bool `tfsdk:"remove"`
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// AuthProviderStatusDataSourceModel describes the data source data model.
+type AuthProviderStatusDataSourceModel struct {
+	ID            types.String                    `tfsdk:"id"`
+	AuthProviders []AuthProviderStatusDetailModel `tfsdk:"auth_providers_status"`
+
+	// Filters
+	AuthProviderStatusFilter *AuthProviderStatusFilterType `tfsdk:"filter"`
+}
+
+// AuthProviderStatusDetailModel describes the status of an authentication provider.
+type AuthProviderStatusDetailModel struct {
+	// Provider ID in the form of provider-type:provider-name, as referenced by access zones.
+	ID types.String `tfsdk:"id"`
+	// Provider name.
+	Name types.String `tfsdk:"name"`
+	// Provider type, one of ads, ldap, nis, file, local.
+	Type types.String `tfsdk:"type"`
+	// Name of the access zone the provider is bound to.
+	ZoneName types.String `tfsdk:"zone_name"`
+	// Status reported by PowerScale.
+	Status types.String `tfsdk:"status"`
+	// Whether the provider is online.
+	Online types.Bool `tfsdk:"online"`
+	// Domain controllers or servers the provider is connected to.
+	Servers []types.String `tfsdk:"servers"`
+	// Last error encountered while checking the provider.
+	LastError types.String `tfsdk:"last_error"`
+}
+
+// AuthProviderStatusFilterType describes the filter data model.
+type AuthProviderStatusFilterType struct {
+	Zone  types.String   `tfsdk:"zone"`
+	Names []types.String `tfsdk:"names"`
+	Types []types.String `tfsdk:"types"`
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// CloudAccountResourceModel describes the cloud account resource data model.
+type CloudAccountResourceModel struct {
+	// The ID of the cloud account.
+	ID types.String `tfsdk:"id"`
+	// The name of the cloud account.
+	Name types.String `tfsdk:"name"`
+	// The type of the cloud storage.
+	Type types.String `tfsdk:"type"`
+	// The URI of the cloud storage.
+	URI types.String `tfsdk:"uri"`
+	// The username of the cloud storage account.
+	AccountUsername types.String `tfsdk:"account_username"`
+	// The key or password of the cloud storage account, write-only.
+	Key types.String `tfsdk:"key"`
+	// The version of the key, changed to send a new key.
+	KeyVersion types.Int64 `tfsdk:"key_version"`
+	// The S3 account ID, used for the s3 type.
+	AccountID types.String `tfsdk:"account_id"`
+	// The region of the cloud storage, used for the s3 type.
+	StorageRegion types.String `tfsdk:"storage_region"`
+	// The name of the network proxy used to reach the cloud storage.
+	Proxy types.String `tfsdk:"proxy"`
+	// Whether the cloud account is enabled.
+	Enabled types.Bool `tfsdk:"enabled"`
+	// Whether to skip the SSL certificate validation of the cloud storage.
+	SkipSslValidation types.Bool `tfsdk:"skip_ssl_validation"`
+	// The state of the cloud account.
+	State types.String `tfsdk:"state"`
+}
+
+// CloudAccountDataSourceModel describes the cloud account datasource data model.
+type CloudAccountDataSourceModel struct {
+	ID            types.String              `tfsdk:"id"`
+	CloudAccounts []CloudAccountDetailModel `tfsdk:"cloud_accounts_details"`
+	Filter        *CloudAccountFilterModel  `tfsdk:"filter"`
+}
+
+// CloudAccountFilterModel describes the filter data model.
+type CloudAccountFilterModel struct {
+	Names []types.String `tfsdk:"names"`
+}
+
+// CloudAccountDetailModel describes the cloud account details.
+type CloudAccountDetailModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	URI               types.String `tfsdk:"uri"`
+	AccountUsername   types.String `tfsdk:"account_username"`
+	AccountID         types.String `tfsdk:"account_id"`
+	StorageRegion     types.String `tfsdk:"storage_region"`
+	Proxy             types.String `tfsdk:"proxy"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	SkipSslValidation types.Bool   `tfsdk:"skip_ssl_validation"`
+	State             types.String `tfsdk:"state"`
+}
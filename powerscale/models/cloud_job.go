@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// CloudJobResourceModel describes the cloud job resource data model.
+type CloudJobResourceModel struct {
+	// The ID of the cloud job.
+	ID types.String `tfsdk:"id"`
+	// The type of the cloud job, archive or recall.
+	Type types.String `tfsdk:"type"`
+	// The files to archive or recall.
+	Files types.List `tfsdk:"files"`
+	// The directories to archive or recall.
+	Directories types.List `tfsdk:"directories"`
+	// The file pool policy used to archive the files.
+	Policy types.String `tfsdk:"policy"`
+	// Whether to wait for the job to complete.
+	WaitForCompletion types.Bool `tfsdk:"wait_for_completion"`
+	// Seconds between two polls of the job.
+	PollInterval types.Int64 `tfsdk:"poll_interval"`
+	// Seconds to wait for the job to complete.
+	Timeout types.Int64 `tfsdk:"timeout"`
+	// The state of the cloud job.
+	State types.String `tfsdk:"state"`
+}
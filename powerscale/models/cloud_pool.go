@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// CloudPoolResourceModel describes the cloud pool resource data model.
+type CloudPoolResourceModel struct {
+	// The ID of the cloud pool.
+	ID types.String `tfsdk:"id"`
+	// The name of the cloud pool.
+	Name types.String `tfsdk:"name"`
+	// The type of the cloud storage of the pool.
+	Type types.String `tfsdk:"type"`
+	// The names of the cloud accounts in the pool.
+	Accounts types.List `tfsdk:"accounts"`
+	// The description of the cloud pool.
+	Description types.String `tfsdk:"description"`
+	// The vendor of the cloud storage.
+	Vendor types.String `tfsdk:"vendor"`
+	// The state of the cloud pool.
+	State types.String `tfsdk:"state"`
+}
+
+// CloudPoolDataSourceModel describes the cloud pool datasource data model.
+type CloudPoolDataSourceModel struct {
+	ID         types.String           `tfsdk:"id"`
+	CloudPools []CloudPoolDetailModel `tfsdk:"cloud_pools_details"`
+	Filter     *CloudPoolFilterModel  `tfsdk:"filter"`
+}
+
+// CloudPoolFilterModel describes the filter data model.
+type CloudPoolFilterModel struct {
+	Names []types.String `tfsdk:"names"`
+}
+
+// CloudPoolDetailModel describes the cloud pool details.
+type CloudPoolDetailModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Accounts    types.List   `tfsdk:"accounts"`
+	Description types.String `tfsdk:"description"`
+	Vendor      types.String `tfsdk:"vendor"`
+	State       types.String `tfsdk:"state"`
+}
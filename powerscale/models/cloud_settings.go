@@ -0,0 +1,29 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// CloudSettingsModel describes the CloudPools settings.
+type CloudSettingsModel struct {
+	ID types.String `tfsdk:"id"`
+	// The default values of the cloudpool policy action of the file pool policies.
+	CloudPolicyDefaults types.Object `tfsdk:"cloud_policy_defaults"`
+	// The name of the network proxy used by default to reach the cloud storage.
+	DefaultNetworkProxy types.String `tfsdk:"default_network_proxy"`
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// DataReductionStatsDataSourceModel describes the data reduction statistics datasource data model.
+type DataReductionStatsDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// Statistics keys to query.
+	StatisticsKeys []types.String                  `tfsdk:"statistics_keys"`
+	Statistics     []DataReductionStatisticModel   `tfsdk:"statistics"`
+	StoragePools   []DataReductionStoragePoolModel `tfsdk:"storage_pools"`
+	Filter         *DataReductionStatsFilterModel  `tfsdk:"filter"`
+}
+
+// DataReductionStatsFilterModel describes the filter data model.
+type DataReductionStatsFilterModel struct {
+	Names []types.String `tfsdk:"names"`
+	Type  types.String   `tfsdk:"type"`
+}
+
+// DataReductionStatisticModel describes the current value of a statistics key.
+type DataReductionStatisticModel struct {
+	Key   types.String `tfsdk:"key"`
+	Devid types.Int64  `tfsdk:"devid"`
+	Time  types.Int64  `tfsdk:"time"`
+	Value types.String `tfsdk:"value"`
+	Error types.String `tfsdk:"error"`
+}
+
+// DataReductionStoragePoolModel describes the usage of a node pool or a tier.
+type DataReductionStoragePoolModel struct {
+	ID       types.Int64                `tfsdk:"id"`
+	Name     types.String               `tfsdk:"name"`
+	Type     types.String               `tfsdk:"type"`
+	Lnns     types.List                 `tfsdk:"lnns"`
+	Children types.List                 `tfsdk:"children"`
+	Usage    *StoragepoolTierUsageModel `tfsdk:"usage"`
+}
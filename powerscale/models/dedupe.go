@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// DedupeSettingsModel Specifies the dedupe settings.
+type DedupeSettingsModel struct {
+	ID types.String `tfsdk:"id"`
+	// Paths that will be assessed.
+	AssessPaths types.List `tfsdk:"assess_paths"`
+	// Paths that will be deduplicated.
+	Paths types.List `tfsdk:"paths"`
+	// Job started after the settings change, Dedupe or DedupeAssessment.
+	JobType types.String `tfsdk:"job_type"`
+	// ID of the job started after the settings were applied.
+	JobID types.Int64 `tfsdk:"job_id"`
+}
+
+// DedupeReportDataSourceModel describes the dedupe report datasource data model.
+type DedupeReportDataSourceModel struct {
+	ID            types.String              `tfsdk:"id"`
+	Summary       *DedupeSummaryModel       `tfsdk:"summary"`
+	DedupeReports []DedupeReportDetailModel `tfsdk:"dedupe_reports"`
+	Filter        *DedupeReportFilterModel  `tfsdk:"filter"`
+}
+
+// DedupeReportFilterModel describes the filter data model.
+type DedupeReportFilterModel struct {
+	JobType types.String  `tfsdk:"job_type"`
+	JobIDs  []types.Int64 `tfsdk:"job_ids"`
+}
+
+// DedupeSummaryModel describes the dedupe savings of the cluster.
+type DedupeSummaryModel struct {
+	BlockSize               types.Int64 `tfsdk:"block_size"`
+	EstimatedPhysicalBlocks types.Int64 `tfsdk:"estimated_physical_blocks"`
+	EstimatedSavedBlocks    types.Int64 `tfsdk:"estimated_saved_blocks"`
+	LogicalBlocks           types.Int64 `tfsdk:"logical_blocks"`
+	SavedLogicalBlocks      types.Int64 `tfsdk:"saved_logical_blocks"`
+	TotalBlocks             types.Int64 `tfsdk:"total_blocks"`
+	UsedBlocks              types.Int64 `tfsdk:"used_blocks"`
+	// Bytes saved by deduplication.
+	LogicalSavedBytes types.Int64 `tfsdk:"logical_saved_bytes"`
+	// Physical bytes estimated to be saved by deduplication.
+	EstimatedPhysicalSavedBytes types.Int64 `tfsdk:"estimated_physical_saved_bytes"`
+}
+
+// DedupeReportDetailModel describes a dedupe report.
+type DedupeReportDetailModel struct {
+	ID        types.String `tfsdk:"id"`
+	JobID     types.Int64  `tfsdk:"job_id"`
+	JobType   types.String `tfsdk:"job_type"`
+	StartTime types.Int64  `tfsdk:"start_time"`
+	EndTime   types.Int64  `tfsdk:"end_time"`
+	// Results of the job, estimated for a DedupeAssessment job.
+	ScannedBlocks types.Int64   `tfsdk:"scanned_blocks"`
+	SampledBlocks types.Int64   `tfsdk:"sampled_blocks"`
+	DedupedBlocks types.Int64   `tfsdk:"deduped_blocks"`
+	DedupePercent types.Float64 `tfsdk:"dedupe_percent"`
+	SavedBytes    types.Int64   `tfsdk:"saved_bytes"`
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FilePoolPolicyPreviewDataSourceModel describes the file pool policy preview data source model.
+type FilePoolPolicyPreviewDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// Directory subtree to evaluate.
+	Path types.String `tfsdk:"path"`
+	// Name of an existing file pool policy to evaluate.
+	PolicyName types.String `tfsdk:"policy_name"`
+	// Candidate file matching rules to evaluate.
+	FileMatchingPattern *V1FilepoolPolicyFileMatchingPattern `tfsdk:"file_matching_pattern"`
+	// Maximum number of files to scan.
+	MaxFiles types.Int64 `tfsdk:"max_files"`
+	// Maximum number of directories to list.
+	MaxDirectories types.Int64 `tfsdk:"max_directories"`
+	// Maximum directory depth to descend below path.
+	MaxDepth types.Int64 `tfsdk:"max_depth"`
+	// Maximum number of matching files to return.
+	SampleSize types.Int64 `tfsdk:"sample_size"`
+	// Storage pool the matching files would land on.
+	MatchedStoragePool types.String `tfsdk:"matched_storage_pool"`
+	// Storage pool of the default policy, where non matching files would land.
+	DefaultStoragePool types.String `tfsdk:"default_storage_pool"`
+	// Number of files scanned.
+	ScannedFiles types.Int64 `tfsdk:"scanned_files"`
+	// Number of scanned files matching the pattern.
+	MatchedFiles types.Int64 `tfsdk:"matched_files"`
+	// Total size in bytes of the matching files.
+	MatchedBytes types.Int64 `tfsdk:"matched_bytes"`
+	// Whether the scan stopped at max_files before walking the whole subtree.
+	Truncated types.Bool `tfsdk:"truncated"`
+	// Sample of matching files.
+	SampleMatches []FilePoolPolicyPreviewMatchModel `tfsdk:"sample_matches"`
+}
+
+// FilePoolPolicyPreviewMatchModel describes a file matched by the previewed pattern.
+type FilePoolPolicyPreviewMatchModel struct {
+	Path types.String `tfsdk:"path"`
+	Size types.Int64  `tfsdk:"size"`
+}
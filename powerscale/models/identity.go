@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// IdentityDataSourceModel describes the data source data model.
+type IdentityDataSourceModel struct {
+	ID         types.String          `tfsdk:"id"`
+	Identities []IdentityDetailModel `tfsdk:"identities_details"`
+
+	// Filters
+	IdentityFilter *IdentityFilterType `tfsdk:"filter"`
+}
+
+// IdentityDetailModel describes the resolved identity of a name.
+type IdentityDetailModel struct {
+	// The name which is looked up.
+	Lookup types.String `tfsdk:"lookup"`
+	// Specifies the persona name of the identity.
+	Name types.String `tfsdk:"name"`
+	// Specifies the type of the identity, user or group.
+	Type types.String `tfsdk:"type"`
+	// Specifies the authentication provider that the identity belongs to.
+	Provider types.String `tfsdk:"provider"`
+	// Specifies the domain that the identity belongs to.
+	Domain types.String `tfsdk:"domain"`
+	// Specifies the security identifier of the identity.
+	SID types.String `tfsdk:"sid"`
+	// Specifies the user ID, only for users.
+	UID types.String `tfsdk:"uid"`
+	// Specifies the group ID, or the primary group ID of users.
+	GID types.String `tfsdk:"gid"`
+	// Specifies the primary group SID, only for users.
+	PrimaryGroupSID types.String `tfsdk:"primary_group_sid"`
+	// Specifies the groups that the identity is member of.
+	MemberOf []V1AuthAccessAccessItemFileGroup `tfsdk:"member_of"`
+	// Specifies the identities mapped to the identity.
+	MappedIdentities []AuthMappingTargetModel `tfsdk:"mapped_identities"`
+}
+
+// IdentityFilterType describes the filter data model.
+type IdentityFilterType struct {
+	Names    []types.String `tfsdk:"names"`
+	Zone     types.String   `tfsdk:"zone"`
+	Provider types.String   `tfsdk:"provider"`
+}
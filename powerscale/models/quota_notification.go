@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// QuotaNotificationResourceModel describes the notification rule of a quota.
+type QuotaNotificationResourceModel struct {
+	// The ID of the notification rule.
+	ID types.String `tfsdk:"id"`
+	// The ID of the quota the rule belongs to.
+	QuotaID types.String `tfsdk:"quota_id"`
+	// The quota threshold the rule is about.
+	Threshold types.String `tfsdk:"threshold"`
+	// The condition of the threshold which triggers the rule.
+	Condition types.String `tfsdk:"condition"`
+	// Whether to send an alert.
+	ActionAlert types.Bool `tfsdk:"action_alert"`
+	// Whether to send an email to the owner of the quota.
+	ActionEmailOwner types.Bool `tfsdk:"action_email_owner"`
+	// The email address to send the notification to.
+	ActionEmailAddress types.String `tfsdk:"action_email_address"`
+	// The path of the email template.
+	EmailTemplate types.String `tfsdk:"email_template"`
+	// The schedule of the notification, used by the violated and expired conditions.
+	Schedule types.String `tfsdk:"schedule"`
+	// Seconds between two notifications, used by the exceeded and denied conditions.
+	Holdoff types.Int64 `tfsdk:"holdoff"`
+}
+
+// QuotaDefaultNotificationResourceModel describes a global default quota notification rule.
+type QuotaDefaultNotificationResourceModel struct {
+	// The ID of the notification rule.
+	ID types.String `tfsdk:"id"`
+	// The quota threshold the rule is about.
+	Threshold types.String `tfsdk:"threshold"`
+	// The condition of the threshold which triggers the rule.
+	Condition types.String `tfsdk:"condition"`
+	// Whether to send an alert.
+	ActionAlert types.Bool `tfsdk:"action_alert"`
+	// Whether to send an email to the owner of the quota.
+	ActionEmailOwner types.Bool `tfsdk:"action_email_owner"`
+	// The email address to send the notification to.
+	ActionEmailAddress types.String `tfsdk:"action_email_address"`
+	// The path of the email template.
+	EmailTemplate types.String `tfsdk:"email_template"`
+	// The schedule of the notification, used by the violated and expired conditions.
+	Schedule types.String `tfsdk:"schedule"`
+	// Seconds between two notifications, used by the exceeded and denied conditions.
+	Holdoff types.Int64 `tfsdk:"holdoff"`
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// QuotaOverrideResourceModel describes the resource data model.
+type QuotaOverrideResourceModel struct {
+	// The system ID given to the overridden quota.
+	ID types.String `tfsdk:"id"`
+	// The /ifs path governed by the default quota.
+	Path types.String `tfsdk:"path"`
+	// The type of the overridden quota.
+	Type types.String `tfsdk:"type"`
+	// The serialized form of the persona of the overridden quota.
+	Persona types.String `tfsdk:"persona"`
+	// Optional named zone to use for user and group resolution.
+	Zone types.String `tfsdk:"zone"`
+	// If true, quota governs snapshot data as well as head data.
+	IncludeSnapshots types.Bool `tfsdk:"include_snapshots"`
+	// True if the quota provides enforcement, otherwise an accounting quota.
+	Enforced types.Bool `tfsdk:"enforced"`
+	// Thresholds apply on quota accounting metric.
+	ThresholdsOn types.String `tfsdk:"thresholds_on"`
+	// The custom thresholds of the overridden quota.
+	Thresholds types.Object `tfsdk:"thresholds"`
+	// True if the quota is linked and controlled by the default quota.
+	Linked types.Bool `tfsdk:"linked"`
+}
+
+// QuotaOverrideThresholds describes the custom thresholds of the overridden quota.
+type QuotaOverrideThresholds struct {
+	// Usage bytes at which notifications will be sent but writes will not be denied.
+	Advisory types.Int64 `tfsdk:"advisory"`
+	// Usage bytes at which further writes will be denied.
+	Hard types.Int64 `tfsdk:"hard"`
+	// Usage bytes at which notifications will be sent and soft grace time will be started.
+	Soft types.Int64 `tfsdk:"soft"`
+	// Time in seconds after which the soft threshold has been hit before writes will be denied.
+	SoftGrace types.Int64 `tfsdk:"soft_grace"`
+}
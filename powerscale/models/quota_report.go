@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// QuotaReportSettingsModel Specifies the quota report settings.
+type QuotaReportSettingsModel struct {
+	ID types.String `tfsdk:"id"`
+	// The directory where the live reports are written.
+	LiveDir types.String `tfsdk:"live_dir"`
+	// The number of live reports to keep.
+	LiveRetain types.Int64 `tfsdk:"live_retain"`
+	// The schedule of the scheduled reports.
+	Schedule types.String `tfsdk:"schedule"`
+	// The directory where the scheduled reports are written.
+	ScheduledDir types.String `tfsdk:"scheduled_dir"`
+	// The number of scheduled reports to keep.
+	ScheduledRetain types.Int64 `tfsdk:"scheduled_retain"`
+}
+
+// QuotaReportDataSourceModel describes the quota report datasource data model.
+type QuotaReportDataSourceModel struct {
+	ID           types.String             `tfsdk:"id"`
+	QuotaReports []QuotaReportDetailModel `tfsdk:"quota_reports_details"`
+	Filter       *QuotaReportFilterModel  `tfsdk:"filter"`
+}
+
+// QuotaReportFilterModel describes the filter data model.
+type QuotaReportFilterModel struct {
+	IDs  []types.String `tfsdk:"ids"`
+	Type types.String   `tfsdk:"type"`
+}
+
+// QuotaReportDetailModel describes a quota report and its contents.
+type QuotaReportDetailModel struct {
+	ID        types.String            `tfsdk:"id"`
+	Type      types.String            `tfsdk:"type"`
+	Generated types.Int64             `tfsdk:"generated"`
+	Quotas    []QuotaReportQuotaModel `tfsdk:"quotas"`
+}
+
+// QuotaReportQuotaModel describes the usage of a quota at report time.
+type QuotaReportQuotaModel struct {
+	ID         types.String               `tfsdk:"id"`
+	Path       types.String               `tfsdk:"path"`
+	Type       types.String               `tfsdk:"type"`
+	Usage      QuotaReportUsageModel      `tfsdk:"usage"`
+	Thresholds QuotaReportThresholdsModel `tfsdk:"thresholds"`
+}
+
+// QuotaReportUsageModel describes the usage of a quota at report time.
+type QuotaReportUsageModel struct {
+	Applogical types.Int64 `tfsdk:"applogical"`
+	Fslogical  types.Int64 `tfsdk:"fslogical"`
+	Fsphysical types.Int64 `tfsdk:"fsphysical"`
+	Inodes     types.Int64 `tfsdk:"inodes"`
+	Physical   types.Int64 `tfsdk:"physical"`
+}
+
+// QuotaReportThresholdsModel describes the thresholds of a quota at report time.
+type QuotaReportThresholdsModel struct {
+	Advisory types.Int64 `tfsdk:"advisory"`
+	Soft     types.Int64 `tfsdk:"soft"`
+	Hard     types.Int64 `tfsdk:"hard"`
+}
@@ -30,6 +30,8 @@ type RoleResourceModel struct {
 	Members     types.List   `tfsdk:"members"`
 	Privileges  types.List   `tfsdk:"privileges"`
 	Description types.String `tfsdk:"description"`
+	// Specifies whether the members are managed authoritatively or additively.
+	MembershipMode types.String `tfsdk:"membership_mode"`
 }
 
 // RoleDataSourceModel describes the data source data model.
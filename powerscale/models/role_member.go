@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// RoleMemberResourceModel describes the role member resource data model.
+type RoleMemberResourceModel struct {
+	// Role member ID, in the format <role_id>/<member_id>.
+	ID types.String `tfsdk:"id"`
+	// Specifies which access zone to use.
+	Zone types.String `tfsdk:"zone"`
+	// Specifies the ID of the role.
+	RoleID types.String `tfsdk:"role_id"`
+	// Specifies the serialized form of the member persona, e.g. 'GID:2000'.
+	MemberID types.String `tfsdk:"member_id"`
+	// Specifies the name of the member persona.
+	MemberName types.String `tfsdk:"member_name"`
+	// Specifies the type of the member persona.
+	MemberType types.String `tfsdk:"member_type"`
+}
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SmartPoolsJobResourceModel describes the SmartPools job resource data model.
+type SmartPoolsJobResourceModel struct {
+	// The ID of the started job.
+	ID types.String `tfsdk:"id"`
+	// The job type, SmartPools or SmartPoolsTree.
+	JobType types.String `tfsdk:"job_type"`
+	// Paths to restrict the job to.
+	Paths types.List `tfsdk:"paths"`
+	// Impact policy of the job.
+	Policy types.String `tfsdk:"policy"`
+	// Priority of the job.
+	Priority types.Int64 `tfsdk:"priority"`
+	// Whether a job of the same type may be started while another one is running.
+	AllowDup types.Bool `tfsdk:"allow_dup"`
+	// Whether to wait for the job to finish.
+	Wait types.Bool `tfsdk:"wait"`
+	// Seconds between two polls of the job.
+	PollInterval types.Int64 `tfsdk:"poll_interval"`
+	// Seconds to wait for the job to finish.
+	Timeout types.Int64 `tfsdk:"timeout"`
+	// The numeric ID of the started job.
+	JobID types.Int64 `tfsdk:"job_id"`
+	// The last known state of the job.
+	State types.String `tfsdk:"state"`
+	// The last known progress of the job.
+	Progress types.String `tfsdk:"progress"`
+	// The last known phase of the job.
+	CurrentPhase types.Int64 `tfsdk:"current_phase"`
+	// The number of phases of the job.
+	TotalPhases types.Int64 `tfsdk:"total_phases"`
+	// The time the job started.
+	StartTime types.Int64 `tfsdk:"start_time"`
+	// The time the job ended.
+	EndTime types.Int64 `tfsdk:"end_time"`
+	// Error reported when the job did not succeed.
+	ErrorMessage types.String `tfsdk:"error_message"`
+}
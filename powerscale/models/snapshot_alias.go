@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SnapshotAliasResourceModel describes the snapshot alias resource data model.
+type SnapshotAliasResourceModel struct {
+	// The ID of the snapshot alias.
+	ID types.String `tfsdk:"id"`
+	// The name of the snapshot alias.
+	Name types.String `tfsdk:"name"`
+	// The name or ID of the snapshot the alias points to, HEAD for the live file system.
+	Target types.String `tfsdk:"target"`
+	// The ID of the snapshot the alias points to, -1 for the live file system.
+	TargetID types.Int64 `tfsdk:"target_id"`
+	// The name of the snapshot the alias points to.
+	TargetName types.String `tfsdk:"target_name"`
+}
+
+// SnapshotAliasDataSourceModel describes the snapshot alias datasource data model.
+type SnapshotAliasDataSourceModel struct {
+	ID              types.String               `tfsdk:"id"`
+	SnapshotAliases []SnapshotAliasDetailModel `tfsdk:"snapshot_aliases_details"`
+	Filter          *SnapshotAliasFilterModel  `tfsdk:"filter"`
+}
+
+// SnapshotAliasFilterModel describes the filter data model.
+type SnapshotAliasFilterModel struct {
+	Names []types.String `tfsdk:"names"`
+}
+
+// SnapshotAliasDetailModel describes the snapshot alias details.
+type SnapshotAliasDetailModel struct {
+	// The ID of the snapshot alias.
+	ID types.String `tfsdk:"id"`
+	// The name of the snapshot alias.
+	Name types.String `tfsdk:"name"`
+	// The ID of the snapshot the alias points to, -1 for the live file system.
+	TargetID types.Int64 `tfsdk:"target_id"`
+	// The name of the snapshot the alias points to.
+	TargetName types.String `tfsdk:"target_name"`
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SnapshotChangelistResourceModel describes the snapshot changelist resource data model.
+type SnapshotChangelistResourceModel struct {
+	// The ID of the changelist, in the format <older snapshot ID>_<newer snapshot ID>.
+	ID types.String `tfsdk:"id"`
+	// The name or ID of the older snapshot.
+	OlderSnapshot types.String `tfsdk:"older_snapshot"`
+	// The name or ID of the newer snapshot.
+	NewerSnapshot types.String `tfsdk:"newer_snapshot"`
+	// The ID of the older snapshot.
+	OlderSnapshotID types.Int64 `tfsdk:"older_snapshot_id"`
+	// The ID of the newer snapshot.
+	NewerSnapshotID types.Int64 `tfsdk:"newer_snapshot_id"`
+	// Seconds to wait between two polls of the ChangelistCreate job.
+	PollInterval types.Int64 `tfsdk:"poll_interval"`
+	// Seconds to wait for the ChangelistCreate job to finish.
+	Timeout types.Int64 `tfsdk:"timeout"`
+	// The ID of the ChangelistCreate job.
+	JobID types.Int64 `tfsdk:"job_id"`
+	// The number of entries in the changelist.
+	NumEntries types.Int64 `tfsdk:"num_entries"`
+	// The root path of the changelist.
+	Root types.String `tfsdk:"root"`
+}
+
+// SnapshotChangelistEntryDataSourceModel describes the snapshot changelist entry datasource data model.
+type SnapshotChangelistEntryDataSourceModel struct {
+	ID         types.String                        `tfsdk:"id"`
+	Changelist types.String                        `tfsdk:"changelist"`
+	Limit      types.Int64                         `tfsdk:"limit"`
+	Truncated  types.Bool                          `tfsdk:"truncated"`
+	Entries    []SnapshotChangelistEntryModel      `tfsdk:"changelist_entries"`
+	Filter     *SnapshotChangelistEntryFilterModel `tfsdk:"filter"`
+}
+
+// SnapshotChangelistEntryFilterModel describes the filter data model.
+type SnapshotChangelistEntryFilterModel struct {
+	PathPrefix  types.String   `tfsdk:"path_prefix"`
+	ChangeTypes []types.String `tfsdk:"change_types"`
+}
+
+// SnapshotChangelistEntryModel describes a changelist entry.
+type SnapshotChangelistEntryModel struct {
+	// The LIN of the changed file or directory.
+	Lin types.Int64 `tfsdk:"lin"`
+	// The path of the changed file or directory.
+	Path types.String `tfsdk:"path"`
+	// The types of the change.
+	ChangeTypes []types.String `tfsdk:"change_types"`
+	// The logical size of the file in bytes.
+	Size types.Int64 `tfsdk:"size"`
+}
@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SnapshotLockResourceModel describes the snapshot lock resource data model.
+type SnapshotLockResourceModel struct {
+	// The ID of the lock.
+	ID types.String `tfsdk:"id"`
+	// The name or ID of the locked snapshot.
+	Snapshot types.String `tfsdk:"snapshot"`
+	// The ID of the locked snapshot.
+	SnapshotID types.String `tfsdk:"snapshot_id"`
+	// The comment of the lock.
+	Comment types.String `tfsdk:"comment"`
+	// The unix epoch time the lock expires, 0 if it never expires.
+	Expires types.Int64 `tfsdk:"expires"`
+	// The number of times the lock is held.
+	LockCount types.Int64 `tfsdk:"lock_count"`
+}
+
+// SnapshotLockDataSourceModel describes the snapshot lock datasource data model.
+type SnapshotLockDataSourceModel struct {
+	ID            types.String              `tfsdk:"id"`
+	SnapshotLocks []SnapshotLockDetailModel `tfsdk:"snapshot_locks_details"`
+	Filter        *SnapshotLockFilterModel  `tfsdk:"filter"`
+}
+
+// SnapshotLockFilterModel describes the filter data model.
+type SnapshotLockFilterModel struct {
+	Snapshots []types.String `tfsdk:"snapshots"`
+}
+
+// SnapshotLockDetailModel describes the snapshot lock details.
+type SnapshotLockDetailModel struct {
+	// The ID of the lock.
+	ID types.String `tfsdk:"id"`
+	// The ID of the locked snapshot.
+	SnapshotID types.String `tfsdk:"snapshot_id"`
+	// The name of the locked snapshot.
+	SnapshotName types.String `tfsdk:"snapshot_name"`
+	// The comment of the lock.
+	Comment types.String `tfsdk:"comment"`
+	// The unix epoch time the lock expires, 0 if it never expires.
+	Expires types.Int64 `tfsdk:"expires"`
+	// The number of times the lock is held.
+	LockCount types.Int64 `tfsdk:"lock_count"`
+}
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SnapshotSettingsModel Specifies the global snapshot settings.
+type SnapshotSettingsModel struct {
+	ID types.String `tfsdk:"id"`
+	// True if the scheduled snapshot creation services are on.
+	Autocreate types.Bool `tfsdk:"autocreate"`
+	// True if the scheduled snapshot deletion services are on.
+	Autodelete types.Bool `tfsdk:"autodelete"`
+	// Global switch for the other accessible and visible settings. When true, the other settings apply, and when false, the .snapshot directory is neither visible nor accessible.
+	GlobalVisibleAccessible types.Bool `tfsdk:"global_visible_accessible"`
+	// True if the .snapshot directory is accessible at the root of the local file system.
+	LocalRootAccessible types.Bool `tfsdk:"local_root_accessible"`
+	// True if the .snapshot directory is visible at the root of the local file system.
+	LocalRootVisible types.Bool `tfsdk:"local_root_visible"`
+	// True if the .snapshot directory is accessible in the subdirectories of the local file system.
+	LocalSubdirAccessible types.Bool `tfsdk:"local_subdir_accessible"`
+	// True if the .snapshot directory is accessible at the root of NFS exports.
+	NfsRootAccessible types.Bool `tfsdk:"nfs_root_accessible"`
+	// True if the .snapshot directory is visible at the root of NFS exports.
+	NfsRootVisible types.Bool `tfsdk:"nfs_root_visible"`
+	// True if the .snapshot directory is accessible in the subdirectories of NFS exports.
+	NfsSubdirAccessible types.Bool `tfsdk:"nfs_subdir_accessible"`
+	// Percentage of the space to reserve for snapshots.
+	Reserve types.Int64 `tfsdk:"reserve"`
+	// True if the system allows snapshot creation.
+	Service types.Bool `tfsdk:"service"`
+	// True if the .snapshot directory is accessible at the root of SMB shares.
+	SmbRootAccessible types.Bool `tfsdk:"smb_root_accessible"`
+	// True if the .snapshot directory is visible at the root of SMB shares.
+	SmbRootVisible types.Bool `tfsdk:"smb_root_visible"`
+	// True if the .snapshot directory is accessible in the subdirectories of SMB shares.
+	SmbSubdirAccessible types.Bool `tfsdk:"smb_subdir_accessible"`
+}
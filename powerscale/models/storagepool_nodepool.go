@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// StoragepoolNodepoolResourceModel describes the resource data model.
+type StoragepoolNodepoolResourceModel struct {
+	// The system ID given to the node pool.
+	ID types.Int64 `tfsdk:"id"`
+	// The node pool name.
+	Name types.String `tfsdk:"name"`
+	// The nodes that are part of this node pool.
+	Lnns types.List `tfsdk:"lnns"`
+	// Use SSDs in this node pool for L3 cache.
+	L3 types.Bool `tfsdk:"l3"`
+	// The node pool protection policy.
+	ProtectionPolicy types.String `tfsdk:"protection_policy"`
+	// The name or ID of the node pool's owning tier.
+	Tier types.String `tfsdk:"tier"`
+	// Stop moving files to this pool when this limit is met.
+	TransferLimitPct types.Int64 `tfsdk:"transfer_limit_pct"`
+	// How the transfer limit value is being applied.
+	TransferLimitState types.String `tfsdk:"transfer_limit_state"`
+	// Whether the node pool was manually created.
+	Manual types.Bool `tfsdk:"manual"`
+	// Whether the L3 cache of the node pool is enabled, disabled or in the middle of a transition.
+	L3Status types.String `tfsdk:"l3_status"`
+	// The node type IDs of the node pool.
+	NodeTypeIds types.List `tfsdk:"node_type_ids"`
+	// The health status of the node pool.
+	HealthFlags types.List `tfsdk:"health_flags"`
+	// The SSD usage of the node pool.
+	SsdUsage types.Object `tfsdk:"ssd_usage"`
+}
+
+// StoragepoolNodepoolSsdUsage describes the SSD usage of the node pool.
+type StoragepoolNodepoolSsdUsage struct {
+	// Available SSD bytes.
+	AvailSsdBytes types.String `tfsdk:"avail_ssd_bytes"`
+	// Free SSD bytes.
+	FreeSsdBytes types.String `tfsdk:"free_ssd_bytes"`
+	// Percentage of the usable SSD space used.
+	PctUsedSsd types.String `tfsdk:"pct_used_ssd"`
+	// Total SSD bytes.
+	TotalSsdBytes types.String `tfsdk:"total_ssd_bytes"`
+	// Usable SSD bytes.
+	UsableSsdBytes types.String `tfsdk:"usable_ssd_bytes"`
+	// Used SSD bytes.
+	UsedSsdBytes types.String `tfsdk:"used_ssd_bytes"`
+}
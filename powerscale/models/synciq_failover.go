@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SyncIQFailoverResourceModel describes the SyncIQ failover resource data model.
+type SyncIQFailoverResourceModel struct {
+	// The ID of the target policy on the secondary cluster.
+	ID types.String `tfsdk:"id"`
+	// The name of the SyncIQ policy.
+	Policy types.String `tfsdk:"policy"`
+	// The desired failover state, source_active or failed_over.
+	FailoverState types.String `tfsdk:"failover_state"`
+	// How to return from failed_over to source_active, resync or revert.
+	FailbackMode types.String `tfsdk:"failback_mode"`
+	// Seconds between two polls of a running job.
+	PollInterval types.Int64 `tfsdk:"poll_interval"`
+	// Seconds to wait for a single job to complete.
+	Timeout types.Int64 `tfsdk:"timeout"`
+	// The steps of the interrupted transition that already completed.
+	CompletedSteps types.List `tfsdk:"completed_steps"`
+	// Connection details of the primary cluster.
+	Peer *SyncIQFailoverPeerModel `tfsdk:"peer"`
+}
+
+// SyncIQFailoverPeerModel describes the connection details of the peer cluster.
+type SyncIQFailoverPeerModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Insecure types.Bool   `tfsdk:"insecure"`
+	AuthType types.Int64  `tfsdk:"auth_type"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SyncIQPolicyActionResourceModel describes the SyncIQ policy action resource data model.
+type SyncIQPolicyActionResourceModel struct {
+	// The ID of the policy the action was performed on.
+	ID types.String `tfsdk:"id"`
+	// The name or ID of the policy.
+	Policy types.String `tfsdk:"policy"`
+	// The action to perform, reset or resolve.
+	Action types.String `tfsdk:"action"`
+	// Seconds between two polls of the policy.
+	PollInterval types.Int64 `tfsdk:"poll_interval"`
+	// Seconds to wait for the policy to become runnable.
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
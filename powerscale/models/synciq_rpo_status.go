@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SyncIQRPOStatusDataSourceModel describes the SyncIQ RPO status datasource data model.
+type SyncIQRPOStatusDataSourceModel struct {
+	ID               types.String                `tfsdk:"id"`
+	Policies         []SyncIQRPOStatusModel      `tfsdk:"synciq_rpo_status"`
+	BreachedPolicies []types.String              `tfsdk:"breached_policies"`
+	Filter           *SyncIQRPOStatusFilterModel `tfsdk:"filter"`
+}
+
+// SyncIQRPOStatusFilterModel describes the filter data model.
+type SyncIQRPOStatusFilterModel struct {
+	Names []types.String `tfsdk:"names"`
+}
+
+// SyncIQRPOStatusModel describes the evaluated RPO compliance of a SyncIQ policy.
+type SyncIQRPOStatusModel struct {
+	// The unique identifier of the policy.
+	ID types.String `tfsdk:"id"`
+	// The name of the policy.
+	Name types.String `tfsdk:"name"`
+	// Whether the policy is enabled.
+	Enabled types.Bool `tfsdk:"enabled"`
+	// The schedule of the policy.
+	Schedule types.String `tfsdk:"schedule"`
+	// The RPO alert threshold of the policy in seconds.
+	RpoAlert types.Int64 `tfsdk:"rpo_alert"`
+	// The interval between two scheduled jobs in seconds.
+	ScheduleInterval types.Int64 `tfsdk:"schedule_interval"`
+	// The threshold the time since the last successful job is compared with.
+	RpoThreshold types.Int64 `tfsdk:"rpo_threshold"`
+	// The state of the last job of the policy.
+	LastJobState types.String `tfsdk:"last_job_state"`
+	// The unix epoch time the last successful job ended.
+	LastSuccess types.Int64 `tfsdk:"last_success"`
+	// Seconds since the last successful job ended.
+	SecondsSinceLastSuccess types.Int64 `tfsdk:"seconds_since_last_success"`
+	// The evaluated RPO status.
+	Status types.String `tfsdk:"status"`
+	// Whether the RPO of the policy is breached.
+	Breached types.Bool `tfsdk:"breached"`
+}
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SyncIQTargetPolicyDataSourceModel describes the SyncIQ target policy datasource data model.
+type SyncIQTargetPolicyDataSourceModel struct {
+	ID             types.String                   `tfsdk:"id"`
+	TargetPolicies []SyncIQTargetPolicyModel      `tfsdk:"synciq_target_policies_details"`
+	Filter         *SyncIQTargetPolicyFilterModel `tfsdk:"filter"`
+}
+
+// SyncIQTargetPolicyFilterModel describes the filter data model.
+type SyncIQTargetPolicyFilterModel struct {
+	Names              []types.String `tfsdk:"names"`
+	SourceClusterNames []types.String `tfsdk:"source_cluster_names"`
+}
+
+// SyncIQTargetPolicyModel describes a SyncIQ policy targeting this cluster.
+type SyncIQTargetPolicyModel struct {
+	// The unique identifier of the policy.
+	ID types.String `tfsdk:"id"`
+	// The name of the policy.
+	Name types.String `tfsdk:"name"`
+	// The GUID of the source cluster.
+	SourceClusterGUID types.String `tfsdk:"source_cluster_guid"`
+	// The name of the source cluster.
+	SourceClusterName types.String `tfsdk:"source_cluster_name"`
+	// The host of the source cluster.
+	SourceHost types.String `tfsdk:"source_host"`
+	// The IP of the last source coordinator.
+	LastSourceCoordinatorIP types.String `tfsdk:"last_source_coordinator_ip"`
+	// The target directory of the policy.
+	TargetPath types.String `tfsdk:"target_path"`
+	// The state of the last job of the policy.
+	LastJobState types.String `tfsdk:"last_job_state"`
+	// The failover-failback state of the target directory.
+	FailoverFailbackState types.String `tfsdk:"failover_failback_state"`
+	// The time of the last update from the source cluster.
+	LastUpdateFromSource types.Int64 `tfsdk:"last_update_from_source"`
+	// Whether the policy is a legacy policy.
+	LegacyPolicy types.Bool `tfsdk:"legacy_policy"`
+}
+
+// SyncIQTargetPolicyActionResourceModel describes the SyncIQ target policy action resource data model.
+type SyncIQTargetPolicyActionResourceModel struct {
+	// Placeholder ID.
+	ID types.String `tfsdk:"id"`
+	// The name or ID of the target policy.
+	Policy types.String `tfsdk:"policy"`
+	// The action to perform, break_association or cancel.
+	Action types.String `tfsdk:"action"`
+	// Break the association even if the source cluster cannot be contacted.
+	Force types.Bool `tfsdk:"force"`
+}
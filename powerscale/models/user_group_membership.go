@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// UserGroupMembershipResourceModel describes the user group membership resource data model.
+type UserGroupMembershipResourceModel struct {
+	// User Group Membership ID.
+	ID types.String `tfsdk:"id"`
+	// Specifies the name of the existing user group.
+	Group types.String `tfsdk:"group"`
+	// Specifies the zone that the user group belongs to.
+	Zone types.String `tfsdk:"zone"`
+	// Specifies the user members managed by this resource.
+	Users types.Set `tfsdk:"users"`
+	// Specifies the group members managed by this resource.
+	Groups types.Set `tfsdk:"groups"`
+	// Specifies the well-known members managed by this resource.
+	WellKnowns types.Set `tfsdk:"well_knowns"`
+}
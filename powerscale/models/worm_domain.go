@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// WormDomainResourceModel describes the resource data model.
+type WormDomainResourceModel struct {
+	// The unique identifier of the WORM domain.
+	ID types.String `tfsdk:"id"`
+	// The root path of the WORM domain.
+	Path types.String `tfsdk:"path"`
+	// The type of the WORM domain, enterprise or compliance.
+	Type types.String `tfsdk:"type"`
+	// The default retention period of files committed in the domain, e.g. 1Y6M.
+	DefaultRetention types.String `tfsdk:"default_retention"`
+	// The minimum retention period of files committed in the domain.
+	MinRetention types.String `tfsdk:"min_retention"`
+	// The maximum retention period of files committed in the domain.
+	MaxRetention types.String `tfsdk:"max_retention"`
+	// The autocommit time period in seconds.
+	AutocommitOffset types.Int64 `tfsdk:"autocommit_offset"`
+	// The retention date override as a unix epoch timestamp.
+	OverrideDate types.Int64 `tfsdk:"override_date"`
+	// The privileged delete setting, on, off or disabled.
+	PrivilegedDelete types.String `tfsdk:"privileged_delete"`
+	// The LIN of the root directory of the domain.
+	Lin types.Int64 `tfsdk:"lin"`
+	// Whether the WORM domain is incomplete.
+	Incomplete types.Bool `tfsdk:"incomplete"`
+}
+
+// WormDomainDataSourceModel describes the data source data model.
+type WormDomainDataSourceModel struct {
+	ID          types.String              `tfsdk:"id"`
+	WormDomains []WormDomainResourceModel `tfsdk:"worm_domains_details"`
+
+	// Filters
+	WormDomainFilter *WormDomainFilterType `tfsdk:"filter"`
+}
+
+// WormDomainFilterType describes the filter data model.
+type WormDomainFilterType struct {
+	Paths []types.String `tfsdk:"paths"`
+	Types []types.String `tfsdk:"types"`
+}
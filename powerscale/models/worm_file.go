@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// WormFileResourceModel describes the resource data model.
+type WormFileResourceModel struct {
+	// Placeholder ID.
+	ID types.String `tfsdk:"id"`
+	// Paths of the files to commit to WORM.
+	Paths types.Set `tfsdk:"paths"`
+	// Whether the files are committed to WORM.
+	Commit types.Bool `tfsdk:"commit"`
+	// The retention date of the files in RFC3339 format.
+	RetentionDate types.String `tfsdk:"retention_date"`
+	// Current WORM state of the files.
+	Files types.List `tfsdk:"files"`
+}
+
+// WormFileDetailModel describes the current WORM state of a file.
+type WormFileDetailModel struct {
+	// Path of the file.
+	Path types.String `tfsdk:"path"`
+	// Whether the file is committed to WORM.
+	Committed types.Bool `tfsdk:"committed"`
+	// The retention date of the file in RFC3339 format.
+	RetentionDate types.String `tfsdk:"retention_date"`
+	// ID of the WORM domain the file belongs to.
+	DomainID types.Int64 `tfsdk:"domain_id"`
+	// Root path of the WORM domain the file belongs to.
+	DomainPath types.String `tfsdk:"domain_path"`
+}
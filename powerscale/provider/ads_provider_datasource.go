@@ -376,13 +376,12 @@ func (d *AdsProviderDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	adsProviderParams := d.client.PscaleOpenAPIClient.AuthApi.ListAuthv14ProvidersAds(ctx)
-
-	if state.AdsProviderFilter != nil && !state.AdsProviderFilter.Scope.IsNull() {
-		adsProviderParams = adsProviderParams.Scope(state.AdsProviderFilter.Scope.ValueString())
+	scope := ""
+	if state.AdsProviderFilter != nil {
+		scope = state.AdsProviderFilter.Scope.ValueString()
 	}
 
-	result, _, err := adsProviderParams.Execute()
+	result, err := helper.GetAllAdsProviders(ctx, d.client, scope)
 
 	if err != nil {
 		errStr := constants.ReadAdsProviderErrorMsg + "with error: "
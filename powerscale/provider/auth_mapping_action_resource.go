@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource = &AuthMappingActionResource{}
+)
+
+// NewAuthMappingActionResource returns the auth mapping action resource object.
+func NewAuthMappingActionResource() resource.Resource {
+	return &AuthMappingActionResource{}
+}
+
+// AuthMappingActionResource defines the resource implementation.
+type AuthMappingActionResource struct {
+	client *client.Client
+}
+
+// Configure configures the resource.
+func (r *AuthMappingActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the resource arguments.
+func (r *AuthMappingActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_mapping_action"
+}
+
+// Schema defines the schema for the resource.
+func (r *AuthMappingActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to import identity mappings from a file on PowerScale Array, or to flush the identity mappings of an access zone. " +
+			"The action is performed on Create and Update. Delete only removes the resource from the Terraform state.",
+		Description: "This resource is used to import identity mappings from a file on PowerScale Array, or to flush the identity mappings of an access zone. " +
+			"The action is performed on Create and Update. Delete only removes the resource from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Placeholder ID",
+				MarkdownDescription: "Placeholder ID",
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				Description:         "The zone on which the action is performed. Defaults to System.",
+				MarkdownDescription: "The zone on which the action is performed. Defaults to System.",
+				Optional:            true,
+			},
+			"action": schema.StringAttribute{
+				Description:         "Specifies the action to perform. Acceptable values: import, flush.",
+				MarkdownDescription: "Specifies the action to perform. Acceptable values: import, flush.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("import", "flush"),
+				},
+			},
+			"file": schema.StringAttribute{
+				Description:         "Specifies the absolute path of the file on the cluster to import the identity mappings from. Required when action is import.",
+				MarkdownDescription: "Specifies the absolute path of the file on the cluster to import the identity mappings from. Required when action is import.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("filter"), path.MatchRoot("remove")),
+				},
+			},
+			"replace": schema.BoolAttribute{
+				Description:         "If true, existing identity mappings are replaced by the imported ones. Only applicable when action is import.",
+				MarkdownDescription: "If true, existing identity mappings are replaced by the imported ones. Only applicable when action is import.",
+				Optional:            true,
+			},
+			"filter": schema.StringAttribute{
+				Description:         "Only flush the identity mappings matching this filter. Only applicable when action is flush.",
+				MarkdownDescription: "Only flush the identity mappings matching this filter. Only applicable when action is flush.",
+				Optional:            true,
+			},
+			"remove": schema.BoolAttribute{
+				Description:         "If true, the identity mappings are removed, including manual mappings. Otherwise only the cached mappings are flushed. Only applicable when action is flush.",
+				MarkdownDescription: "If true, the identity mappings are removed, including manual mappings. Otherwise only the cached mappings are flushed. Only applicable when action is flush.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Create performs the action and sets the initial Terraform state.
+func (r *AuthMappingActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating auth mapping action resource state")
+	var plan models.AuthMappingActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageAuthMappingAction(ctx, r.client, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating auth mapping action resource state")
+}
+
+// Read refreshes the Terraform state with the latest value.
+func (r *AuthMappingActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading auth mapping action resource state")
+	var state models.AuthMappingActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading auth mapping action resource state")
+}
+
+// Update performs the action again and sets the updated Terraform state.
+func (r *AuthMappingActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating auth mapping action resource state")
+	var plan models.AuthMappingActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageAuthMappingAction(ctx, r.client, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating auth mapping action resource state")
+}
+
+// Delete deletes the resource.
+func (r *AuthMappingActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting auth mapping action resource state")
+	var state models.AuthMappingActionResourceModel
+
+	// Read Terraform prior state data into the model
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting auth mapping action resource state")
+}
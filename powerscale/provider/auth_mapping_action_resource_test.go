@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuthMappingActionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + authMappingFlushConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_action.test", "id", "auth_mapping_action"),
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_action.test", "action", "flush"),
+				),
+			},
+			{
+				Config: ProviderConfig + authMappingFlushUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_action.test", "remove", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingActionResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + authMappingImportMissingFileConfig,
+				ExpectError: regexp.MustCompile(`.*file is required*.`),
+			},
+			{
+				Config:      ProviderConfig + authMappingInvalidActionConfig,
+				ExpectError: regexp.MustCompile(`.*value must be one of*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ImportAuthMappings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + authMappingImportConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.FlushAuthMappings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + authMappingFlushConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var authMappingFlushConfig = `
+resource "powerscale_auth_mapping_action" "test" {
+	action = "flush"
+}
+`
+
+var authMappingFlushUpdateConfig = `
+resource "powerscale_auth_mapping_action" "test" {
+	action = "flush"
+	remove = true
+}
+`
+
+var authMappingImportConfig = `
+resource "powerscale_auth_mapping_action" "test" {
+	action  = "import"
+	file    = "/ifs/data/idmap.txt"
+	replace = true
+}
+`
+
+var authMappingImportMissingFileConfig = `
+resource "powerscale_auth_mapping_action" "test" {
+	action = "import"
+}
+`
+
+var authMappingInvalidActionConfig = `
+resource "powerscale_auth_mapping_action" "test" {
+	action = "invalid"
+}
+`
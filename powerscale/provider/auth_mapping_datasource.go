@@ -0,0 +1,206 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuthMappingDataSource{}
+
+// NewAuthMappingDataSource creates a new data source.
+func NewAuthMappingDataSource() datasource.DataSource {
+	return &AuthMappingDataSource{}
+}
+
+// AuthMappingDataSource defines the data source implementation.
+type AuthMappingDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *AuthMappingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_mapping"
+}
+
+// Schema describes the data source arguments.
+func (d *AuthMappingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to look up the identity mappings (ID map) of source personas from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to look up the identity mappings (ID map) of source personas from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Auth Mapping datasource.",
+				MarkdownDescription: "Identifier of the Auth Mapping datasource.",
+				Computed:            true,
+			},
+			"auth_mappings_details": schema.ListNestedAttribute{
+				Description:         "List of identity mappings of the source personas.",
+				MarkdownDescription: "List of identity mappings of the source personas.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description:         "Specifies the source persona ID.",
+							MarkdownDescription: "Specifies the source persona ID.",
+							Computed:            true,
+						},
+						"source_name": schema.StringAttribute{
+							Description:         "Specifies the source persona name.",
+							MarkdownDescription: "Specifies the source persona name.",
+							Computed:            true,
+						},
+						"source_type": schema.StringAttribute{
+							Description:         "Specifies the source persona type.",
+							MarkdownDescription: "Specifies the source persona type.",
+							Computed:            true,
+						},
+						"targets": schema.ListNestedAttribute{
+							Description:         "Specifies the target identities mapped to the source persona.",
+							MarkdownDescription: "Specifies the target identities mapped to the source persona.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description:         "Specifies the target persona ID.",
+										MarkdownDescription: "Specifies the target persona ID.",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										Description:         "Specifies the target persona name.",
+										MarkdownDescription: "Specifies the target persona name.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										Description:         "Specifies the target persona type.",
+										MarkdownDescription: "Specifies the target persona type.",
+										Computed:            true,
+									},
+									"on_disk": schema.BoolAttribute{
+										Description:         "Whether the target identity is used as the on-disk identity.",
+										MarkdownDescription: "Whether the target identity is used as the on-disk identity.",
+										Computed:            true,
+									},
+									"mapping_type": schema.StringAttribute{
+										Description:         "Specifies how the mapping was created, e.g. auto, external or manual.",
+										MarkdownDescription: "Specifies how the mapping was created, e.g. auto, external or manual.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"zone": schema.StringAttribute{
+						Description:         "The zone to look up the identity mappings from. Defaults to System.",
+						MarkdownDescription: "The zone to look up the identity mappings from. Defaults to System.",
+						Optional:            true,
+					},
+					"sources": schema.SetAttribute{
+						Description:         "Source persona IDs to look up, e.g. SID:S-1-5-21-1-2-3-1000, UID:1000 or GID:1000.",
+						MarkdownDescription: "Source persona IDs to look up, e.g. SID:S-1-5-21-1-2-3-1000, UID:1000 or GID:1000.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *AuthMappingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *AuthMappingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading auth mapping data source")
+
+	var state models.AuthMappingDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.AuthMappingFilter == nil || len(state.AuthMappingFilter.Sources) == 0 {
+		resp.Diagnostics.AddError(
+			"Error reading auth mapping data source",
+			"At least one source persona should be provided in filter.sources to look up the identity mappings.",
+		)
+		return
+	}
+
+	zone := state.AuthMappingFilter.Zone.ValueString()
+	authMappings := []models.AuthMappingDetailModel{}
+	for _, source := range state.AuthMappingFilter.Sources {
+		identities, err := helper.GetAuthMappingIdentity(ctx, d.client, source.ValueString(), zone)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting the identity mappings",
+				err.Error(),
+			)
+			return
+		}
+		for _, identity := range identities.Identities {
+			authMappings = append(authMappings, helper.AuthMappingDetailMapper(identity))
+		}
+	}
+
+	state.AuthMappings = authMappings
+	state.ID = types.StringValue("auth_mapping_datasource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading auth mapping data source ")
+}
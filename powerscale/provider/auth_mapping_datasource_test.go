@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuthMappingDataSource(t *testing.T) {
+	var authMappingTerraformName = "data.powerscale_auth_mapping.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by sources
+			{
+				Config: ProviderConfig + AuthMappingDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(authMappingTerraformName, "id", "auth_mapping_datasource"),
+					resource.TestCheckResourceAttrSet(authMappingTerraformName, "auth_mappings_details.#"),
+					resource.TestCheckResourceAttr(authMappingTerraformName, "auth_mappings_details.0.source", "UID:10001"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingDataSourceNoSourcesErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + AuthMappingDataSourceNoSourcesConfig,
+				ExpectError: regexp.MustCompile(`.*At least one source persona*.`),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAuthMappingIdentity).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthMappingDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var AuthMappingDataSourceConfig = AuthMappingResourceConfig + `
+data "powerscale_auth_mapping" "test" {
+	filter {
+		sources = ["UID:10001"]
+	}
+	depends_on = [
+		powerscale_auth_mapping.test
+	]
+}
+`
+
+var AuthMappingDataSourceNoSourcesConfig = `
+data "powerscale_auth_mapping" "test" {
+	filter {
+		zone = "System"
+	}
+}
+`
@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuthMappingResource{}
+var _ resource.ResourceWithConfigure = &AuthMappingResource{}
+
+// NewAuthMappingResource creates a new resource.
+func NewAuthMappingResource() resource.Resource {
+	return &AuthMappingResource{}
+}
+
+// AuthMappingResource defines the resource implementation.
+type AuthMappingResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *AuthMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_mapping"
+}
+
+// Schema describes the resource arguments.
+func (r *AuthMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	personaValidator := stringvalidator.RegexMatches(regexp.MustCompile(`^(UID|GID|SID):\S+$`), "must be a persona ID in the format UID:<uid>, GID:<gid> or SID:<sid>")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the explicit identity mapping (ID map) entries of PowerScale Array. " +
+			"Each entry maps a source persona (SID, UID or GID) to a target persona in an access zone. " +
+			"We can Create, Update and Delete the identity mappings using this resource. " +
+			"Note that, only the mappings listed in this resource are managed, other mappings of the access zone are left untouched. " +
+			"Creating a mapping for a source persona which is already mapped on PowerScale fails instead of replacing the existing mapping.",
+		Description: "This resource is used to manage the explicit identity mapping (ID map) entries of PowerScale Array. " +
+			"Each entry maps a source persona (SID, UID or GID) to a target persona in an access zone. " +
+			"We can Create, Update and Delete the identity mappings using this resource. " +
+			"Note that, only the mappings listed in this resource are managed, other mappings of the access zone are left untouched. " +
+			"Creating a mapping for a source persona which is already mapped on PowerScale fails instead of replacing the existing mapping.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Auth Mapping ID.",
+				MarkdownDescription: "Auth Mapping ID.",
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				Description:         "The zone to which the identity mappings apply. Defaults to System. Cannot be updated.",
+				MarkdownDescription: "The zone to which the identity mappings apply. Defaults to System. Cannot be updated.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mappings": schema.SetNestedAttribute{
+				Description:         "Specifies the set of source to target identity mappings.",
+				MarkdownDescription: "Specifies the set of source to target identity mappings.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description:         "Specifies the source persona ID, e.g. SID:S-1-5-21-1-2-3-1000, UID:1000 or GID:1000.",
+							MarkdownDescription: "Specifies the source persona ID, e.g. SID:S-1-5-21-1-2-3-1000, UID:1000 or GID:1000.",
+							Required:            true,
+							Validators:          []validator.String{personaValidator},
+						},
+						"target": schema.StringAttribute{
+							Description:         "Specifies the target persona ID, e.g. SID:S-1-5-21-1-2-3-1000, UID:1000 or GID:1000.",
+							MarkdownDescription: "Specifies the target persona ID, e.g. SID:S-1-5-21-1-2-3-1000, UID:1000 or GID:1000.",
+							Required:            true,
+							Validators:          []validator.String{personaValidator},
+						},
+						"two_way": schema.BoolAttribute{
+							Description:         "If true, the mapping is created in both directions, from source to target and from target to source.",
+							MarkdownDescription: "If true, the mapping is created in both directions, from source to target and from target to source.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"on_disk": schema.BoolAttribute{
+							Description:         "If true, the target identity is used as the on-disk identity of the source.",
+							MarkdownDescription: "If true, the target identity is used as the on-disk identity of the source.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *AuthMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *AuthMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Auth Mapping resource state")
+	// Read Terraform plan into the model
+	var plan models.AuthMappingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := models.AuthMappingResourceModel{
+		Zone:     plan.Zone,
+		Mappings: types.SetNull(types.ObjectType{AttrTypes: helper.AuthMappingItemType()}),
+	}
+	if diags := helper.UpdateAuthMappings(ctx, r.client, &state, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if diags := helper.UpdateAuthMappingResourceState(ctx, r.client, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create Auth Mapping resource state")
+}
+
+// Read reads the resource state.
+func (r *AuthMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading Auth Mapping resource state")
+
+	var state models.AuthMappingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if diags := helper.UpdateAuthMappingResourceState(ctx, r.client, &state); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read Auth Mapping resource state")
+}
+
+// Update updates the resource state.
+func (r *AuthMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Auth Mapping resource state")
+
+	var plan models.AuthMappingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.AuthMappingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if diags := helper.UpdateAuthMappings(ctx, r.client, &state, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if diags := helper.UpdateAuthMappingResourceState(ctx, r.client, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update Auth Mapping resource state")
+}
+
+// Delete deletes the resource.
+func (r *AuthMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Auth Mapping resource state")
+	var state models.AuthMappingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan := models.AuthMappingResourceModel{
+		Zone:     state.Zone,
+		Mappings: types.SetNull(types.ObjectType{AttrTypes: helper.AuthMappingItemType()}),
+	}
+	if diags := helper.UpdateAuthMappings(ctx, r.client, &state, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete Auth Mapping resource state")
+}
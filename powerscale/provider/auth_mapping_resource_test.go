@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuthMappingResource(t *testing.T) {
+	var authMappingResourceName = "powerscale_auth_mapping.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuthMappingResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(authMappingResourceName, "id", "System"),
+					resource.TestCheckResourceAttr(authMappingResourceName, "mappings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(authMappingResourceName, "mappings.*", map[string]string{
+						"source":  "UID:10001",
+						"target":  "GID:10001",
+						"two_way": "false",
+						"on_disk": "false",
+					}),
+				),
+			},
+			// Update testing
+			{
+				Config: ProviderConfig + AuthMappingUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(authMappingResourceName, "mappings.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(authMappingResourceName, "mappings.*", map[string]string{
+						"source": "UID:10001",
+						"target": "GID:10002",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(authMappingResourceName, "mappings.*", map[string]string{
+						"source":  "UID:10002",
+						"target":  "GID:10001",
+						"on_disk": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingResourceInvalidPersona(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + AuthMappingInvalidPersonaResourceConfig,
+				ExpectError: regexp.MustCompile(`.*must be a persona ID*.`),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingResourceExistingMapping(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + AuthMappingExistingMappingResourceConfig,
+				ExpectError: regexp.MustCompile(`.*Error adding identity mapping*.`),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingResourceCreateErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = Mock(helper.CreateAuthMapping).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthMappingResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetAuthMappingIdentity).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthMappingResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingResourceUpdateErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + AuthMappingResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = Mock(helper.DeleteAuthMapping).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthMappingUpdateResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.CreateAuthMapping).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthMappingUpdateResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + AuthMappingUpdateResourceConfig,
+			},
+		},
+	})
+}
+
+var AuthMappingResourceConfig = `
+resource "powerscale_auth_mapping" "test" {
+	mappings = [
+		{
+			source = "UID:10001"
+			target = "GID:10001"
+		}
+	]
+}
+`
+
+var AuthMappingUpdateResourceConfig = `
+resource "powerscale_auth_mapping" "test" {
+	mappings = [
+		{
+			source = "UID:10001"
+			target = "GID:10002"
+		},
+		{
+			source  = "UID:10002"
+			target  = "GID:10001"
+			on_disk = true
+		}
+	]
+}
+`
+
+var AuthMappingInvalidPersonaResourceConfig = `
+resource "powerscale_auth_mapping" "test" {
+	mappings = [
+		{
+			source = "10001"
+			target = "GID:10001"
+		}
+	]
+}
+`
+
+var AuthMappingExistingMappingResourceConfig = AuthMappingResourceConfig + `
+resource "powerscale_auth_mapping" "existing" {
+	mappings = [
+		{
+			source = "UID:10001"
+			target = "GID:10002"
+		}
+	]
+	depends_on = [powerscale_auth_mapping.test]
+}
+`
@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuthProviderStatusDataSource{}
+
+// NewAuthProviderStatusDataSource creates a new data source.
+func NewAuthProviderStatusDataSource() datasource.DataSource {
+	return &AuthProviderStatusDataSource{}
+}
+
+// AuthProviderStatusDataSource defines the data source implementation.
+type AuthProviderStatusDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *AuthProviderStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_provider_status"
+}
+
+// Schema describes the data source arguments.
+func (d *AuthProviderStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the status of the authentication providers (ADS, LDAP, NIS, file and local) from PowerScale array. " +
+			"The information fetched from this datasource can be used in check blocks to validate that the providers are online after apply.",
+		Description: "This datasource is used to query the status of the authentication providers (ADS, LDAP, NIS, file and local) from PowerScale array. " +
+			"The information fetched from this datasource can be used in check blocks to validate that the providers are online after apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Auth Provider Status datasource.",
+				MarkdownDescription: "Identifier of the Auth Provider Status datasource.",
+				Computed:            true,
+			},
+			"auth_providers_status": schema.ListNestedAttribute{
+				Description:         "List of authentication providers with their status.",
+				MarkdownDescription: "List of authentication providers with their status.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "Specifies the provider ID as referenced by access zones, e.g. lsa-activedirectory-provider:DOMAIN.",
+							MarkdownDescription: "Specifies the provider ID as referenced by access zones, e.g. lsa-activedirectory-provider:DOMAIN.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "Specifies the name of the provider.",
+							MarkdownDescription: "Specifies the name of the provider.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Specifies the type of the provider, one of ads, ldap, nis, file or local.",
+							MarkdownDescription: "Specifies the type of the provider, one of ads, ldap, nis, file or local.",
+							Computed:            true,
+						},
+						"zone_name": schema.StringAttribute{
+							Description:         "Specifies the name of the access zone in which the provider was created.",
+							MarkdownDescription: "Specifies the name of the access zone in which the provider was created.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							Description:         "Specifies the status of the provider as reported by PowerScale.",
+							MarkdownDescription: "Specifies the status of the provider as reported by PowerScale.",
+							Computed:            true,
+						},
+						"online": schema.BoolAttribute{
+							Description:         "Whether the provider is online.",
+							MarkdownDescription: "Whether the provider is online.",
+							Computed:            true,
+						},
+						"servers": schema.ListAttribute{
+							Description:         "Specifies the domain controllers the ADS provider is connected to, or the servers of the LDAP and NIS providers.",
+							MarkdownDescription: "Specifies the domain controllers the ADS provider is connected to, or the servers of the LDAP and NIS providers.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"last_error": schema.StringAttribute{
+							Description:         "Specifies the last error encountered while checking the provider.",
+							MarkdownDescription: "Specifies the last error encountered while checking the provider.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"zone": schema.StringAttribute{
+						Description:         "Only list the providers used by this access zone.",
+						MarkdownDescription: "Only list the providers used by this access zone.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"names": schema.SetAttribute{
+						Description:         "Only list the providers with these names.",
+						MarkdownDescription: "Only list the providers with these names.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"types": schema.SetAttribute{
+						Description:         "Only list the providers of these types. Acceptable values: ads, ldap, nis, file, local.",
+						MarkdownDescription: "Only list the providers of these types. Acceptable values: ads, ldap, nis, file, local.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf("ads", "ldap", "nis", "file", "local")),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *AuthProviderStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *AuthProviderStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading auth provider status data source")
+
+	var state models.AuthProviderStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names, providerTypes, zoneProviders []string
+	if state.AuthProviderStatusFilter != nil {
+		for _, name := range state.AuthProviderStatusFilter.Names {
+			names = append(names, name.ValueString())
+		}
+		for _, providerType := range state.AuthProviderStatusFilter.Types {
+			providerTypes = append(providerTypes, providerType.ValueString())
+		}
+		if zone := state.AuthProviderStatusFilter.Zone.ValueString(); zone != "" {
+			var err error
+			if zoneProviders, err = helper.GetAccessZoneAuthProviders(ctx, d.client, zone); err != nil {
+				resp.Diagnostics.AddError("Error getting the auth providers of the access zone", err.Error())
+				return
+			}
+		}
+	}
+
+	providers, err := helper.GetAuthProvidersStatus(ctx, d.client, providerTypes)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the status of auth providers", err.Error())
+		return
+	}
+
+	state.AuthProviders = helper.FilterAuthProvidersStatus(providers, names, zoneProviders)
+	state.ID = types.StringValue("auth_provider_status_datasource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading auth provider status data source ")
+}
@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuthProviderStatusDataSource(t *testing.T) {
+	var statusTerraformName = "data.powerscale_auth_provider_status.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// List the status of all providers
+			{
+				Config: ProviderConfig + AuthProviderStatusDataSourceAllConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(statusTerraformName, "id", "auth_provider_status_datasource"),
+					resource.TestCheckTypeSetElemNestedAttrs(statusTerraformName, "auth_providers_status.*", map[string]string{
+						"id":     "lsa-local-provider:System",
+						"type":   "local",
+						"online": "true",
+					}),
+				),
+			},
+			// Filter by zone and types
+			{
+				Config: ProviderConfig + AuthProviderStatusDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(statusTerraformName, "auth_providers_status.#", "1"),
+					resource.TestCheckResourceAttr(statusTerraformName, "auth_providers_status.0.id", "lsa-file-provider:System"),
+					resource.TestCheckResourceAttr(statusTerraformName, "auth_providers_status.0.online", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthProviderStatusDataSourceInvalidZoneErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + AuthProviderStatusDataSourceInvalidZoneConfig,
+				ExpectError: regexp.MustCompile(`.*access zone invalid_zone not found*.`),
+			},
+		},
+	})
+}
+
+func TestAccAuthProviderStatusDataSourceInvalidTypeErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + AuthProviderStatusDataSourceInvalidTypeConfig,
+				ExpectError: regexp.MustCompile(`.*Attribute filter.types*.`),
+			},
+		},
+	})
+}
+
+func TestAccAuthProviderStatusDataSourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAllLdapProvidersWithFilter).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthProviderStatusDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetAllAccessZones).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthProviderStatusDataSourceFilterConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var AuthProviderStatusDataSourceAllConfig = `
+data "powerscale_auth_provider_status" "test" {
+}
+`
+
+var AuthProviderStatusDataSourceFilterConfig = `
+data "powerscale_auth_provider_status" "test" {
+	filter {
+		zone = "System"
+		types = ["file"]
+	}
+}
+`
+
+var AuthProviderStatusDataSourceInvalidZoneConfig = `
+data "powerscale_auth_provider_status" "test" {
+	filter {
+		zone = "invalid_zone"
+	}
+}
+`
+
+var AuthProviderStatusDataSourceInvalidTypeConfig = `
+data "powerscale_auth_provider_status" "test" {
+	filter {
+		types = ["invalid"]
+	}
+}
+`
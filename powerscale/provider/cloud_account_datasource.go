@@ -0,0 +1,185 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudAccountDataSource{}
+
+// NewCloudAccountDataSource creates a new data source.
+func NewCloudAccountDataSource() datasource.DataSource {
+	return &CloudAccountDataSource{}
+}
+
+// CloudAccountDataSource defines the data source implementation.
+type CloudAccountDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *CloudAccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_account"
+}
+
+// Schema describes the data source arguments.
+func (d *CloudAccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the existing CloudPools cloud storage accounts from PowerScale array. The credentials of the accounts are never returned. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing CloudPools cloud storage accounts from PowerScale array. The credentials of the accounts are never returned. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Cloud Account datasource.",
+				MarkdownDescription: "Identifier of the Cloud Account datasource.",
+				Computed:            true,
+			},
+			"cloud_accounts_details": schema.ListNestedAttribute{
+				Description:         "List of cloud accounts.",
+				MarkdownDescription: "List of cloud accounts.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "The ID of the cloud account.",
+							MarkdownDescription: "The ID of the cloud account.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "The name of the cloud account.",
+							MarkdownDescription: "The name of the cloud account.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "The type of the cloud storage.",
+							MarkdownDescription: "The type of the cloud storage.",
+							Computed:            true,
+						},
+						"uri": schema.StringAttribute{
+							Description:         "The URI of the cloud storage.",
+							MarkdownDescription: "The URI of the cloud storage.",
+							Computed:            true,
+						},
+						"account_username": schema.StringAttribute{
+							Description:         "The username of the cloud storage account.",
+							MarkdownDescription: "The username of the cloud storage account.",
+							Computed:            true,
+						},
+						"account_id": schema.StringAttribute{
+							Description:         "The account ID of the cloud storage.",
+							MarkdownDescription: "The account ID of the cloud storage.",
+							Computed:            true,
+						},
+						"storage_region": schema.StringAttribute{
+							Description:         "The region of the cloud storage.",
+							MarkdownDescription: "The region of the cloud storage.",
+							Computed:            true,
+						},
+						"proxy": schema.StringAttribute{
+							Description:         "The name of the network proxy used to reach the cloud storage.",
+							MarkdownDescription: "The name of the network proxy used to reach the cloud storage.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description:         "Whether the cloud account is enabled.",
+							MarkdownDescription: "Whether the cloud account is enabled.",
+							Computed:            true,
+						},
+						"skip_ssl_validation": schema.BoolAttribute{
+							Description:         "Whether the validation of the SSL certificate of the cloud storage is skipped.",
+							MarkdownDescription: "Whether the validation of the SSL certificate of the cloud storage is skipped.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							Description:         "The state of the cloud account.",
+							MarkdownDescription: "The state of the cloud account.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Description:         "Only list the cloud accounts with these names.",
+						MarkdownDescription: "Only list the cloud accounts with these names.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *CloudAccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *CloudAccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading cloud account data source")
+
+	var state models.CloudAccountDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.ManageDataSourceCloudAccount(ctx, d.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading cloud account data source ")
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudAccountDataSource(t *testing.T) {
+	var cloudAccountTerraformName = "data.powerscale_cloud_account.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read all the cloud accounts
+			{
+				Config: ProviderConfig + CloudAccountAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cloudAccountTerraformName, "id", "cloud_account_datasource"),
+					resource.TestCheckResourceAttrSet(cloudAccountTerraformName, "cloud_accounts_details.#"),
+				),
+			},
+			// Filter by name
+			{
+				Config: ProviderConfig + CloudAccountFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cloudAccountTerraformName, "cloud_accounts_details.#", "1"),
+					resource.TestCheckResourceAttr(cloudAccountTerraformName, "cloud_accounts_details.0.name", "tfacc_cloud_account"),
+					resource.TestCheckResourceAttr(cloudAccountTerraformName, "cloud_accounts_details.0.type", "ecs"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudAccountDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListCloudAccounts).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + CloudAccountAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var CloudAccountAllDataSourceConfig = `
+data "powerscale_cloud_account" "test" {
+}
+`
+
+// CloudAccountFilterDataSourceConfig is prefixed with its resource config in initCloudAccountConfig.
+var CloudAccountFilterDataSourceConfig = `
+data "powerscale_cloud_account" "test" {
+	filter {
+		names = [powerscale_cloud_account.account_test.name]
+	}
+}
+`
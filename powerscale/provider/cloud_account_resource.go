@@ -0,0 +1,351 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CloudAccountResource{}
+var _ resource.ResourceWithConfigure = &CloudAccountResource{}
+var _ resource.ResourceWithImportState = &CloudAccountResource{}
+
+// cloudStorageTypes are the types of cloud storage supported by CloudPools.
+var cloudStorageTypes = []string{"ecs", "s3", "azure", "google", "alibaba", "isilon"}
+
+// NewCloudAccountResource creates a new resource.
+func NewCloudAccountResource() resource.Resource {
+	return &CloudAccountResource{}
+}
+
+// CloudAccountResource defines the resource implementation.
+type CloudAccountResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *CloudAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_account"
+}
+
+// Schema describes the resource arguments.
+func (r *CloudAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the CloudPools cloud storage accounts of PowerScale Array. " +
+			"A cloud account holds the connection details and the credentials of a cloud storage, and is used by a cloud pool. " +
+			"We can Create, Update and Delete the cloud accounts using this resource. We can also import an existing cloud account from PowerScale array. " +
+			"The key of the cloud account is write-only and is never stored in the Terraform state, which requires Terraform 1.11 or later. Change key_version to send a new key to the array.",
+		Description: "This resource is used to manage the CloudPools cloud storage accounts of PowerScale Array. " +
+			"A cloud account holds the connection details and the credentials of a cloud storage, and is used by a cloud pool. " +
+			"We can Create, Update and Delete the cloud accounts using this resource. We can also import an existing cloud account from PowerScale array. " +
+			"The key of the cloud account is write-only and is never stored in the Terraform state, which requires Terraform 1.11 or later. Change key_version to send a new key to the array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the cloud account.",
+				MarkdownDescription: "The ID of the cloud account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The name of the cloud account.",
+				MarkdownDescription: "The name of the cloud account.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "The type of the cloud storage. Accepted values are ecs, s3, azure, google, alibaba and isilon. Cannot be updated.",
+				MarkdownDescription: "The type of the cloud storage. Accepted values are ecs, s3, azure, google, alibaba and isilon. Cannot be updated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(cloudStorageTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				Description:         "The URI of the cloud storage.",
+				MarkdownDescription: "The URI of the cloud storage.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"account_username": schema.StringAttribute{
+				Description:         "The username of the cloud storage account, or the access key ID for the s3 type.",
+				MarkdownDescription: "The username of the cloud storage account, or the access key ID for the s3 type.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description:         "The key or password of the cloud storage account. The key is write-only: it is sent to the array when the cloud account is created or when key_version changes, and it is never stored in the Terraform state.",
+				MarkdownDescription: "The key or password of the cloud storage account. The key is write-only: it is sent to the array when the cloud account is created or when `key_version` changes, and it is never stored in the Terraform state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"key_version": schema.Int64Attribute{
+				Description:         "The version of the key. PowerScale never returns the key and Terraform does not store it, so change this value to send a new key to the array.",
+				MarkdownDescription: "The version of the key. PowerScale never returns the key and Terraform does not store it, so change this value to send a new key to the array.",
+				Optional:            true,
+			},
+			"account_id": schema.StringAttribute{
+				Description:         "The account ID of the cloud storage, required for the s3 type.",
+				MarkdownDescription: "The account ID of the cloud storage, required for the s3 type.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"storage_region": schema.StringAttribute{
+				Description:         "The region of the cloud storage, used by the s3 type.",
+				MarkdownDescription: "The region of the cloud storage, used by the s3 type.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"proxy": schema.StringAttribute{
+				Description:         "The name of the network proxy used to reach the cloud storage.",
+				MarkdownDescription: "The name of the network proxy used to reach the cloud storage.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:         "Whether the cloud account is enabled.",
+				MarkdownDescription: "Whether the cloud account is enabled.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skip_ssl_validation": schema.BoolAttribute{
+				Description:         "Whether to skip the validation of the SSL certificate of the cloud storage.",
+				MarkdownDescription: "Whether to skip the validation of the SSL certificate of the cloud storage.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description:         "The state of the cloud account.",
+				MarkdownDescription: "The state of the cloud account.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *CloudAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *CloudAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating cloud account")
+
+	var plan models.CloudAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The key is write-only, so it is only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key"), &plan.Key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, err := helper.CreateCloudAccount(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating cloud account", err.Error())
+		return
+	}
+
+	account, err := helper.GetCloudAccount(ctx, r.client, accountID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating cloud account", err.Error())
+		return
+	}
+	if err := helper.UpdateCloudAccountState(ctx, &plan, account); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of cloud account resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create cloud account")
+}
+
+// Read reads the resource state.
+func (r *CloudAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading cloud account")
+
+	var state models.CloudAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := helper.GetCloudAccount(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading cloud account", err.Error())
+		return
+	}
+	if err := helper.UpdateCloudAccountState(ctx, &state, account); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of cloud account resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read cloud account")
+}
+
+// Update updates the resource state.
+func (r *CloudAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating cloud account")
+
+	var plan models.CloudAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.CloudAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The key is write-only, so it is sent again only when its version changes
+	if !plan.KeyVersion.Equal(state.KeyVersion) {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key"), &plan.Key)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if err := helper.UpdateCloudAccount(ctx, r.client, state, plan); err != nil {
+		resp.Diagnostics.AddError("Error updating cloud account", err.Error())
+		return
+	}
+
+	account, err := helper.GetCloudAccount(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating cloud account", err.Error())
+		return
+	}
+	if err := helper.UpdateCloudAccountState(ctx, &plan, account); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of cloud account resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update cloud account")
+}
+
+// Delete deletes the resource.
+func (r *CloudAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting cloud account")
+
+	var state models.CloudAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteCloudAccount(ctx, r.client, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting cloud account", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete cloud account")
+}
+
+// ImportState imports the resource state by the cloud account ID or name.
+func (r *CloudAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing cloud account")
+
+	account, err := helper.GetCloudAccount(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing cloud account", err.Error())
+		return
+	}
+
+	var state models.CloudAccountResourceModel
+	if err := helper.UpdateCloudAccountState(ctx, &state, account); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of cloud account resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import cloud account")
+}
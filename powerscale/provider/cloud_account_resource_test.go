@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudAccountResource(t *testing.T) {
+	var cloudAccountResourceName = "powerscale_cloud_account.account_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + CloudAccountResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cloudAccountResourceName, "name", "tfacc_cloud_account"),
+					resource.TestCheckResourceAttr(cloudAccountResourceName, "type", "ecs"),
+					resource.TestCheckResourceAttr(cloudAccountResourceName, "uri", powerscaleCloudAccountURI),
+					resource.TestCheckResourceAttr(cloudAccountResourceName, "account_username", powerscaleCloudAccountUsername),
+					resource.TestCheckNoResourceAttr(cloudAccountResourceName, "key"),
+					resource.TestCheckResourceAttr(cloudAccountResourceName, "key_version", "1"),
+					resource.TestCheckResourceAttr(cloudAccountResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(cloudAccountResourceName, "id"),
+					resource.TestCheckResourceAttrSet(cloudAccountResourceName, "state"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  cloudAccountResourceName,
+				ImportState:   true,
+				ImportStateId: "tfacc_cloud_account",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "tfacc_cloud_account", states[0].Attributes["name"])
+					assert.Equal(t, "ecs", states[0].Attributes["type"])
+					assert.Equal(t, powerscaleCloudAccountURI, states[0].Attributes["uri"])
+					assert.Empty(t, states[0].Attributes["key"])
+					return nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + CloudAccountUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cloudAccountResourceName, "name", "tfacc_cloud_account_updated"),
+					resource.TestCheckResourceAttr(cloudAccountResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(cloudAccountResourceName, "skip_ssl_validation", "true"),
+					resource.TestCheckNoResourceAttr(cloudAccountResourceName, "key"),
+					resource.TestCheckResourceAttr(cloudAccountResourceName, "key_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudAccountResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateCloudAccount).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + CloudAccountResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Read after create error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetCloudAccount).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + CloudAccountResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + CloudAccountResourceConfig,
+			},
+			// Update error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.UpdateCloudAccount).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + CloudAccountUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// The key is not sent when its version is unchanged
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.UpdateCloudAccount).To(func(ctx context.Context, powerscaleClient *client.Client, state, plan models.CloudAccountResourceModel) error {
+						return fmt.Errorf("mock error, key sent: %t", !plan.Key.IsNull())
+					}).Build()
+				},
+				Config:      ProviderConfig + CloudAccountDisableResourceConfig,
+				ExpectError: regexp.MustCompile(`.*key sent: false*.`),
+			},
+			// The key is sent when its version changes
+			{
+				Config:      ProviderConfig + CloudAccountUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*key sent: true*.`),
+			},
+			// Import error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetCloudAccount).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:        ProviderConfig + CloudAccountResourceConfig,
+				ResourceName:  "powerscale_cloud_account.account_test",
+				ImportState:   true,
+				ImportStateId: "tfacc_cloud_account",
+				ExpectError:   regexp.MustCompile(`.*mock error*.`),
+			},
+			// Delete error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.DeleteCloudAccount).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + CloudAccountResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + CloudAccountResourceConfig,
+			},
+		},
+	})
+}
+
+func initCloudAccountConfig() {
+	CloudAccountResourceConfig = fmt.Sprintf(CloudAccountResourceConfig, powerscaleCloudAccountURI, powerscaleCloudAccountUsername, powerscaleCloudAccountKey)
+	CloudAccountUpdateResourceConfig = fmt.Sprintf(CloudAccountUpdateResourceConfig, powerscaleCloudAccountURI, powerscaleCloudAccountUsername, powerscaleCloudAccountKey)
+	CloudAccountDisableResourceConfig = fmt.Sprintf(CloudAccountDisableResourceConfig, powerscaleCloudAccountURI, powerscaleCloudAccountUsername, powerscaleCloudAccountKey)
+
+	// The cloud pool and the filtered cloud datasources need a cloud account as pre-requirement
+	CloudAccountFilterDataSourceConfig = CloudAccountResourceConfig + CloudAccountFilterDataSourceConfig
+	CloudPoolResourceConfig = CloudAccountResourceConfig + CloudPoolResourceConfig
+	CloudPoolUpdateResourceConfig = CloudAccountResourceConfig + CloudPoolUpdateResourceConfig
+	CloudPoolFilterDataSourceConfig = CloudPoolResourceConfig + CloudPoolFilterDataSourceConfig
+}
+
+var CloudAccountResourceConfig = `
+resource "powerscale_cloud_account" "account_test" {
+	name = "tfacc_cloud_account"
+	type = "ecs"
+	uri = "%s"
+	account_username = "%s"
+	key = "%s"
+	key_version = 1
+}
+`
+
+var CloudAccountDisableResourceConfig = `
+resource "powerscale_cloud_account" "account_test" {
+	name = "tfacc_cloud_account"
+	type = "ecs"
+	uri = "%s"
+	account_username = "%s"
+	key = "%s"
+	key_version = 1
+	enabled = false
+}
+`
+
+var CloudAccountUpdateResourceConfig = `
+resource "powerscale_cloud_account" "account_test" {
+	name = "tfacc_cloud_account_updated"
+	type = "ecs"
+	uri = "%s"
+	account_username = "%s"
+	key = "%s"
+	key_version = 2
+	enabled = false
+	skip_ssl_validation = true
+}
+`
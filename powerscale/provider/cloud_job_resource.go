@@ -0,0 +1,229 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource = &CloudJobResource{}
+)
+
+// NewCloudJobResource returns the cloud job resource object.
+func NewCloudJobResource() resource.Resource {
+	return &CloudJobResource{}
+}
+
+// CloudJobResource defines the resource implementation.
+type CloudJobResource struct {
+	client *client.Client
+}
+
+// Configure configures the resource.
+func (r *CloudJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the resource arguments.
+func (r *CloudJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_job"
+}
+
+// Schema defines the schema for the resource.
+func (r *CloudJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to run a CloudPools archive or recall job on PowerScale Array for specific files and directories, and to wait for the job to complete. " +
+			"It complements the cloudpool policy action of the file pool policies, which only archives the files when the SmartPools job runs. " +
+			"The job is started on Create and Update. Delete only removes the resource from the Terraform state.",
+		Description: "This resource is used to run a CloudPools archive or recall job on PowerScale Array for specific files and directories, and to wait for the job to complete. " +
+			"It complements the cloudpool policy action of the file pool policies, which only archives the files when the SmartPools job runs. " +
+			"The job is started on Create and Update. Delete only removes the resource from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the cloud job.",
+				MarkdownDescription: "The ID of the cloud job.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				Description:         "Specifies the type of the cloud job. Acceptable values: archive, recall. archive moves the data of the files to their cloud pool, recall brings it back to the cluster.",
+				MarkdownDescription: "Specifies the type of the cloud job. Acceptable values: archive, recall. archive moves the data of the files to their cloud pool, recall brings it back to the cluster.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("archive", "recall"),
+				},
+			},
+			"files": schema.ListAttribute{
+				Description:         "The paths of the files to archive or recall.",
+				MarkdownDescription: "The paths of the files to archive or recall.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.AtLeastOneOf(path.MatchRoot("directories")),
+				},
+			},
+			"directories": schema.ListAttribute{
+				Description:         "The paths of the directories to archive or recall.",
+				MarkdownDescription: "The paths of the directories to archive or recall.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Description:         "The name of the file pool policy whose cloudpool policy action is used to archive the files. Only used by the archive job.",
+				MarkdownDescription: "The name of the file pool policy whose cloudpool policy action is used to archive the files. Only used by the archive job.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description:         "Whether to wait for the cloud job to complete. A job which fails, is cancelled or paused while waiting is reported as an error.",
+				MarkdownDescription: "Whether to wait for the cloud job to complete. A job which fails, is cancelled or paused while waiting is reported as an error.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"poll_interval": schema.Int64Attribute{
+				Description:         "Seconds to wait between two polls of the cloud job.",
+				MarkdownDescription: "Seconds to wait between two polls of the cloud job.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Description:         "Seconds to wait for the cloud job to complete.",
+				MarkdownDescription: "Seconds to wait for the cloud job to complete.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3600),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description:         "The state of the cloud job.",
+				MarkdownDescription: "The state of the cloud job.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Create starts the cloud job and sets the initial Terraform state.
+func (r *CloudJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating cloud job resource state")
+	var plan models.CloudJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageCloudJob(ctx, r.client, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating cloud job resource state")
+}
+
+// Read refreshes the Terraform state with the latest value.
+func (r *CloudJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading cloud job resource state")
+	var state models.CloudJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading cloud job resource state")
+}
+
+// Update starts the cloud job again and sets the updated Terraform state.
+func (r *CloudJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating cloud job resource state")
+	var plan models.CloudJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageCloudJob(ctx, r.client, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating cloud job resource state")
+}
+
+// Delete deletes the resource.
+func (r *CloudJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting cloud job resource state")
+	var state models.CloudJobResourceModel
+
+	// Read Terraform prior state data into the model
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting cloud job resource state")
+}
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudJobResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + cloudJobConfig("archive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerscale_cloud_job.test", "id"),
+					resource.TestCheckResourceAttr("powerscale_cloud_job.test", "type", "archive"),
+					resource.TestCheckResourceAttr("powerscale_cloud_job.test", "state", "completed"),
+				),
+			},
+			{
+				Config: ProviderConfig + cloudJobConfig("recall"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_cloud_job.test", "type", "recall"),
+					resource.TestCheckResourceAttr("powerscale_cloud_job.test", "state", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudJobResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + cloudJobInvalidTypeConfig,
+				ExpectError: regexp.MustCompile(`.*value must be one of*.`),
+			},
+			{
+				Config:      ProviderConfig + cloudJobNoPathConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateCloudJob).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				Config:      ProviderConfig + cloudJobConfig("archive"),
+				ExpectError: regexp.MustCompile(`.*mock create error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.WaitForCloudJob).Return("", fmt.Errorf("mock wait error")).Build()
+				},
+				Config:      ProviderConfig + cloudJobConfig("archive"),
+				ExpectError: regexp.MustCompile(`.*mock wait error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetCloudJob).Return(newCloudJob("failed"), nil).Build()
+				},
+				Config:      ProviderConfig + cloudJobConfig("archive"),
+				ExpectError: regexp.MustCompile(`.*ended in state failed*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetCloudJob).Return(newCloudJob("paused"), nil).Build()
+				},
+				Config:      ProviderConfig + cloudJobConfig("archive"),
+				ExpectError: regexp.MustCompile(`.*ended in state paused*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + cloudJobConfig("archive"),
+			},
+		},
+	})
+}
+
+// newCloudJob returns a cloud job in the given state.
+func newCloudJob(state string) *powerscale.V3CloudJobExtended {
+	job := &powerscale.V3CloudJobExtended{}
+	job.SetState(state)
+	job.SetEffectiveState(state)
+	return job
+}
+
+func cloudJobConfig(jobType string) string {
+	return CloudPoolResourceConfig + FileSystemResourceConfigCommon + fmt.Sprintf(`
+resource "powerscale_filepool_policy" "cloud_job_policy" {
+	name = "tfacc_cloud_job_policy"
+	file_matching_pattern = {
+		or_criteria = [
+			{
+				and_criteria = [
+					{
+						operator = "=="
+						type = "path"
+						value = "/ifs/tfacc_file_system_test"
+					}
+				]
+			}
+		]
+	}
+	actions = [
+		{
+			action_type = "set_cloudpool_policy"
+			cloudpool_policy_action = {
+				pool = powerscale_cloud_pool.pool_test.name
+			}
+		}
+	]
+	depends_on = [powerscale_filesystem.file_system_test]
+}
+
+resource "powerscale_cloud_job" "test" {
+	type          = "%s"
+	directories   = ["/ifs/tfacc_file_system_test"]
+	policy        = powerscale_filepool_policy.cloud_job_policy.name
+	poll_interval = 1
+}
+`, jobType)
+}
+
+var cloudJobInvalidTypeConfig = `
+resource "powerscale_cloud_job" "test" {
+	type        = "invalid"
+	directories = ["/ifs/tfacc_file_system_test"]
+}
+`
+
+var cloudJobNoPathConfig = `
+resource "powerscale_cloud_job" "test" {
+	type = "archive"
+}
+`
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudPoolDataSource{}
+
+// NewCloudPoolDataSource creates a new data source.
+func NewCloudPoolDataSource() datasource.DataSource {
+	return &CloudPoolDataSource{}
+}
+
+// CloudPoolDataSource defines the data source implementation.
+type CloudPoolDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *CloudPoolDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_pool"
+}
+
+// Schema describes the data source arguments.
+func (d *CloudPoolDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the existing CloudPools cloud pools from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing CloudPools cloud pools from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Cloud Pool datasource.",
+				MarkdownDescription: "Identifier of the Cloud Pool datasource.",
+				Computed:            true,
+			},
+			"cloud_pools_details": schema.ListNestedAttribute{
+				Description:         "List of cloud pools.",
+				MarkdownDescription: "List of cloud pools.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "The ID of the cloud pool.",
+							MarkdownDescription: "The ID of the cloud pool.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "The name of the cloud pool.",
+							MarkdownDescription: "The name of the cloud pool.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "The type of the cloud storage of the pool.",
+							MarkdownDescription: "The type of the cloud storage of the pool.",
+							Computed:            true,
+						},
+						"accounts": schema.ListAttribute{
+							Description:         "The names of the cloud accounts in the pool.",
+							MarkdownDescription: "The names of the cloud accounts in the pool.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"description": schema.StringAttribute{
+							Description:         "The description of the cloud pool.",
+							MarkdownDescription: "The description of the cloud pool.",
+							Computed:            true,
+						},
+						"vendor": schema.StringAttribute{
+							Description:         "The vendor of the cloud storage.",
+							MarkdownDescription: "The vendor of the cloud storage.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							Description:         "The state of the cloud pool.",
+							MarkdownDescription: "The state of the cloud pool.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Description:         "Only list the cloud pools with these names.",
+						MarkdownDescription: "Only list the cloud pools with these names.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *CloudPoolDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *CloudPoolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading cloud pool data source")
+
+	var state models.CloudPoolDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.ManageDataSourceCloudPool(ctx, d.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading cloud pool data source ")
+}
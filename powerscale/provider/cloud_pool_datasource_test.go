@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudPoolDataSource(t *testing.T) {
+	var cloudPoolTerraformName = "data.powerscale_cloud_pool.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read all the cloud pools
+			{
+				Config: ProviderConfig + CloudPoolAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cloudPoolTerraformName, "id", "cloud_pool_datasource"),
+					resource.TestCheckResourceAttrSet(cloudPoolTerraformName, "cloud_pools_details.#"),
+				),
+			},
+			// Filter by name
+			{
+				Config: ProviderConfig + CloudPoolFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cloudPoolTerraformName, "cloud_pools_details.#", "1"),
+					resource.TestCheckResourceAttr(cloudPoolTerraformName, "cloud_pools_details.0.name", "tfacc_cloud_pool"),
+					resource.TestCheckResourceAttr(cloudPoolTerraformName, "cloud_pools_details.0.accounts.0", "tfacc_cloud_account"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudPoolDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListCloudPools).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + CloudPoolAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var CloudPoolAllDataSourceConfig = `
+data "powerscale_cloud_pool" "test" {
+}
+`
+
+// CloudPoolFilterDataSourceConfig is prefixed with its resource config in initCloudAccountConfig.
+var CloudPoolFilterDataSourceConfig = `
+data "powerscale_cloud_pool" "test" {
+	filter {
+		names = [powerscale_cloud_pool.pool_test.name]
+	}
+}
+`
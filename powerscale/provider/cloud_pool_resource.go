@@ -0,0 +1,283 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CloudPoolResource{}
+var _ resource.ResourceWithConfigure = &CloudPoolResource{}
+var _ resource.ResourceWithImportState = &CloudPoolResource{}
+
+// NewCloudPoolResource creates a new resource.
+func NewCloudPoolResource() resource.Resource {
+	return &CloudPoolResource{}
+}
+
+// CloudPoolResource defines the resource implementation.
+type CloudPoolResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *CloudPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_pool"
+}
+
+// Schema describes the resource arguments.
+func (r *CloudPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the CloudPools cloud pools of PowerScale Array. " +
+			"A cloud pool groups cloud accounts of the same type and is the target of the cloudpool policy action of a file pool policy. " +
+			"We can Create, Update and Delete the cloud pools using this resource. We can also import an existing cloud pool from PowerScale array.",
+		Description: "This resource is used to manage the CloudPools cloud pools of PowerScale Array. " +
+			"A cloud pool groups cloud accounts of the same type and is the target of the cloudpool policy action of a file pool policy. " +
+			"We can Create, Update and Delete the cloud pools using this resource. We can also import an existing cloud pool from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the cloud pool.",
+				MarkdownDescription: "The ID of the cloud pool.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The name of the cloud pool.",
+				MarkdownDescription: "The name of the cloud pool.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "The type of the cloud storage of the pool, which must match the type of its cloud accounts. Accepted values are ecs, s3, azure, google, alibaba and isilon. Cannot be updated.",
+				MarkdownDescription: "The type of the cloud storage of the pool, which must match the type of its cloud accounts. Accepted values are ecs, s3, azure, google, alibaba and isilon. Cannot be updated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(cloudStorageTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"accounts": schema.ListAttribute{
+				Description:         "The names of the cloud accounts in the pool.",
+				MarkdownDescription: "The names of the cloud accounts in the pool.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description:         "The description of the cloud pool.",
+				MarkdownDescription: "The description of the cloud pool.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vendor": schema.StringAttribute{
+				Description:         "The vendor of the cloud storage.",
+				MarkdownDescription: "The vendor of the cloud storage.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description:         "The state of the cloud pool.",
+				MarkdownDescription: "The state of the cloud pool.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *CloudPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *CloudPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating cloud pool")
+
+	var plan models.CloudPoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolID, err := helper.CreateCloudPool(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating cloud pool", err.Error())
+		return
+	}
+
+	pool, err := helper.GetCloudPool(ctx, r.client, poolID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating cloud pool", err.Error())
+		return
+	}
+	if err := helper.UpdateCloudPoolState(ctx, &plan, pool); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of cloud pool resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create cloud pool")
+}
+
+// Read reads the resource state.
+func (r *CloudPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading cloud pool")
+
+	var state models.CloudPoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := helper.GetCloudPool(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading cloud pool", err.Error())
+		return
+	}
+	if err := helper.UpdateCloudPoolState(ctx, &state, pool); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of cloud pool resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read cloud pool")
+}
+
+// Update updates the resource state.
+func (r *CloudPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating cloud pool")
+
+	var plan models.CloudPoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.CloudPoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateCloudPool(ctx, r.client, state, plan); err != nil {
+		resp.Diagnostics.AddError("Error updating cloud pool", err.Error())
+		return
+	}
+
+	pool, err := helper.GetCloudPool(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating cloud pool", err.Error())
+		return
+	}
+	if err := helper.UpdateCloudPoolState(ctx, &plan, pool); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of cloud pool resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update cloud pool")
+}
+
+// Delete deletes the resource.
+func (r *CloudPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting cloud pool")
+
+	var state models.CloudPoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteCloudPool(ctx, r.client, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting cloud pool", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete cloud pool")
+}
+
+// ImportState imports the resource state by the cloud pool ID or name.
+func (r *CloudPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing cloud pool")
+
+	pool, err := helper.GetCloudPool(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing cloud pool", err.Error())
+		return
+	}
+
+	var state models.CloudPoolResourceModel
+	if err := helper.UpdateCloudPoolState(ctx, &state, pool); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of cloud pool resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import cloud pool")
+}
@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudPoolResource(t *testing.T) {
+	var cloudPoolResourceName = "powerscale_cloud_pool.pool_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + CloudPoolResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cloudPoolResourceName, "name", "tfacc_cloud_pool"),
+					resource.TestCheckResourceAttr(cloudPoolResourceName, "type", "ecs"),
+					resource.TestCheckResourceAttr(cloudPoolResourceName, "accounts.#", "1"),
+					resource.TestCheckResourceAttr(cloudPoolResourceName, "accounts.0", "tfacc_cloud_account"),
+					resource.TestCheckResourceAttrSet(cloudPoolResourceName, "id"),
+					resource.TestCheckResourceAttrSet(cloudPoolResourceName, "state"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      cloudPoolResourceName,
+				ImportState:       true,
+				ImportStateId:     "tfacc_cloud_pool",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + CloudPoolUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cloudPoolResourceName, "name", "tfacc_cloud_pool_updated"),
+					resource.TestCheckResourceAttr(cloudPoolResourceName, "description", "Tiering target of the archive policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudPoolResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateCloudPool).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + CloudPoolResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Read after create error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetCloudPool).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + CloudPoolResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + CloudPoolResourceConfig,
+			},
+			// Update error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.UpdateCloudPool).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + CloudPoolUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Delete error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.DeleteCloudPool).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + CloudPoolResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + CloudPoolResourceConfig,
+			},
+		},
+	})
+}
+
+// CloudPoolResourceConfig is prefixed with CloudAccountResourceConfig in initCloudAccountConfig.
+var CloudPoolResourceConfig = `
+resource "powerscale_cloud_pool" "pool_test" {
+	name = "tfacc_cloud_pool"
+	type = powerscale_cloud_account.account_test.type
+	accounts = [powerscale_cloud_account.account_test.name]
+}
+`
+
+var CloudPoolUpdateResourceConfig = `
+resource "powerscale_cloud_pool" "pool_test" {
+	name = "tfacc_cloud_pool_updated"
+	type = powerscale_cloud_account.account_test.type
+	accounts = [powerscale_cloud_account.account_test.name]
+	description = "Tiering target of the archive policy"
+}
+`
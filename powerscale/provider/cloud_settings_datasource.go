@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &CloudSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &CloudSettingsDataSource{}
+)
+
+// NewCloudSettingsDataSource creates a new cluster email settings data source.
+func NewCloudSettingsDataSource() datasource.DataSource {
+	return &CloudSettingsDataSource{}
+}
+
+// CloudSettingsDataSource defines the data source implementation.
+type CloudSettingsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *CloudSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_settings"
+}
+
+// Schema describes the data source arguments.
+func (d *CloudSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the Cloud Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the Cloud Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of Cloud settings. Readonly. ",
+				MarkdownDescription: "Id of Cloud settings. Readonly. ",
+			},
+			"cloud_policy_defaults": schema.SingleNestedAttribute{
+				Description:         "The default values of the cloudpool policy action of the file pool policies.",
+				MarkdownDescription: "The default values of the cloudpool policy action of the file pool policies.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"archive_snapshot_files": schema.BoolAttribute{
+						Description:         "Specifies if files with snapshots should be archived.",
+						MarkdownDescription: "Specifies if files with snapshots should be archived.",
+						Computed:            true,
+					},
+					"cache": schema.SingleNestedAttribute{
+						Description:         "Specifies default cloudpool cache settings for new filepool policies.",
+						MarkdownDescription: "Specifies default cloudpool cache settings for new filepool policies.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"expiration": schema.Int64Attribute{
+								Description:         "Specifies cache expiration.",
+								MarkdownDescription: "Specifies cache expiration.",
+								Computed:            true,
+							},
+							"read_ahead": schema.StringAttribute{
+								Description:         "Specifies cache read ahead type. Acceptable values: partial, full.",
+								MarkdownDescription: "Specifies cache read ahead type. Acceptable values: partial, full.",
+								Computed:            true,
+							},
+							"type": schema.StringAttribute{
+								Description:         "Specifies cache type. Acceptable values: cached, no-cache.",
+								MarkdownDescription: "Specifies cache type. Acceptable values: cached, no-cache.",
+								Computed:            true,
+							},
+						},
+					},
+					"compression": schema.BoolAttribute{
+						Description:         "Specifies if files should be compressed.",
+						MarkdownDescription: "Specifies if files should be compressed.",
+						Computed:            true,
+					},
+					"data_retention": schema.Int64Attribute{
+						Description:         "Specifies the minimum amount of time archived data will be retained in the cloud after deletion.",
+						MarkdownDescription: "Specifies the minimum amount of time archived data will be retained in the cloud after deletion.",
+						Computed:            true,
+					},
+					"encryption": schema.BoolAttribute{
+						Description:         "Specifies if files should be encrypted.",
+						MarkdownDescription: "Specifies if files should be encrypted.",
+						Computed:            true,
+					},
+					"full_backup_retention": schema.Int64Attribute{
+						Description:         "The minimum amount of time cloud files will be retained after the creation of a full NDMP backup. (Used with NDMP backups only.  Not applicable to SyncIQ.)",
+						MarkdownDescription: "The minimum amount of time cloud files will be retained after the creation of a full NDMP backup. (Used with NDMP backups only.  Not applicable to SyncIQ.)",
+						Computed:            true,
+					},
+					"incremental_backup_retention": schema.Int64Attribute{
+						Description:         "The minimum amount of time cloud files will be retained after the creation of a SyncIQ backup or an incremental NDMP backup. (Used with SyncIQ and NDMP backups.)",
+						MarkdownDescription: "The minimum amount of time cloud files will be retained after the creation of a SyncIQ backup or an incremental NDMP backup. (Used with SyncIQ and NDMP backups.)",
+						Computed:            true,
+					},
+					"writeback_frequency": schema.Int64Attribute{
+						Description:         "The minimum amount of time to wait before updating cloud data with local changes.",
+						MarkdownDescription: "The minimum amount of time to wait before updating cloud data with local changes.",
+						Computed:            true,
+					},
+				},
+			},
+			"default_network_proxy": schema.StringAttribute{
+				Description:         "The name of the network proxy used by default to reach the cloud storage.",
+				MarkdownDescription: "The name of the network proxy used by default to reach the cloud storage.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *CloudSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *CloudSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Cloud Settings data source ")
+
+	var settingsState models.CloudSettingsModel
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &settingsState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudSettings, err := helper.GetCloudSettings(ctx, d.client)
+
+	if err != nil {
+		errStr := constants.ReadCloudSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error reading cloud settings",
+			message,
+		)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, cloudSettings.GetSettings(), &settingsState)
+	if err != nil {
+		resp.Diagnostics.AddError("Error copying fields of cloud settings datasource", err.Error())
+		return
+	}
+
+	settingsState.ID = types.StringValue("cloud_settings")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settingsState)...)
+	tflog.Info(ctx, "Done with Read Cloud Settings data source ")
+}
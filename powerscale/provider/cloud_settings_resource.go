@@ -0,0 +1,366 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &CloudSettingsResource{}
+	_ resource.ResourceWithConfigure = &CloudSettingsResource{}
+)
+
+// NewCloudSettingsResource creates a new resource.
+func NewCloudSettingsResource() resource.Resource {
+	return &CloudSettingsResource{}
+}
+
+// CloudSettingsResource defines the resource implementation.
+type CloudSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (r *CloudSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_settings"
+}
+
+// Schema describes the data source arguments.
+func (r *CloudSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `This resource is used to manage the Cloud Settings of PowerScale Array. We can Create, Update and Delete the Cloud Settings using this resource.  
+Note that, Cloud Settings is the native functionality of PowerScale CloudPools. When creating the resource, we actually load Cloud Settings from PowerScale to the resource.`,
+		Description: `This resource is used to manage the Cloud Settings of PowerScale Array. We can Create, Update and Delete the Cloud Settings using this resource.  
+Note that, Cloud Settings is the native functionality of PowerScale CloudPools. When creating the resource, we actually load Cloud Settings from PowerScale to the resource.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of Cloud settings. Readonly. ",
+				MarkdownDescription: "Id of Cloud settings. Readonly. ",
+			},
+			"cloud_policy_defaults": schema.SingleNestedAttribute{
+				Description:         "The default values of the cloudpool policy action of the file pool policies.",
+				MarkdownDescription: "The default values of the cloudpool policy action of the file pool policies.",
+				Optional:            true,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"archive_snapshot_files": schema.BoolAttribute{
+						Description:         "Specifies if files with snapshots should be archived.",
+						MarkdownDescription: "Specifies if files with snapshots should be archived.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"cache": schema.SingleNestedAttribute{
+						Description:         "Specifies default cloudpool cache settings for new filepool policies.",
+						MarkdownDescription: "Specifies default cloudpool cache settings for new filepool policies.",
+						Optional:            true,
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"expiration": schema.Int64Attribute{
+								Description:         "Specifies cache expiration.",
+								MarkdownDescription: "Specifies cache expiration.",
+								Optional:            true,
+								Computed:            true,
+							},
+							"read_ahead": schema.StringAttribute{
+								Description:         "Specifies cache read ahead type. Acceptable values: partial, full.",
+								MarkdownDescription: "Specifies cache read ahead type. Acceptable values: partial, full.",
+								Optional:            true,
+								Computed:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf("partial", "full"),
+								},
+							},
+							"type": schema.StringAttribute{
+								Description:         "Specifies cache type. Acceptable values: cached, no-cache.",
+								MarkdownDescription: "Specifies cache type. Acceptable values: cached, no-cache.",
+								Optional:            true,
+								Computed:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf("cached", "no-cache"),
+								},
+							},
+						},
+					},
+					"compression": schema.BoolAttribute{
+						Description:         "Specifies if files should be compressed.",
+						MarkdownDescription: "Specifies if files should be compressed.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"data_retention": schema.Int64Attribute{
+						Description:         "Specifies the minimum amount of time archived data will be retained in the cloud after deletion.",
+						MarkdownDescription: "Specifies the minimum amount of time archived data will be retained in the cloud after deletion.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"encryption": schema.BoolAttribute{
+						Description:         "Specifies if files should be encrypted.",
+						MarkdownDescription: "Specifies if files should be encrypted.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"full_backup_retention": schema.Int64Attribute{
+						Description:         "The minimum amount of time cloud files will be retained after the creation of a full NDMP backup. (Used with NDMP backups only.  Not applicable to SyncIQ.)",
+						MarkdownDescription: "The minimum amount of time cloud files will be retained after the creation of a full NDMP backup. (Used with NDMP backups only.  Not applicable to SyncIQ.)",
+						Optional:            true,
+						Computed:            true,
+					},
+					"incremental_backup_retention": schema.Int64Attribute{
+						Description:         "The minimum amount of time cloud files will be retained after the creation of a SyncIQ backup or an incremental NDMP backup. (Used with SyncIQ and NDMP backups.)",
+						MarkdownDescription: "The minimum amount of time cloud files will be retained after the creation of a SyncIQ backup or an incremental NDMP backup. (Used with SyncIQ and NDMP backups.)",
+						Optional:            true,
+						Computed:            true,
+					},
+					"writeback_frequency": schema.Int64Attribute{
+						Description:         "The minimum amount of time to wait before updating cloud data with local changes.",
+						MarkdownDescription: "The minimum amount of time to wait before updating cloud data with local changes.",
+						Optional:            true,
+						Computed:            true,
+					},
+				},
+			},
+			"default_network_proxy": schema.StringAttribute{
+				Description:         "The name of the network proxy used by default to reach the cloud storage.",
+				MarkdownDescription: "The name of the network proxy used by default to reach the cloud storage.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *CloudSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *CloudSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Cloud Settings resource...")
+
+	var plan models.CloudSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V4CloudSettingsExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateCloudSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating cloud settings",
+			fmt.Sprintf("Could not read cloud settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateCloudSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateCloudSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating cloud settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetCloudSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadCloudSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading cloud settings", message)
+		return
+	}
+
+	var state models.CloudSettingsModel
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of cloud settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("cloud_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Create cloud settings resource")
+}
+
+// Read reads the resource state.
+func (r *CloudSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading Cloud Settings resource")
+
+	var state models.CloudSettingsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := helper.GetCloudSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadCloudSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading cloud settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of cloud settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("cloud_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read cloud settings resource")
+}
+
+// Update updates the resource state.
+func (r *CloudSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Cloud Settings resource...")
+
+	var plan models.CloudSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.CloudSettingsModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V4CloudSettingsExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateCloudSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating cloud settings",
+			fmt.Sprintf("Could not read cloud settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateCloudSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateCloudSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating cloud settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetCloudSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadCloudSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading cloud settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of cloud settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("cloud_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Update cloud settings resource")
+}
+
+// Delete deletes the resource.
+func (r *CloudSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Cloud Settings resource")
+	var state models.CloudSettingsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Cloud settings is the native functionality that cannot be deleted, so just remove state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete cloud settings resource")
+}
+
+// ImportState imports the resource state.
+func (r *CloudSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Cloud Settings resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
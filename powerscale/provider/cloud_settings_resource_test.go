@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"github.com/bytedance/mockey"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCloudSettingsImport(t *testing.T) {
+	var cloudSettings = "powerscale_cloud_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + cloudSettingsResourceConfig,
+			},
+			// Import testing
+			{
+				ResourceName: cloudSettings,
+				ImportState:  true,
+				ExpectError:  nil,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					resource.TestCheckResourceAttrSet(cloudSettings, "id")
+					resource.TestCheckResourceAttrSet(cloudSettings, "cloud_policy_defaults.compression")
+					resource.TestCheckResourceAttrSet(cloudSettings, "cloud_policy_defaults.encryption")
+					resource.TestCheckResourceAttrSet(cloudSettings, "cloud_policy_defaults.data_retention")
+					resource.TestCheckResourceAttrSet(cloudSettings, "cloud_policy_defaults.cache.type")
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudSettingsUpdate(t *testing.T) {
+	var cloudSettings = "powerscale_cloud_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + cloudSettingsResourceConfig,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + cloudSettingsUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cloudSettings, "cloud_policy_defaults.compression", "false"),
+					resource.TestCheckResourceAttr(cloudSettings, "cloud_policy_defaults.encryption", "false"),
+					resource.TestCheckResourceAttr(cloudSettings, "cloud_policy_defaults.data_retention", "86400"),
+					resource.TestCheckResourceAttr(cloudSettings, "cloud_policy_defaults.cache.read_ahead", "full"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + cloudSettingsUpdateRevertResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cloudSettings, "cloud_policy_defaults.compression", "true"),
+					resource.TestCheckResourceAttr(cloudSettings, "cloud_policy_defaults.encryption", "true"),
+					resource.TestCheckResourceAttr(cloudSettings, "cloud_policy_defaults.data_retention", "604800"),
+					resource.TestCheckResourceAttr(cloudSettings, "cloud_policy_defaults.cache.read_ahead", "partial"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudSettingsCreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetCloudSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + cloudSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateCloudSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + cloudSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + cloudSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + cloudSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccCloudSettingsUpdateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + cloudSettingsResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetCloudSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + cloudSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateCloudSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + cloudSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + cloudSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + cloudSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccCloudSettingsImportMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + cloudSettingsResourceConfig,
+			},
+			// Import and read Error testing
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetCloudSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:            ProviderConfig + cloudSettingsResourceConfig,
+				ResourceName:      "powerscale_cloud_settings.test",
+				ImportState:       true,
+				ExpectError:       regexp.MustCompile(`.*mock error*.`),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var cloudSettingsResourceConfig = `
+resource "powerscale_cloud_settings" "test" {
+
+}
+`
+
+var cloudSettingsUpdateResourceConfig = `
+resource "powerscale_cloud_settings" "test" {
+	cloud_policy_defaults = {
+		compression = false
+		encryption = false
+		data_retention = 86400
+		cache = {
+			read_ahead = "full"
+		}
+	}
+}
+`
+
+var cloudSettingsUpdateRevertResourceConfig = `
+resource "powerscale_cloud_settings" "test" {
+	cloud_policy_defaults = {
+		compression = true
+		encryption = true
+		data_retention = 604800
+		cache = {
+			read_ahead = "partial"
+		}
+	}
+}
+`
@@ -0,0 +1,321 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DataReductionStatsDataSource{}
+
+// NewDataReductionStatsDataSource creates a new data source.
+func NewDataReductionStatsDataSource() datasource.DataSource {
+	return &DataReductionStatsDataSource{}
+}
+
+// DataReductionStatsDataSource defines the data source implementation.
+type DataReductionStatsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *DataReductionStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_reduction_stats"
+}
+
+// Schema describes the data source arguments.
+func (d *DataReductionStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the data reduction statistics of PowerScale array, together with the physical usage of each node pool and tier. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the data reduction statistics of PowerScale array, together with the physical usage of each node pool and tier. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Data Reduction Statistics datasource.",
+				MarkdownDescription: "Identifier of the Data Reduction Statistics datasource.",
+				Computed:            true,
+			},
+			"statistics_keys": schema.ListAttribute{
+				Description: "The statistics keys to query, e.g. the compression and dedupe keys listed by 'isi statistics list keys'. " +
+					"Defaults to the cluster dedupe savings keys, the ifs capacity keys and the cluster.compression and cluster.data.reduce keys known by the array.",
+				MarkdownDescription: "The statistics keys to query, e.g. the compression and dedupe keys listed by `isi statistics list keys`. " +
+					"Defaults to the cluster dedupe savings keys, the ifs capacity keys and the cluster.compression and cluster.data.reduce keys known by the array.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"statistics": schema.ListNestedAttribute{
+				Description:         "The current value of the statistics keys.",
+				MarkdownDescription: "The current value of the statistics keys.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description:         "The statistics key.",
+							MarkdownDescription: "The statistics key.",
+							Computed:            true,
+						},
+						"devid": schema.Int64Attribute{
+							Description:         "The device ID the value was collected on, 0 for cluster wide keys.",
+							MarkdownDescription: "The device ID the value was collected on, 0 for cluster wide keys.",
+							Computed:            true,
+						},
+						"time": schema.Int64Attribute{
+							Description:         "The time the value was collected, in unix epoch seconds.",
+							MarkdownDescription: "The time the value was collected, in unix epoch seconds.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							Description:         "The value of the key. Structured values are rendered as JSON.",
+							MarkdownDescription: "The value of the key. Structured values are rendered as JSON.",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							Description:         "The error reported for the key, e.g. when the key is unknown.",
+							MarkdownDescription: "The error reported for the key, e.g. when the key is unknown.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"storage_pools": schema.ListNestedAttribute{
+				Description:         "The node pools and tiers with their usage. The storage pools report their physical capacity only: the logical size and the data reduction ratio are tracked cluster wide by the statistics keys and are not available per node pool or tier.",
+				MarkdownDescription: "The node pools and tiers with their usage. The storage pools report their physical capacity only: the logical size and the data reduction ratio are tracked cluster wide by the statistics keys and are not available per node pool or tier.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description:         "The system ID given to the storage pool.",
+							MarkdownDescription: "The system ID given to the storage pool.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "The name of the storage pool.",
+							MarkdownDescription: "The name of the storage pool.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "The type of the storage pool, nodepool or tier.",
+							MarkdownDescription: "The type of the storage pool, nodepool or tier.",
+							Computed:            true,
+						},
+						"lnns": schema.ListAttribute{
+							Description:         "The nodes that are part of the storage pool.",
+							MarkdownDescription: "The nodes that are part of the storage pool.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"children": schema.ListAttribute{
+							Description:         "The names of the node pools of a tier.",
+							MarkdownDescription: "The names of the node pools of a tier.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"usage": schema.SingleNestedAttribute{
+							Description:         "Usage of the storage pool.",
+							MarkdownDescription: "Usage of the storage pool.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"avail_bytes": schema.StringAttribute{
+									Description:         "Available free bytes remaining in the pool when virtual hot spare is taken into account.",
+									MarkdownDescription: "Available free bytes remaining in the pool when virtual hot spare is taken into account.",
+									Computed:            true,
+								},
+								"avail_hdd_bytes": schema.StringAttribute{
+									Description:         "Available free bytes remaining in the pool on HDD drives when virtual hot spare is taken into account.",
+									MarkdownDescription: "Available free bytes remaining in the pool on HDD drives when virtual hot spare is taken into account.",
+									Computed:            true,
+								},
+								"avail_ssd_bytes": schema.StringAttribute{
+									Description:         "Available free bytes remaining in the pool on SSD drives when virtual hot spare is taken into account.",
+									MarkdownDescription: "Available free bytes remaining in the pool on SSD drives when virtual hot spare is taken into account.",
+									Computed:            true,
+								},
+								"balanced": schema.BoolAttribute{
+									Description:         "Whether or not the pool usage is currently balanced.",
+									MarkdownDescription: "Whether or not the pool usage is currently balanced.",
+									Computed:            true,
+								},
+								"free_bytes": schema.StringAttribute{
+									Description:         "Free bytes remaining in the pool.",
+									MarkdownDescription: "Free bytes remaining in the pool.",
+									Computed:            true,
+								},
+								"free_hdd_bytes": schema.StringAttribute{
+									Description:         "Free bytes remaining in the pool on HDD drives.",
+									MarkdownDescription: "Free bytes remaining in the pool on HDD drives.",
+									Computed:            true,
+								},
+								"free_ssd_bytes": schema.StringAttribute{
+									Description:         "Free bytes remaining in the pool on SSD drives.",
+									MarkdownDescription: "Free bytes remaining in the pool on SSD drives.",
+									Computed:            true,
+								},
+								"pct_used": schema.StringAttribute{
+									Description:         "Percentage of usable space in the pool which is used.",
+									MarkdownDescription: "Percentage of usable space in the pool which is used.",
+									Computed:            true,
+								},
+								"pct_used_hdd": schema.StringAttribute{
+									Description:         "Percentage of usable space on HDD drives in the pool which is used.",
+									MarkdownDescription: "Percentage of usable space on HDD drives in the pool which is used.",
+									Computed:            true,
+								},
+								"pct_used_ssd": schema.StringAttribute{
+									Description:         "Percentage of usable space on SSD drives in the pool which is used.",
+									MarkdownDescription: "Percentage of usable space on SSD drives in the pool which is used.",
+									Computed:            true,
+								},
+								"total_bytes": schema.StringAttribute{
+									Description:         "Total bytes in the pool.",
+									MarkdownDescription: "Total bytes in the pool.",
+									Computed:            true,
+								},
+								"total_hdd_bytes": schema.StringAttribute{
+									Description:         "Total bytes in the pool on HDD drives.",
+									MarkdownDescription: "Total bytes in the pool on HDD drives.",
+									Computed:            true,
+								},
+								"total_ssd_bytes": schema.StringAttribute{
+									Description:         "Total bytes in the pool on SSD drives.",
+									MarkdownDescription: "Total bytes in the pool on SSD drives.",
+									Computed:            true,
+								},
+								"usable_bytes": schema.StringAttribute{
+									Description:         "Total bytes in the pool drives when virtual hot spare is taken into account.",
+									MarkdownDescription: "Total bytes in the pool drives when virtual hot spare is taken into account.",
+									Computed:            true,
+								},
+								"usable_hdd_bytes": schema.StringAttribute{
+									Description:         "Total bytes in the pool on HDD drives when virtual hot spare is taken into account.",
+									MarkdownDescription: "Total bytes in the pool on HDD drives when virtual hot spare is taken into account.",
+									Computed:            true,
+								},
+								"usable_ssd_bytes": schema.StringAttribute{
+									Description:         "Total bytes in the pool on SSD drives when virtual hot spare is taken into account.",
+									MarkdownDescription: "Total bytes in the pool on SSD drives when virtual hot spare is taken into account.",
+									Computed:            true,
+								},
+								"used_bytes": schema.StringAttribute{
+									Description:         "Used bytes in the pool.",
+									MarkdownDescription: "Used bytes in the pool.",
+									Computed:            true,
+								},
+								"used_hdd_bytes": schema.StringAttribute{
+									Description:         "Used bytes in the pool on HDD drives.",
+									MarkdownDescription: "Used bytes in the pool on HDD drives.",
+									Computed:            true,
+								},
+								"used_ssd_bytes": schema.StringAttribute{
+									Description:         "Used bytes in the pool on SSD drives.",
+									MarkdownDescription: "Used bytes in the pool on SSD drives.",
+									Computed:            true,
+								},
+								"virtual_hot_spare_bytes": schema.StringAttribute{
+									Description:         "Bytes reserved for virtual hot spare in the pool.",
+									MarkdownDescription: "Bytes reserved for virtual hot spare in the pool.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Description:         "Only list the storage pools with these names.",
+						MarkdownDescription: "Only list the storage pools with these names.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"type": schema.StringAttribute{
+						Description:         "Only list the storage pools of this type. Acceptable values: nodepool, tier.",
+						MarkdownDescription: "Only list the storage pools of this type. Acceptable values: nodepool, tier.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("nodepool", "tier"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *DataReductionStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *DataReductionStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading data reduction statistics data source")
+
+	var state models.DataReductionStatsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.ManageDataSourceDataReductionStats(ctx, d.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading data reduction statistics data source ")
+}
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataReductionStatsDataSource(t *testing.T) {
+	var dataReductionStats = "data.powerscale_data_reduction_stats.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + dataReductionStatsAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataReductionStats, "id", "data_reduction_stats_datasource"),
+					resource.TestCheckResourceAttrSet(dataReductionStats, "statistics.#"),
+					resource.TestCheckResourceAttr(dataReductionStats, "statistics.0.key", helper.DataReductionStatisticsDefaultKeys[0]),
+					resource.TestCheckResourceAttrSet(dataReductionStats, "statistics.0.value"),
+					resource.TestCheckResourceAttrSet(dataReductionStats, "storage_pools.#"),
+					resource.TestCheckResourceAttrSet(dataReductionStats, "storage_pools.0.usage.total_bytes"),
+				),
+			},
+			{
+				Config: ProviderConfig + dataReductionStatsKeysDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataReductionStats, "statistics.#", "1"),
+					resource.TestCheckResourceAttr(dataReductionStats, "statistics.0.key", "ifs.bytes.used"),
+				),
+			},
+			{
+				Config: ProviderConfig + dataReductionStatsFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataReductionStats, "storage_pools.#"),
+				),
+			},
+			{
+				Config: ProviderConfig + dataReductionStatsFilterNameDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataReductionStats, "storage_pools.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataReductionStatsDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + dataReductionStatsInvalidFilterDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListStatisticsKeys).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dataReductionStatsAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetDataReductionStatistics).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dataReductionStatsAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetAllStoragePools).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dataReductionStatsAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.CopyFields).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dataReductionStatsAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + dataReductionStatsAllDataSourceConfig,
+			},
+		},
+	})
+}
+
+var dataReductionStatsAllDataSourceConfig = `
+data "powerscale_data_reduction_stats" "test" {
+}
+`
+
+var dataReductionStatsKeysDataSourceConfig = `
+data "powerscale_data_reduction_stats" "test" {
+	statistics_keys = ["ifs.bytes.used"]
+}
+`
+
+var dataReductionStatsFilterDataSourceConfig = `
+data "powerscale_data_reduction_stats" "test" {
+	filter {
+		type = "nodepool"
+	}
+}
+`
+
+var dataReductionStatsFilterNameDataSourceConfig = `
+data "powerscale_data_reduction_stats" "test" {
+	filter {
+		names = ["nonexistent_pool"]
+	}
+}
+`
+
+var dataReductionStatsInvalidFilterDataSourceConfig = `
+data "powerscale_data_reduction_stats" "test" {
+	filter {
+		type = "invalid"
+	}
+}
+`
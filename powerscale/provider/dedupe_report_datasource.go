@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DedupeReportDataSource{}
+
+// NewDedupeReportDataSource creates a new data source.
+func NewDedupeReportDataSource() datasource.DataSource {
+	return &DedupeReportDataSource{}
+}
+
+// DedupeReportDataSource defines the data source implementation.
+type DedupeReportDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *DedupeReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedupe_report"
+}
+
+// Schema describes the data source arguments.
+func (d *DedupeReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the SmartDedupe savings summary and the reports of the Dedupe and DedupeAssessment jobs, with the assessed and estimated savings of each report, from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the SmartDedupe savings summary and the reports of the Dedupe and DedupeAssessment jobs, with the assessed and estimated savings of each report, from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Dedupe Report datasource.",
+				MarkdownDescription: "Identifier of the Dedupe Report datasource.",
+				Computed:            true,
+			},
+			"summary": schema.SingleNestedAttribute{
+				Description:         "The dedupe savings of the cluster. The estimated values are the results of the last DedupeAssessment job.",
+				MarkdownDescription: "The dedupe savings of the cluster. The estimated values are the results of the last DedupeAssessment job.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"block_size": schema.Int64Attribute{
+						Description:         "The block size in bytes.",
+						MarkdownDescription: "The block size in bytes.",
+						Computed:            true,
+					},
+					"estimated_physical_blocks": schema.Int64Attribute{
+						Description:         "The number of physical blocks estimated to be used after deduplication.",
+						MarkdownDescription: "The number of physical blocks estimated to be used after deduplication.",
+						Computed:            true,
+					},
+					"estimated_saved_blocks": schema.Int64Attribute{
+						Description:         "The number of physical blocks estimated to be saved by deduplication.",
+						MarkdownDescription: "The number of physical blocks estimated to be saved by deduplication.",
+						Computed:            true,
+					},
+					"logical_blocks": schema.Int64Attribute{
+						Description:         "The number of logical blocks of the deduplicated files.",
+						MarkdownDescription: "The number of logical blocks of the deduplicated files.",
+						Computed:            true,
+					},
+					"saved_logical_blocks": schema.Int64Attribute{
+						Description:         "The number of logical blocks saved by deduplication.",
+						MarkdownDescription: "The number of logical blocks saved by deduplication.",
+						Computed:            true,
+					},
+					"total_blocks": schema.Int64Attribute{
+						Description:         "The total number of physical blocks of the cluster.",
+						MarkdownDescription: "The total number of physical blocks of the cluster.",
+						Computed:            true,
+					},
+					"used_blocks": schema.Int64Attribute{
+						Description:         "The number of physical blocks used on the cluster.",
+						MarkdownDescription: "The number of physical blocks used on the cluster.",
+						Computed:            true,
+					},
+					"logical_saved_bytes": schema.Int64Attribute{
+						Description:         "The number of logical bytes saved by deduplication.",
+						MarkdownDescription: "The number of logical bytes saved by deduplication.",
+						Computed:            true,
+					},
+					"estimated_physical_saved_bytes": schema.Int64Attribute{
+						Description:         "The number of physical bytes estimated to be saved by deduplication.",
+						MarkdownDescription: "The number of physical bytes estimated to be saved by deduplication.",
+						Computed:            true,
+					},
+				},
+			},
+			"dedupe_reports": schema.ListNestedAttribute{
+				Description:         "List of dedupe reports.",
+				MarkdownDescription: "List of dedupe reports.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "The system ID given to the report.",
+							MarkdownDescription: "The system ID given to the report.",
+							Computed:            true,
+						},
+						"job_id": schema.Int64Attribute{
+							Description:         "The ID of the job that generated the report.",
+							MarkdownDescription: "The ID of the job that generated the report.",
+							Computed:            true,
+						},
+						"job_type": schema.StringAttribute{
+							Description:         "The type of the job that generated the report, Dedupe or DedupeAssessment.",
+							MarkdownDescription: "The type of the job that generated the report, Dedupe or DedupeAssessment.",
+							Computed:            true,
+						},
+						"start_time": schema.Int64Attribute{
+							Description:         "The time the job started, in unix epoch seconds.",
+							MarkdownDescription: "The time the job started, in unix epoch seconds.",
+							Computed:            true,
+						},
+						"end_time": schema.Int64Attribute{
+							Description:         "The time the job ended, in unix epoch seconds.",
+							MarkdownDescription: "The time the job ended, in unix epoch seconds.",
+							Computed:            true,
+						},
+						"scanned_blocks": schema.Int64Attribute{
+							Description:         "The number of blocks scanned by the job.",
+							MarkdownDescription: "The number of blocks scanned by the job.",
+							Computed:            true,
+						},
+						"sampled_blocks": schema.Int64Attribute{
+							Description:         "The number of blocks sampled by the job.",
+							MarkdownDescription: "The number of blocks sampled by the job.",
+							Computed:            true,
+						},
+						"deduped_blocks": schema.Int64Attribute{
+							Description:         "The number of blocks deduplicated by a Dedupe job, or estimated to be deduplicated by a DedupeAssessment job.",
+							MarkdownDescription: "The number of blocks deduplicated by a Dedupe job, or estimated to be deduplicated by a DedupeAssessment job.",
+							Computed:            true,
+						},
+						"dedupe_percent": schema.Float64Attribute{
+							Description:         "The percentage of the scanned blocks which are deduplicated, or estimated to be deduplicated by a DedupeAssessment job.",
+							MarkdownDescription: "The percentage of the scanned blocks which are deduplicated, or estimated to be deduplicated by a DedupeAssessment job.",
+							Computed:            true,
+						},
+						"saved_bytes": schema.Int64Attribute{
+							Description:         "The bytes saved by a Dedupe job, or estimated to be saved by a DedupeAssessment job, based on the deduped blocks and the block size.",
+							MarkdownDescription: "The bytes saved by a Dedupe job, or estimated to be saved by a DedupeAssessment job, based on the deduped blocks and the block size.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"job_ids": schema.SetAttribute{
+						Description:         "Only list the dedupe reports of these jobs.",
+						MarkdownDescription: "Only list the dedupe reports of these jobs.",
+						Optional:            true,
+						ElementType:         types.Int64Type,
+					},
+					"job_type": schema.StringAttribute{
+						Description:         "Only list the dedupe reports of this job type. Acceptable values: Dedupe, DedupeAssessment.",
+						MarkdownDescription: "Only list the dedupe reports of this job type. Acceptable values: Dedupe, DedupeAssessment.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("Dedupe", "DedupeAssessment"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *DedupeReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *DedupeReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading dedupe report data source")
+
+	var state models.DedupeReportDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.ManageDataSourceDedupeReport(ctx, d.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading dedupe report data source ")
+}
@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDedupeReportDataSource(t *testing.T) {
+	var dedupeReport = "data.powerscale_dedupe_report.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + dedupeReportAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedupeReport, "id", "dedupe_report_datasource"),
+					resource.TestCheckResourceAttrSet(dedupeReport, "summary.block_size"),
+					resource.TestCheckResourceAttrSet(dedupeReport, "summary.logical_saved_bytes"),
+					resource.TestCheckResourceAttrSet(dedupeReport, "summary.estimated_physical_saved_bytes"),
+					resource.TestCheckResourceAttrSet(dedupeReport, "dedupe_reports.#"),
+				),
+			},
+			{
+				Config: ProviderConfig + dedupeReportFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dedupeReport, "dedupe_reports.#"),
+				),
+			},
+			{
+				Config: ProviderConfig + dedupeReportFilterJobIDDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedupeReport, "dedupe_reports.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDedupeReportDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + dedupeReportInvalidFilterDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetDedupeSummary).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeReportAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.ListDedupeReports).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeReportAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.CopyFields).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeReportAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + dedupeReportAllDataSourceConfig,
+			},
+		},
+	})
+}
+
+func TestAccDedupeReportDataSourceResults(t *testing.T) {
+	var dedupeReport = "data.powerscale_dedupe_report.test"
+	var report powerscale.V1DedupeReport
+	report.SetId("1")
+	report.SetJobId(5)
+	report.SetJobType("DedupeAssessment")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListDedupeReports).Return([]powerscale.V1DedupeReport{report}, nil).Build()
+					FunctionMocker2 = mockey.Mock(helper.GetDedupeReportCounters).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeReportAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker2.Release()
+					FunctionMocker2 = mockey.Mock(helper.GetDedupeReportCounters).Return(map[string]string{
+						"scanned_blocks": "1,000",
+						"deduped_blocks": "100",
+						"dedupe_percent": "10.5",
+					}, nil).Build()
+				},
+				Config: ProviderConfig + dedupeReportAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedupeReport, "dedupe_reports.#", "1"),
+					resource.TestCheckResourceAttr(dedupeReport, "dedupe_reports.0.job_type", "DedupeAssessment"),
+					resource.TestCheckResourceAttr(dedupeReport, "dedupe_reports.0.scanned_blocks", "1000"),
+					resource.TestCheckResourceAttr(dedupeReport, "dedupe_reports.0.deduped_blocks", "100"),
+					resource.TestCheckResourceAttr(dedupeReport, "dedupe_reports.0.dedupe_percent", "10.5"),
+					resource.TestCheckResourceAttrSet(dedupeReport, "dedupe_reports.0.saved_bytes"),
+					resource.TestCheckNoResourceAttr(dedupeReport, "dedupe_reports.0.sampled_blocks"),
+				),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker2.Release()
+				},
+				Config: ProviderConfig + dedupeReportAllDataSourceConfig,
+			},
+		},
+	})
+}
+
+var dedupeReportAllDataSourceConfig = `
+data "powerscale_dedupe_report" "test" {
+}
+`
+
+var dedupeReportFilterDataSourceConfig = `
+data "powerscale_dedupe_report" "test" {
+	filter {
+		job_type = "DedupeAssessment"
+	}
+}
+`
+
+var dedupeReportFilterJobIDDataSourceConfig = `
+data "powerscale_dedupe_report" "test" {
+	filter {
+		job_ids = [999999]
+	}
+}
+`
+
+var dedupeReportInvalidFilterDataSourceConfig = `
+data "powerscale_dedupe_report" "test" {
+	filter {
+		job_type = "invalid"
+	}
+}
+`
@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DedupeSettingsResource{}
+	_ resource.ResourceWithConfigure   = &DedupeSettingsResource{}
+	_ resource.ResourceWithImportState = &DedupeSettingsResource{}
+)
+
+// NewDedupeSettingsResource creates a new resource.
+func NewDedupeSettingsResource() resource.Resource {
+	return &DedupeSettingsResource{}
+}
+
+// DedupeSettingsResource defines the resource implementation.
+type DedupeSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *DedupeSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedupe_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *DedupeSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `This resource is used to manage the SmartDedupe Settings of PowerScale Array. We can Create, Update and Delete the Dedupe Settings using this resource. A Dedupe or DedupeAssessment job can be started whenever the settings change.  
+Note that, Dedupe Settings is the native functionality of PowerScale. When creating the resource, we actually load Dedupe Settings from PowerScale to the resource.  
+The dedupe settings of PowerScale have no exclusions: a path is deduplicated along with all its subdirectories, so a directory is excluded by listing only its siblings in paths.`,
+		Description: `This resource is used to manage the SmartDedupe Settings of PowerScale Array. We can Create, Update and Delete the Dedupe Settings using this resource. A Dedupe or DedupeAssessment job can be started whenever the settings change.  
+Note that, Dedupe Settings is the native functionality of PowerScale. When creating the resource, we actually load Dedupe Settings from PowerScale to the resource.  
+The dedupe settings of PowerScale have no exclusions: a path is deduplicated along with all its subdirectories, so a directory is excluded by listing only its siblings in paths.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of Dedupe settings. Readonly. ",
+				MarkdownDescription: "Id of Dedupe settings. Readonly. ",
+			},
+			"assess_paths": schema.ListAttribute{
+				Description:         "The paths that will be assessed by the DedupeAssessment job.",
+				MarkdownDescription: "The paths that will be assessed by the DedupeAssessment job.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"paths": schema.ListAttribute{
+				Description:         "The paths that will be deduplicated by the Dedupe job.",
+				MarkdownDescription: "The paths that will be deduplicated by the Dedupe job.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"job_type": schema.StringAttribute{
+				Description: "The job to start when the settings are created, when `paths` or `assess_paths` change, or when the job type changes. " +
+					"Acceptable values: Dedupe, DedupeAssessment. No job is started if not set.",
+				MarkdownDescription: "The job to start when the settings are created, when `paths` or `assess_paths` change, or when the job type changes. " +
+					"Acceptable values: Dedupe, DedupeAssessment. No job is started if not set.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Dedupe", "DedupeAssessment"),
+				},
+			},
+			"job_id": schema.Int64Attribute{
+				Description:         "The ID of the job started when the settings last changed.",
+				MarkdownDescription: "The ID of the job started when the settings last changed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *DedupeSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *DedupeSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Dedupe Settings resource...")
+
+	var plan models.DedupeSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageDedupeSettings(ctx, r.client, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if state == nil {
+		return
+	}
+
+	// Save updated data into Terraform state, also when the job could not be started after the settings were applied
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Done with Create dedupe settings resource")
+}
+
+// Read reads the resource state.
+func (r *DedupeSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading Dedupe Settings resource")
+
+	var state models.DedupeSettingsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := helper.GetDedupeSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadDedupeSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading dedupe settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of dedupe settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("dedupe_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read dedupe settings resource")
+}
+
+// Update updates the resource state.
+func (r *DedupeSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Dedupe Settings resource...")
+
+	var plan models.DedupeSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior models.DedupeSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageDedupeSettings(ctx, r.client, plan, &prior)
+	resp.Diagnostics.Append(diags...)
+	if state == nil {
+		return
+	}
+
+	// Save updated data into Terraform state, also when the job could not be started after the settings were applied
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Done with Update dedupe settings resource")
+}
+
+// Delete deletes the resource.
+func (r *DedupeSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Dedupe Settings resource")
+	var state models.DedupeSettingsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Dedupe settings is the native functionality that cannot be deleted, so just remove state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete dedupe settings resource")
+}
+
+// ImportState imports the resource state.
+func (r *DedupeSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Dedupe Settings resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,277 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDedupeSettingsResource(t *testing.T) {
+	var dedupeSettings = "powerscale_dedupe_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + dedupeSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedupeSettings, "id", "dedupe_settings"),
+					resource.TestCheckResourceAttr(dedupeSettings, "paths.#", "1"),
+					resource.TestCheckResourceAttr(dedupeSettings, "paths.0", "/ifs/tfacc_dedupe"),
+					resource.TestCheckResourceAttr(dedupeSettings, "assess_paths.#", "1"),
+					resource.TestCheckNoResourceAttr(dedupeSettings, "job_id"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName: dedupeSettings,
+				ImportState:  true,
+				ExpectError:  nil,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					resource.TestCheckResourceAttrSet(dedupeSettings, "id")
+					resource.TestCheckResourceAttrSet(dedupeSettings, "paths.#")
+					resource.TestCheckResourceAttrSet(dedupeSettings, "assess_paths.#")
+					return nil
+				},
+			},
+			// Update and start an assessment
+			{
+				Config: ProviderConfig + dedupeSettingsUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedupeSettings, "paths.#", "0"),
+					resource.TestCheckResourceAttr(dedupeSettings, "assess_paths.#", "0"),
+					resource.TestCheckResourceAttr(dedupeSettings, "job_type", "DedupeAssessment"),
+					resource.TestCheckResourceAttrSet(dedupeSettings, "job_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDedupeSettingsResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + dedupeSettingsInvalidJobConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetDedupeSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateDedupeSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.StartDedupeJob).Return(0, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + dedupeSettingsResourceConfig,
+			},
+			// Read error
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetDedupeSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + dedupeSettingsRevertResourceConfig,
+			},
+		},
+	})
+}
+
+func TestAccDedupeSettingsResourceJobStartErr(t *testing.T) {
+	var dedupeSettings = "powerscale_dedupe_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.StartDedupeJob).Return(0, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + dedupeSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// the applied settings are kept in state, and the job is started by the next apply
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + dedupeSettingsUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedupeSettings, "paths.#", "0"),
+					resource.TestCheckResourceAttr(dedupeSettings, "job_type", "DedupeAssessment"),
+					resource.TestCheckResourceAttrSet(dedupeSettings, "job_id"),
+				),
+			},
+			{
+				Config: ProviderConfig + dedupeSettingsRevertResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(dedupeSettings, "job_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestManageDedupeSettingsStartJob(t *testing.T) {
+	updateMocker := mockey.Mock(helper.UpdateDedupeSettings).Return(nil).Build()
+	defer updateMocker.Release()
+	getMocker := mockey.Mock(helper.GetDedupeSettings).Return(&powerscale.V1DedupeSettings{}, nil).Build()
+	defer getMocker.Release()
+	copyMocker := mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(nil).Build()
+	defer copyMocker.Release()
+	jobMocker := mockey.Mock(helper.StartDedupeJob).Return(int32(7), nil).Build()
+
+	paths := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("/ifs/tfacc_dedupe")})
+	plan := models.DedupeSettingsModel{
+		Paths:       paths,
+		AssessPaths: types.ListValueMust(types.StringType, []attr.Value{}),
+		JobType:     types.StringValue("DedupeAssessment"),
+		JobID:       types.Int64Unknown(),
+	}
+
+	// create starts the job
+	state, diags := helper.ManageDedupeSettings(context.Background(), nil, plan, nil)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.Int64Value(7), state.JobID)
+	assert.Equal(t, 1, jobMocker.Times())
+
+	// unchanged settings and job type keep the prior job
+	prior := *state
+	prior.JobID = types.Int64Value(3)
+	state, diags = helper.ManageDedupeSettings(context.Background(), nil, plan, &prior)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.Int64Value(3), state.JobID)
+	assert.Equal(t, 1, jobMocker.Times())
+
+	// changed settings start a new job
+	prior.Paths = types.ListValueMust(types.StringType, []attr.Value{})
+	state, diags = helper.ManageDedupeSettings(context.Background(), nil, plan, &prior)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.Int64Value(7), state.JobID)
+	assert.Equal(t, 2, jobMocker.Times())
+
+	// the applied state is returned along with the error, keeping the prior job type to start the job again
+	jobMocker.Release()
+	jobErrMocker := mockey.Mock(helper.StartDedupeJob).Return(int32(0), fmt.Errorf("mock error")).Build()
+	defer jobErrMocker.Release()
+	prior.JobType = types.StringValue("Dedupe")
+	state, diags = helper.ManageDedupeSettings(context.Background(), nil, plan, &prior)
+	assert.True(t, diags.HasError())
+	assert.NotNil(t, state)
+	assert.Equal(t, paths, state.Paths)
+	assert.Equal(t, types.StringValue("Dedupe"), state.JobType)
+	assert.Equal(t, types.Int64Value(3), state.JobID)
+}
+
+var dedupeSettingsResourceConfig = `
+resource "powerscale_dedupe_settings" "test" {
+	paths        = ["/ifs/tfacc_dedupe"]
+	assess_paths = ["/ifs/tfacc_dedupe"]
+}
+`
+
+var dedupeSettingsUpdateResourceConfig = `
+resource "powerscale_dedupe_settings" "test" {
+	paths        = []
+	assess_paths = []
+	job_type     = "DedupeAssessment"
+}
+`
+
+var dedupeSettingsRevertResourceConfig = `
+resource "powerscale_dedupe_settings" "test" {
+	paths        = []
+	assess_paths = []
+}
+`
+
+var dedupeSettingsInvalidJobConfig = `
+resource "powerscale_dedupe_settings" "test" {
+	job_type = "invalid"
+}
+`
@@ -0,0 +1,288 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &FilePoolPolicyPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &FilePoolPolicyPreviewDataSource{}
+)
+
+// NewFilePoolPolicyPreviewDataSource creates a new data source.
+func NewFilePoolPolicyPreviewDataSource() datasource.DataSource {
+	return &FilePoolPolicyPreviewDataSource{}
+}
+
+// FilePoolPolicyPreviewDataSource defines the data source implementation.
+type FilePoolPolicyPreviewDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *FilePoolPolicyPreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_filepool_policy_preview"
+}
+
+// Schema describes the data source arguments.
+func (d *FilePoolPolicyPreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to preview the effect of a File Pool Policy on PowerScale array. It evaluates an existing policy, or a candidate file matching pattern, against a directory subtree and reports how many files and bytes would match and which storage pool they would land on. The subtree is sampled through the namespace API up to the configured limits. Criteria of type custom_attribute cannot be previewed, and only files (not directories) are evaluated.",
+		Description:         "This datasource is used to preview the effect of a File Pool Policy on PowerScale array. It evaluates an existing policy, or a candidate file matching pattern, against a directory subtree and reports how many files and bytes would match and which storage pool they would land on. The subtree is sampled through the namespace API up to the configured limits. Criteria of type custom_attribute cannot be previewed, and only files (not directories) are evaluated.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the file pool policy preview instance.",
+				Description:         "Unique identifier of the file pool policy preview instance.",
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Directory subtree to evaluate, e.g. /ifs/data.",
+				Description:         "Directory subtree to evaluate, e.g. /ifs/data.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"policy_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of an existing File Pool Policy to evaluate. Conflicts with file_matching_pattern.",
+				Description:         "Name of an existing File Pool Policy to evaluate. Conflicts with file_matching_pattern.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("file_matching_pattern")),
+				},
+			},
+			"file_matching_pattern": schema.SingleNestedAttribute{
+				Description:         "Candidate file matching rules to evaluate, using the same format as the File Pool Policy resource. Conflicts with policy_name.",
+				MarkdownDescription: "Candidate file matching rules to evaluate, using the same format as the File Pool Policy resource. Conflicts with policy_name.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"or_criteria": schema.ListNestedAttribute{
+						Description:         "List of or_criteria file matching rules for this policy.",
+						MarkdownDescription: "List of or_criteria file matching rules for this policy.",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"and_criteria": schema.ListNestedAttribute{
+									Description:         "List of and_criteria file matching rules for this policy.",
+									MarkdownDescription: "List of and_criteria file matching rules for this policy.",
+									Required:            true,
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"value": schema.StringAttribute{
+												Description:         "The value to be compared against a file attribute.",
+												MarkdownDescription: "The value to be compared against a file attribute.",
+												Optional:            true,
+											},
+											"units": schema.StringAttribute{
+												Description:         "Size unit value. One of 'B','KB','MB','GB','TB','PB','EB' (valid only with 'type' = 'size').",
+												MarkdownDescription: "Size unit value. One of 'B','KB','MB','GB','TB','PB','EB' (valid only with 'type' = 'size').",
+												Optional:            true,
+												Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+											},
+											"type": schema.StringAttribute{
+												Description:         "The file attribute to be compared to a given value.",
+												MarkdownDescription: "The file attribute to be compared to a given value.",
+												Required:            true,
+											},
+											"operator": schema.StringAttribute{
+												Description:         "The comparison operator to use while comparing an attribute with its value.",
+												MarkdownDescription: "The comparison operator to use while comparing an attribute with its value.",
+												Optional:            true,
+											},
+											"field": schema.StringAttribute{
+												Description:         "File attribute field name to be compared in a custom comparison (valid only with 'type' = 'custom_attribute').",
+												MarkdownDescription: "File attribute field name to be compared in a custom comparison (valid only with 'type' = 'custom_attribute').",
+												Optional:            true,
+											},
+											"use_relative_time": schema.BoolAttribute{
+												Description:         "Whether time units refer to a calendar date and time (e.g., Jun 3, 2009) or a relative duration (e.g., 2 weeks) (valid only with 'type' in {accessed_time, birth_time, changed_time or metadata_changed_time}.",
+												MarkdownDescription: "Whether time units refer to a calendar date and time (e.g., Jun 3, 2009) or a relative duration (e.g., 2 weeks) (valid only with 'type' in {accessed_time, birth_time, changed_time or metadata_changed_time}.",
+												Optional:            true,
+											},
+											"case_sensitive": schema.BoolAttribute{
+												Description:         "True to indicate case sensitivity when comparing file attributes (valid only with 'type' = 'name' or 'type' = 'path').",
+												MarkdownDescription: "True to indicate case sensitivity when comparing file attributes (valid only with 'type' = 'name' or 'type' = 'path').",
+												Optional:            true,
+											},
+											"begins_with": schema.BoolAttribute{
+												Description:         "True to match the path exactly, False to match any subtree. (valid only with 'type' = 'path').",
+												MarkdownDescription: "True to match the path exactly, False to match any subtree. (valid only with 'type' = 'path').",
+												Optional:            true,
+											},
+											"attribute_exists": schema.BoolAttribute{
+												Description:         "Indicates whether the existence of an attribute indicates a match (valid only with 'type' = 'custom_attribute').",
+												MarkdownDescription: "Indicates whether the existence of an attribute indicates a match (valid only with 'type' = 'custom_attribute').",
+												Optional:            true,
+											},
+										},
+									},
+									Validators: []validator.List{
+										listvalidator.UniqueValues(),
+										listvalidator.SizeBetween(1, 5),
+									},
+								},
+							},
+						},
+						Validators: []validator.List{
+							listvalidator.UniqueValues(),
+							listvalidator.SizeBetween(1, 3),
+						},
+					},
+				},
+			},
+			"matched_storage_pool": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Storage pool the matching files would land on. Taken from the apply_data_storage_policy action of the evaluated policy; may be set for a candidate file_matching_pattern. Defaults to the storage pool of the default policy.",
+				Description:         "Storage pool the matching files would land on. Taken from the apply_data_storage_policy action of the evaluated policy; may be set for a candidate file_matching_pattern. Defaults to the storage pool of the default policy.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("policy_name")),
+				},
+			},
+			"default_storage_pool": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Storage pool of the default policy, where files that do not match would land.",
+				Description:         "Storage pool of the default policy, where files that do not match would land.",
+			},
+			"max_files": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of files to scan. Defaults to 10000.",
+				Description:         "Maximum number of files to scan. Defaults to 10000.",
+				Validators:          []validator.Int64{int64validator.Between(1, 1000000)},
+			},
+			"max_directories": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of directories to list, including path. Defaults to 1000.",
+				Description:         "Maximum number of directories to list, including path. Defaults to 1000.",
+				Validators:          []validator.Int64{int64validator.Between(1, 100000)},
+			},
+			"max_depth": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum directory depth to descend below path. 0 scans only the files directly in path. Unlimited if not set.",
+				Description:         "Maximum directory depth to descend below path. 0 scans only the files directly in path. Unlimited if not set.",
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"sample_size": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of matching files to return in sample_matches. Defaults to 10.",
+				Description:         "Maximum number of matching files to return in sample_matches. Defaults to 10.",
+				Validators:          []validator.Int64{int64validator.Between(0, 1000)},
+			},
+			"scanned_files": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of files scanned.",
+				Description:         "Number of files scanned.",
+			},
+			"matched_files": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of scanned files matching the pattern.",
+				Description:         "Number of scanned files matching the pattern.",
+			},
+			"matched_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total size in bytes of the matching files.",
+				Description:         "Total size in bytes of the matching files.",
+			},
+			"truncated": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the scan stopped at max_files or max_directories before the whole subtree was evaluated.",
+				Description:         "Whether the scan stopped at max_files or max_directories before the whole subtree was evaluated.",
+			},
+			"sample_matches": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sample of the matching files.",
+				Description:         "Sample of the matching files.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Full path of the file.",
+							Description:         "Full path of the file.",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size of the file in bytes.",
+							Description:         "Size of the file in bytes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *FilePoolPolicyPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *FilePoolPolicyPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading File Pool Policy Preview data source ")
+
+	var state models.FilePoolPolicyPreviewDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.PreviewFilePoolPolicy(ctx, d.client, &state); err != nil {
+		errStr := constants.ReadFilePoolPolicyPreviewErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error previewing File Pool Policy", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read File Pool Policy Preview data source ")
+}
@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFilePoolPolicyPreviewDataSource(t *testing.T) {
+	var previewTerraformName = "data.powerscale_filepool_policy_preview.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// preview an existing policy
+			{
+				Config: ProviderConfig + filePoolPolicyPreviewResourceConfig + filePoolPolicyPreviewPolicyDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(previewTerraformName, "id", "filepool_policy_preview_datasource"),
+					resource.TestCheckResourceAttr(previewTerraformName, "matched_storage_pool", "anywhere"),
+					resource.TestCheckResourceAttrSet(previewTerraformName, "default_storage_pool"),
+					resource.TestCheckResourceAttrSet(previewTerraformName, "scanned_files"),
+					resource.TestCheckResourceAttrSet(previewTerraformName, "matched_files"),
+					resource.TestCheckResourceAttrSet(previewTerraformName, "matched_bytes"),
+					resource.TestCheckResourceAttrSet(previewTerraformName, "truncated"),
+				),
+			},
+			// preview a candidate pattern
+			{
+				Config: ProviderConfig + filePoolPolicyPreviewPatternDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(previewTerraformName, "matched_storage_pool", "anywhere"),
+					resource.TestCheckResourceAttrSet(previewTerraformName, "scanned_files"),
+					resource.TestCheckResourceAttrSet(previewTerraformName, "sample_matches.#"),
+				),
+			},
+			// the scan stops after max_directories
+			{
+				Config: ProviderConfig + filePoolPolicyPreviewMaxDirectoriesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(previewTerraformName, "truncated", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFilePoolPolicyPreviewDataSourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// neither policy_name nor file_matching_pattern
+			{
+				Config:      ProviderConfig + filePoolPolicyPreviewMissingDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			// custom_attribute criteria cannot be evaluated
+			{
+				Config:      ProviderConfig + filePoolPolicyPreviewCustomAttrDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*cannot be previewed*.`),
+			},
+			// non integral size value
+			{
+				Config:      ProviderConfig + filePoolPolicyPreviewInvalidValueDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*input value should be an integral string*.`),
+			},
+			// unknown policy
+			{
+				Config:      ProviderConfig + filePoolPolicyPreviewInvalidPolicyDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*Error previewing File Pool Policy*.`),
+			},
+		},
+	})
+}
+
+func TestAccFilePoolPolicyPreviewDataSourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetFilePoolDefaultPolicy).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + filePoolPolicyPreviewPatternDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock((*powerscale.NamespaceApiService).GetDirectoryContentsExecute).Return(nil, nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + filePoolPolicyPreviewPatternDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.PreviewFilePoolPolicy).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + filePoolPolicyPreviewPatternDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var filePoolPolicyPreviewResourceConfig = `
+resource "powerscale_filepool_policy" "preview_test" {
+  name = "tfacc_filePoolPolicy_preview"
+  file_matching_pattern = {
+    or_criteria = [
+      {
+        and_criteria = [
+          {
+            operator = ">"
+            type = "size"
+            units = "KB"
+            value = "1"
+          }
+        ]
+      }
+    ]
+  }
+  actions = [
+    {
+      data_storage_policy_action = {
+        ssd_strategy = "metadata"
+        storagepool = "anywhere"
+      }
+      action_type = "apply_data_storage_policy"
+    }
+  ]
+}
+`
+
+var filePoolPolicyPreviewPolicyDataSourceConfig = `
+data "powerscale_filepool_policy_preview" "test" {
+  path        = "/ifs"
+  policy_name = powerscale_filepool_policy.preview_test.name
+  max_files   = 100
+  max_depth   = 1
+}
+`
+
+var filePoolPolicyPreviewPatternDataSourceConfig = `
+data "powerscale_filepool_policy_preview" "test" {
+  path = "/ifs"
+  file_matching_pattern = {
+    or_criteria = [
+      {
+        and_criteria = [
+          {
+            operator = "=="
+            type = "file_type"
+            value = "file"
+          }
+        ]
+      }
+    ]
+  }
+  matched_storage_pool = "anywhere"
+  max_files            = 100
+  max_depth            = 1
+  sample_size          = 5
+}
+`
+
+var filePoolPolicyPreviewMaxDirectoriesDataSourceConfig = `
+data "powerscale_filepool_policy_preview" "test" {
+  path = "/ifs"
+  file_matching_pattern = {
+    or_criteria = [
+      {
+        and_criteria = [
+          {
+            operator = "=="
+            type = "file_type"
+            value = "file"
+          }
+        ]
+      }
+    ]
+  }
+  max_directories = 1
+}
+`
+
+var filePoolPolicyPreviewMissingDataSourceConfig = `
+data "powerscale_filepool_policy_preview" "test" {
+  path = "/ifs"
+}
+`
+
+var filePoolPolicyPreviewCustomAttrDataSourceConfig = `
+data "powerscale_filepool_policy_preview" "test" {
+  path = "/ifs"
+  file_matching_pattern = {
+    or_criteria = [
+      {
+        and_criteria = [
+          {
+            attribute_exists = false
+            field = "test"
+            type = "custom_attribute"
+            value = ""
+          }
+        ]
+      }
+    ]
+  }
+  max_files = 100
+}
+`
+
+var filePoolPolicyPreviewInvalidValueDataSourceConfig = `
+data "powerscale_filepool_policy_preview" "test" {
+  path = "/ifs"
+  file_matching_pattern = {
+    or_criteria = [
+      {
+        and_criteria = [
+          {
+            operator = ">"
+            type = "size"
+            value = "large"
+          }
+        ]
+      }
+    ]
+  }
+}
+`
+
+var filePoolPolicyPreviewInvalidPolicyDataSourceConfig = `
+data "powerscale_filepool_policy_preview" "test" {
+  path        = "/ifs"
+  policy_name = "tfacc_invalid_policy"
+}
+`
@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IdentityDataSource{}
+
+// NewIdentityDataSource creates a new data source.
+func NewIdentityDataSource() datasource.DataSource {
+	return &IdentityDataSource{}
+}
+
+// IdentityDataSource defines the data source implementation.
+type IdentityDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *IdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity"
+}
+
+// Schema describes the data source arguments.
+func (d *IdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	personaAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Specifies the serialized form of a persona, which can be 'UID:0', 'GID:0', or 'SID:S-1-1'.",
+			MarkdownDescription: "Specifies the serialized form of a persona, which can be 'UID:0', 'GID:0', or 'SID:S-1-1'.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Specifies the persona name.",
+			MarkdownDescription: "Specifies the persona name.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			Description:         "Specifies the type of persona.",
+			MarkdownDescription: "Specifies the type of persona.",
+			Computed:            true,
+		},
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to resolve user or group names, e.g. DOMAIN\\user, to their SID, UID and GID, group memberships and mapped identities across the authentication providers of an access zone on PowerScale array. The information fetched from this datasource can be used for referencing stable persona IDs in resource blocks.",
+		Description:         "This datasource is used to resolve user or group names, e.g. DOMAIN\\user, to their SID, UID and GID, group memberships and mapped identities across the authentication providers of an access zone on PowerScale array. The information fetched from this datasource can be used for referencing stable persona IDs in resource blocks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Identity datasource.",
+				MarkdownDescription: "Identifier of the Identity datasource.",
+				Computed:            true,
+			},
+			"identities_details": schema.ListNestedAttribute{
+				Description:         "List of resolved identities. A name matching users or groups in several authentication providers resolves to all of them.",
+				MarkdownDescription: "List of resolved identities. A name matching users or groups in several authentication providers resolves to all of them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"lookup": schema.StringAttribute{
+							Description:         "Specifies the name which is looked up.",
+							MarkdownDescription: "Specifies the name which is looked up.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "Specifies the persona name of the identity.",
+							MarkdownDescription: "Specifies the persona name of the identity.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Specifies the type of the identity, user or group.",
+							MarkdownDescription: "Specifies the type of the identity, user or group.",
+							Computed:            true,
+						},
+						"provider": schema.StringAttribute{
+							Description:         "Specifies the authentication provider that the identity belongs to.",
+							MarkdownDescription: "Specifies the authentication provider that the identity belongs to.",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							Description:         "Specifies the domain that the identity belongs to.",
+							MarkdownDescription: "Specifies the domain that the identity belongs to.",
+							Computed:            true,
+						},
+						"sid": schema.StringAttribute{
+							Description:         "Specifies the security identifier of the identity, e.g. SID:S-1-5-21-1-2-3-1000.",
+							MarkdownDescription: "Specifies the security identifier of the identity, e.g. SID:S-1-5-21-1-2-3-1000.",
+							Computed:            true,
+						},
+						"uid": schema.StringAttribute{
+							Description:         "Specifies the user ID, e.g. UID:1000. Only for users.",
+							MarkdownDescription: "Specifies the user ID, e.g. UID:1000. Only for users.",
+							Computed:            true,
+						},
+						"gid": schema.StringAttribute{
+							Description:         "Specifies the group ID of groups, or the primary group ID of users, e.g. GID:1000.",
+							MarkdownDescription: "Specifies the group ID of groups, or the primary group ID of users, e.g. GID:1000.",
+							Computed:            true,
+						},
+						"primary_group_sid": schema.StringAttribute{
+							Description:         "Specifies the primary group SID. Only for users.",
+							MarkdownDescription: "Specifies the primary group SID. Only for users.",
+							Computed:            true,
+						},
+						"member_of": schema.ListNestedAttribute{
+							Description:         "Specifies the groups that the identity is member of.",
+							MarkdownDescription: "Specifies the groups that the identity is member of.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: personaAttributes,
+							},
+						},
+						"mapped_identities": schema.ListNestedAttribute{
+							Description:         "Specifies the identities mapped to the identity across providers.",
+							MarkdownDescription: "Specifies the identities mapped to the identity across providers.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description:         "Specifies the mapped persona ID.",
+										MarkdownDescription: "Specifies the mapped persona ID.",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										Description:         "Specifies the mapped persona name.",
+										MarkdownDescription: "Specifies the mapped persona name.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										Description:         "Specifies the mapped persona type.",
+										MarkdownDescription: "Specifies the mapped persona type.",
+										Computed:            true,
+									},
+									"on_disk": schema.BoolAttribute{
+										Description:         "Whether the mapped identity is used as the on-disk identity.",
+										MarkdownDescription: "Whether the mapped identity is used as the on-disk identity.",
+										Computed:            true,
+									},
+									"mapping_type": schema.StringAttribute{
+										Description:         "Specifies how the mapping was created, e.g. auto, external or manual.",
+										MarkdownDescription: "Specifies how the mapping was created, e.g. auto, external or manual.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Description:         "Names of the users or groups to resolve, e.g. DOMAIN\\user or user@domain.",
+						MarkdownDescription: "Names of the users or groups to resolve, e.g. DOMAIN\\user or user@domain.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"zone": schema.StringAttribute{
+						Description:         "The zone to resolve the identities in. Defaults to System.",
+						MarkdownDescription: "The zone to resolve the identities in. Defaults to System.",
+						Optional:            true,
+					},
+					"provider": schema.StringAttribute{
+						Description:         "Only resolve the identities from this authentication provider, e.g. lsa-activedirectory-provider:DOMAIN. Defaults to all providers of the zone.",
+						MarkdownDescription: "Only resolve the identities from this authentication provider, e.g. lsa-activedirectory-provider:DOMAIN. Defaults to all providers of the zone.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *IdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *IdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading identity data source")
+
+	var state models.IdentityDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.IdentityFilter == nil || len(state.IdentityFilter.Names) == 0 {
+		resp.Diagnostics.AddError(
+			"Error reading identity data source",
+			"At least one name should be provided in filter.names to resolve the identities.",
+		)
+		return
+	}
+
+	identities := []models.IdentityDetailModel{}
+	for _, name := range state.IdentityFilter.Names {
+		details, err := helper.GetIdentityDetails(ctx, d.client, name.ValueString(), *state.IdentityFilter)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error resolving the identity",
+				err.Error(),
+			)
+			return
+		}
+		identities = append(identities, details...)
+	}
+
+	state.Identities = identities
+	state.ID = types.StringValue("identity_datasource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading identity data source ")
+}
@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIdentityDataSource(t *testing.T) {
+	var identityTerraformName = "data.powerscale_identity.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Resolve user and group names
+			{
+				Config: ProviderConfig + IdentityDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(identityTerraformName, "id", "identity_datasource"),
+					resource.TestCheckResourceAttr(identityTerraformName, "identities_details.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(identityTerraformName, "identities_details.*", map[string]string{
+						"lookup": "admin",
+						"type":   "user",
+						"uid":    "UID:10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(identityTerraformName, "identities_details.*", map[string]string{
+						"lookup": "wheel",
+						"type":   "group",
+						"gid":    "GID:0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityDataSourceNoNamesErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + IdentityDataSourceNoNamesConfig,
+				ExpectError: regexp.MustCompile(`.*At least one name*.`),
+			},
+		},
+	})
+}
+
+func TestAccIdentityDataSourceNotFoundErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + IdentityDataSourceInvalidNameConfig,
+				ExpectError: regexp.MustCompile(`.*neither a user nor a group*.`),
+			},
+		},
+	})
+}
+
+func TestAccIdentityDataSourceLookupErr(t *testing.T) {
+	var identityTerraformName = "data.powerscale_identity.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// errors other than not found are returned
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetIdentityUser).Return(nil, &http.Response{StatusCode: http.StatusInternalServerError}, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + IdentityDataSourceGroupConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetIdentityGroup).Return(nil, nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + IdentityDataSourceGroupConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// a name not found as user is still resolved as group
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetIdentityUser).Return(nil, &http.Response{StatusCode: http.StatusNotFound}, fmt.Errorf("mock error")).Build()
+				},
+				Config: ProviderConfig + IdentityDataSourceGroupConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(identityTerraformName, "identities_details.#", "1"),
+					resource.TestCheckResourceAttr(identityTerraformName, "identities_details.0.type", "group"),
+				),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + IdentityDataSourceGroupConfig,
+			},
+		},
+	})
+}
+
+func TestAccIdentityDataSourceMappingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAuthMappingIdentity).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + IdentityDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var IdentityDataSourceConfig = `
+data "powerscale_identity" "test" {
+	filter {
+		names = ["admin", "wheel"]
+		zone = "System"
+	}
+}
+`
+
+var IdentityDataSourceGroupConfig = `
+data "powerscale_identity" "test" {
+	filter {
+		names = ["wheel"]
+		zone = "System"
+	}
+}
+`
+
+var IdentityDataSourceNoNamesConfig = `
+data "powerscale_identity" "test" {
+	filter {
+		zone = "System"
+	}
+}
+`
+
+var IdentityDataSourceInvalidNameConfig = `
+data "powerscale_identity" "test" {
+	filter {
+		names = ["invalid_identity_name"]
+	}
+}
+`
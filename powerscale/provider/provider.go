@@ -210,6 +210,31 @@ func (p *PscaleProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewNfsAliasResource,
 		NewSyncIQReplicationJobResource,
 		NewStoragepoolTierResource,
+		NewAuthMappingResource,
+		NewAuthMappingActionResource,
+		NewRoleMemberResource,
+		NewUserGroupMembershipResource,
+		NewWormDomainResource,
+		NewWormFileResource,
+		NewSyncIQTargetPolicyActionResource,
+		NewSyncIQFailoverResource,
+		NewSyncIQPolicyActionResource,
+		NewSnapshotAliasResource,
+		NewSnapshotLockResource,
+		NewSnapshotChangelistResource,
+		NewSnapshotSettingsResource,
+		NewCloudAccountResource,
+		NewCloudPoolResource,
+		NewCloudSettingsResource,
+		NewCloudJobResource,
+		NewQuotaNotificationResource,
+		NewQuotaDefaultNotificationResource,
+		NewQuotaReportSettingsResource,
+		NewQuotaOverrideResource,
+		NewStoragepoolNodepoolResource,
+		NewStoragepoolCompatibilityResource,
+		NewSmartPoolsJobResource,
+		NewDedupeSettingsResource,
 	}
 }
 
@@ -258,6 +283,23 @@ func (p *PscaleProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewNfsAliasDataSource,
 		NewWritableSnapshotDataSource,
 		NewSyncIQReplicationJobDataSource,
+		NewAuthMappingDataSource,
+		NewIdentityDataSource,
+		NewAuthProviderStatusDataSource,
+		NewWormDomainDataSource,
+		NewSyncIQTargetPolicyDataSource,
+		NewSyncIQRPOStatusDataSource,
+		NewSnapshotAliasDataSource,
+		NewSnapshotLockDataSource,
+		NewSnapshotChangelistEntryDataSource,
+		NewSnapshotSettingsDataSource,
+		NewCloudAccountDataSource,
+		NewCloudPoolDataSource,
+		NewCloudSettingsDataSource,
+		NewQuotaReportDataSource,
+		NewFilePoolPolicyPreviewDataSource,
+		NewDedupeReportDataSource,
+		NewDataReductionStatsDataSource,
 	}
 }
 
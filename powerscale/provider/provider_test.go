@@ -66,6 +66,9 @@ var powerscaleNetworkpoolHigh = ""
 var powerscaleNetworkpoolLow = ""
 var powerscaleDNSSearch = ""
 var powerscaleDNSServer = ""
+var powerscaleCloudAccountURI = ""
+var powerscaleCloudAccountUsername = ""
+var powerscaleCloudAccountKey = ""
 
 var ProviderConfig = ""
 var SessionAuthProviderConfig = ""
@@ -124,6 +127,12 @@ func init() {
 	powerscaleDNSServer = os.Getenv("POWERSCALE_DNS_SERVER")
 	initGroupnetConfig()
 
+	// cloud account config
+	powerscaleCloudAccountURI = os.Getenv("POWERSCALE_CLOUD_ACCOUNT_URI")
+	powerscaleCloudAccountUsername = os.Getenv("POWERSCALE_CLOUD_ACCOUNT_USERNAME")
+	powerscaleCloudAccountKey = os.Getenv("POWERSCALE_CLOUD_ACCOUNT_KEY")
+	initCloudAccountConfig()
+
 	ProviderConfig = fmt.Sprintf(`
 		provider "powerscale" {
 			username      = "%s"
@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuotaDefaultNotificationResource{}
+var _ resource.ResourceWithConfigure = &QuotaDefaultNotificationResource{}
+var _ resource.ResourceWithImportState = &QuotaDefaultNotificationResource{}
+
+// NewQuotaDefaultNotificationResource creates a new resource.
+func NewQuotaDefaultNotificationResource() resource.Resource {
+	return &QuotaDefaultNotificationResource{}
+}
+
+// QuotaDefaultNotificationResource defines the resource implementation.
+type QuotaDefaultNotificationResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaDefaultNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_default_notification"
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaDefaultNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the global default default quota notification rules of PowerScale Array. " +
+			"The default rules apply to all the quotas whose notifications are not set to custom rules. " +
+			"We can Create, Update and Delete the default notification rules using this resource. We can also import an existing default notification rule from PowerScale array.",
+		Description: "This resource is used to manage the global default default quota notification rules of PowerScale Array. " +
+			"The default rules apply to all the quotas whose notifications are not set to custom rules. " +
+			"We can Create, Update and Delete the default notification rules using this resource. We can also import an existing default notification rule from PowerScale array.",
+		Attributes: quotaNotificationRuleAttributes(),
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaDefaultNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *QuotaDefaultNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating default quota notification rule")
+
+	var plan models.QuotaDefaultNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationID, err := helper.CreateQuotaDefaultNotification(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating default quota notification rule", err.Error())
+		return
+	}
+
+	notification, _, err := helper.GetQuotaDefaultNotification(ctx, r.client, notificationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating default quota notification rule", err.Error())
+		return
+	}
+	if err := helper.UpdateQuotaDefaultNotificationState(ctx, &plan, notification); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of default quota notification rule resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create default quota notification rule")
+}
+
+// Read reads the resource state.
+func (r *QuotaDefaultNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading default quota notification rule")
+
+	var state models.QuotaDefaultNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, httpResp, err := helper.GetQuotaDefaultNotification(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		// the rule has been deleted, so it has to be recreated
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("Default quota notification rule %s not found, removing it from state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading default quota notification rule", err.Error())
+		return
+	}
+	if err := helper.UpdateQuotaDefaultNotificationState(ctx, &state, notification); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of default quota notification rule resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read default quota notification rule")
+}
+
+// Update updates the resource state.
+func (r *QuotaDefaultNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating default quota notification rule")
+
+	var plan models.QuotaDefaultNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.QuotaDefaultNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateQuotaDefaultNotification(ctx, r.client, state, plan); err != nil {
+		resp.Diagnostics.AddError("Error updating default quota notification rule", err.Error())
+		return
+	}
+
+	notification, _, err := helper.GetQuotaDefaultNotification(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating default quota notification rule", err.Error())
+		return
+	}
+	if err := helper.UpdateQuotaDefaultNotificationState(ctx, &plan, notification); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of default quota notification rule resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update default quota notification rule")
+}
+
+// Delete deletes the resource.
+func (r *QuotaDefaultNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting default quota notification rule")
+
+	var state models.QuotaDefaultNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteQuotaDefaultNotification(ctx, r.client, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting default quota notification rule", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete default quota notification rule")
+}
+
+// ImportState imports the resource state by the default notification rule ID.
+func (r *QuotaDefaultNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing default quota notification rule")
+
+	notification, _, err := helper.GetQuotaDefaultNotification(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing default quota notification rule", err.Error())
+		return
+	}
+
+	var state models.QuotaDefaultNotificationResourceModel
+	if err := helper.UpdateQuotaDefaultNotificationState(ctx, &state, notification); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of default quota notification rule resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import default quota notification rule")
+}
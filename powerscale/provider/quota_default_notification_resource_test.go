@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccQuotaDefaultNotificationResource(t *testing.T) {
+	var quotaDefaultNotificationResourceName = "powerscale_quota_default_notification.default_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + QuotaDefaultNotificationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(quotaDefaultNotificationResourceName, "threshold", "soft"),
+					resource.TestCheckResourceAttr(quotaDefaultNotificationResourceName, "condition", "expired"),
+					resource.TestCheckResourceAttr(quotaDefaultNotificationResourceName, "schedule", "Every 1 days"),
+					resource.TestCheckResourceAttrSet(quotaDefaultNotificationResourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      quotaDefaultNotificationResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + QuotaDefaultNotificationUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(quotaDefaultNotificationResourceName, "schedule", "Every 2 days"),
+					resource.TestCheckResourceAttr(quotaDefaultNotificationResourceName, "action_email_owner", "true"),
+				),
+			},
+			// Changing the threshold or the condition recreates the rule
+			{
+				Config: ProviderConfig + QuotaDefaultNotificationReplaceResourceConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(quotaDefaultNotificationResourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(quotaDefaultNotificationResourceName, "threshold", "hard"),
+					resource.TestCheckResourceAttr(quotaDefaultNotificationResourceName, "condition", "violated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuotaDefaultNotificationResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateQuotaDefaultNotification).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaDefaultNotificationResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + QuotaDefaultNotificationResourceConfig,
+			},
+			// Update error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.UpdateQuotaDefaultNotification).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaDefaultNotificationUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Import error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetQuotaDefaultNotification).Return(nil, nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:        ProviderConfig + QuotaDefaultNotificationResourceConfig,
+				ResourceName:  "powerscale_quota_default_notification.default_test",
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile(`.*mock error*.`),
+			},
+			// Delete error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.DeleteQuotaDefaultNotification).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaDefaultNotificationResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + QuotaDefaultNotificationResourceConfig,
+			},
+		},
+	})
+}
+
+var QuotaDefaultNotificationResourceConfig = `
+resource "powerscale_quota_default_notification" "default_test" {
+	threshold = "soft"
+	condition = "expired"
+	action_alert = true
+	schedule = "Every 1 days"
+}
+`
+
+var QuotaDefaultNotificationUpdateResourceConfig = `
+resource "powerscale_quota_default_notification" "default_test" {
+	threshold = "soft"
+	condition = "expired"
+	action_alert = true
+	action_email_owner = true
+	schedule = "Every 2 days"
+}
+`
+
+var QuotaDefaultNotificationReplaceResourceConfig = `
+resource "powerscale_quota_default_notification" "default_test" {
+	threshold = "hard"
+	condition = "violated"
+	action_alert = true
+	action_email_owner = true
+	schedule = "Every 2 days"
+}
+`
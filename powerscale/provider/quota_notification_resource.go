@@ -0,0 +1,330 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuotaNotificationResource{}
+var _ resource.ResourceWithConfigure = &QuotaNotificationResource{}
+var _ resource.ResourceWithImportState = &QuotaNotificationResource{}
+
+// NewQuotaNotificationResource creates a new resource.
+func NewQuotaNotificationResource() resource.Resource {
+	return &QuotaNotificationResource{}
+}
+
+// QuotaNotificationResource defines the resource implementation.
+type QuotaNotificationResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_notification"
+}
+
+// quotaNotificationRuleAttributes returns the attributes shared by the quota and the default notification rules.
+func quotaNotificationRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the notification rule.",
+			MarkdownDescription: "The ID of the notification rule.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"threshold": schema.StringAttribute{
+			Description:         "The quota threshold the rule is about. Acceptable values: hard, soft, advisory. Cannot be updated, a change recreates the rule.",
+			MarkdownDescription: "The quota threshold the rule is about. Acceptable values: hard, soft, advisory. Cannot be updated, a change recreates the rule.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("hard", "soft", "advisory"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"condition": schema.StringAttribute{
+			Description:         "The condition of the threshold which triggers the rule. Acceptable values: exceeded, denied, violated, expired. Cannot be updated, a change recreates the rule.",
+			MarkdownDescription: "The condition of the threshold which triggers the rule. Acceptable values: exceeded, denied, violated, expired. Cannot be updated, a change recreates the rule.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("exceeded", "denied", "violated", "expired"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"action_alert": schema.BoolAttribute{
+			Description:         "Whether to send an alert when the rule is triggered.",
+			MarkdownDescription: "Whether to send an alert when the rule is triggered.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"action_email_owner": schema.BoolAttribute{
+			Description:         "Whether to send an email to the owner of the quota when the rule is triggered.",
+			MarkdownDescription: "Whether to send an email to the owner of the quota when the rule is triggered.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"action_email_address": schema.StringAttribute{
+			Description:         "The email address to send the notification to.",
+			MarkdownDescription: "The email address to send the notification to.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"email_template": schema.StringAttribute{
+			Description:         "The path of the template used for the email, the default template is used when empty.",
+			MarkdownDescription: "The path of the template used for the email, the default template is used when empty.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"schedule": schema.StringAttribute{
+			Description:         "The schedule of the notification, used by the violated and expired conditions, for example 'Every 1 days'.",
+			MarkdownDescription: "The schedule of the notification, used by the violated and expired conditions, for example 'Every 1 days'.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"holdoff": schema.Int64Attribute{
+			Description:         "Seconds between two notifications, used by the exceeded and denied conditions.",
+			MarkdownDescription: "Seconds between two notifications, used by the exceeded and denied conditions.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+	}
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := quotaNotificationRuleAttributes()
+	attributes["quota_id"] = schema.StringAttribute{
+		Description:         "The ID of the quota the rule belongs to. Cannot be updated.",
+		MarkdownDescription: "The ID of the quota the rule belongs to. Cannot be updated.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the notification rules of a quota on PowerScale Array. " +
+			"Creating a rule switches the notifications of the quota to custom rules, so the default rules no longer apply to this quota. " +
+			"We can Create, Update and Delete the notification rules using this resource. We can also import an existing notification rule from PowerScale array.",
+		Description: "This resource is used to manage the notification rules of a quota on PowerScale Array. " +
+			"Creating a rule switches the notifications of the quota to custom rules, so the default rules no longer apply to this quota. " +
+			"We can Create, Update and Delete the notification rules using this resource. We can also import an existing notification rule from PowerScale array.",
+		Attributes: attributes,
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *QuotaNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating quota notification rule")
+
+	var plan models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationID, err := helper.CreateQuotaNotification(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating quota notification rule", err.Error())
+		return
+	}
+
+	notification, _, err := helper.GetQuotaNotification(ctx, r.client, plan.QuotaID.ValueString(), notificationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating quota notification rule", err.Error())
+		return
+	}
+	if err := helper.UpdateQuotaNotificationState(ctx, &plan, notification); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of quota notification rule resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create quota notification rule")
+}
+
+// Read reads the resource state.
+func (r *QuotaNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading quota notification rule")
+
+	var state models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, httpResp, err := helper.GetQuotaNotification(ctx, r.client, state.QuotaID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		// the rule or its quota has been deleted, so the rule has to be recreated
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("Quota notification rule %s not found, removing it from state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading quota notification rule", err.Error())
+		return
+	}
+	if err := helper.UpdateQuotaNotificationState(ctx, &state, notification); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of quota notification rule resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read quota notification rule")
+}
+
+// Update updates the resource state.
+func (r *QuotaNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating quota notification rule")
+
+	var plan models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateQuotaNotification(ctx, r.client, state, plan); err != nil {
+		resp.Diagnostics.AddError("Error updating quota notification rule", err.Error())
+		return
+	}
+
+	notification, _, err := helper.GetQuotaNotification(ctx, r.client, state.QuotaID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating quota notification rule", err.Error())
+		return
+	}
+	if err := helper.UpdateQuotaNotificationState(ctx, &plan, notification); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of quota notification rule resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update quota notification rule")
+}
+
+// Delete deletes the resource.
+func (r *QuotaNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting quota notification rule")
+
+	var state models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteQuotaNotification(ctx, r.client, state.QuotaID.ValueString(), state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting quota notification rule", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete quota notification rule")
+}
+
+// ImportState imports the resource state by <quota ID>:<notification rule ID>.
+func (r *QuotaNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing quota notification rule")
+
+	idx := strings.LastIndex(req.ID, ":")
+	if idx <= 0 || idx == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Error importing quota notification rule",
+			fmt.Sprintf("Invalid import ID %s, the expected format is <quota ID>:<notification rule ID>", req.ID),
+		)
+		return
+	}
+	quotaID, notificationID := req.ID[:idx], req.ID[idx+1:]
+
+	notification, _, err := helper.GetQuotaNotification(ctx, r.client, quotaID, notificationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing quota notification rule", err.Error())
+		return
+	}
+
+	state := models.QuotaNotificationResourceModel{
+		QuotaID: types.StringValue(quotaID),
+	}
+	if err := helper.UpdateQuotaNotificationState(ctx, &state, notification); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of quota notification rule resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import quota notification rule")
+}
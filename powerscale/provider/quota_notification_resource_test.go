@@ -0,0 +1,188 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccQuotaNotificationResource(t *testing.T) {
+	var quotaNotificationResourceName = "powerscale_quota_notification.notification_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + QuotaNotificationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(quotaNotificationResourceName, "quota_id", "powerscale_quota.quota_test", "id"),
+					resource.TestCheckResourceAttr(quotaNotificationResourceName, "threshold", "hard"),
+					resource.TestCheckResourceAttr(quotaNotificationResourceName, "condition", "exceeded"),
+					resource.TestCheckResourceAttr(quotaNotificationResourceName, "action_alert", "true"),
+					resource.TestCheckResourceAttr(quotaNotificationResourceName, "holdoff", "3600"),
+					resource.TestCheckResourceAttrSet(quotaNotificationResourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: quotaNotificationResourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[quotaNotificationResourceName]
+					return rs.Primary.Attributes["quota_id"] + ":" + rs.Primary.ID, nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "hard", states[0].Attributes["threshold"])
+					assert.Equal(t, "exceeded", states[0].Attributes["condition"])
+					return nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + QuotaNotificationUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(quotaNotificationResourceName, "action_email_owner", "true"),
+					resource.TestCheckResourceAttr(quotaNotificationResourceName, "action_email_address", "storage-admin@example.com"),
+					resource.TestCheckResourceAttr(quotaNotificationResourceName, "holdoff", "7200"),
+				),
+			},
+			// Changing the threshold or the condition recreates the rule
+			{
+				Config: ProviderConfig + QuotaNotificationReplaceResourceConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(quotaNotificationResourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(quotaNotificationResourceName, "threshold", "soft"),
+					resource.TestCheckResourceAttr(quotaNotificationResourceName, "condition", "expired"),
+					resource.TestCheckResourceAttr(quotaNotificationResourceName, "holdoff", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuotaNotificationResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid import ID
+			{
+				Config:        ProviderConfig + QuotaNotificationResourceConfig,
+				ResourceName:  "powerscale_quota_notification.notification_test",
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile(`.*Invalid import ID*.`),
+			},
+			// Create error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateQuotaNotification).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaNotificationResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Read after create error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetQuotaNotification).Return(nil, nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaNotificationResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + QuotaNotificationResourceConfig,
+			},
+			// Update error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.UpdateQuotaNotification).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaNotificationUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Delete error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.DeleteQuotaNotification).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaNotificationResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + QuotaNotificationResourceConfig,
+			},
+		},
+	})
+}
+
+var QuotaNotificationResourceConfig = QuotaResourceConfig + `
+resource "powerscale_quota_notification" "notification_test" {
+	quota_id = powerscale_quota.quota_test.id
+	threshold = "hard"
+	condition = "exceeded"
+	action_alert = true
+	holdoff = 3600
+}
+`
+
+var QuotaNotificationUpdateResourceConfig = QuotaResourceConfig + `
+resource "powerscale_quota_notification" "notification_test" {
+	quota_id = powerscale_quota.quota_test.id
+	threshold = "hard"
+	condition = "exceeded"
+	action_alert = true
+	action_email_owner = true
+	action_email_address = "storage-admin@example.com"
+	holdoff = 7200
+}
+`
+
+var QuotaNotificationReplaceResourceConfig = QuotaResourceConfig + `
+resource "powerscale_quota_notification" "notification_test" {
+	quota_id = powerscale_quota.quota_test.id
+	threshold = "soft"
+	condition = "expired"
+	action_alert = true
+	action_email_owner = true
+	action_email_address = "storage-admin@example.com"
+	holdoff = 7200
+}
+`
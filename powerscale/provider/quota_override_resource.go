@@ -0,0 +1,374 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuotaOverrideResource{}
+var _ resource.ResourceWithConfigure = &QuotaOverrideResource{}
+var _ resource.ResourceWithImportState = &QuotaOverrideResource{}
+
+// NewQuotaOverrideResource creates a new resource.
+func NewQuotaOverrideResource() resource.Resource {
+	return &QuotaOverrideResource{}
+}
+
+// QuotaOverrideResource defines the resource implementation.
+type QuotaOverrideResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_override"
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to override the quota that a default-user or default-group quota generates for one of its members on PowerScale Array. " +
+			"On creation the generated quota is unlinked from its default quota and the custom thresholds are applied. On destroy the quota is linked to its default quota again.",
+		Description: "This resource is used to override the quota that a default-user or default-group quota generates for one of its members on PowerScale Array. " +
+			"On creation the generated quota is unlinked from its default quota and the custom thresholds are applied. On destroy the quota is linked to its default quota again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The system ID given to the overridden quota.",
+				MarkdownDescription: "The system ID given to the overridden quota.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description:         "The ifs path governed by the default quota. Cannot be updated.",
+				MarkdownDescription: "The ifs path governed by the default quota. Cannot be updated.",
+				Required:            true,
+				Validators: []validator.String{stringvalidator.RegexMatches(
+					regexp.MustCompile(`^/ifs$|^/ifs/`), "must begin with /ifs",
+				)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "The type of the overridden quota, user for a default-user quota and group for a default-group quota. Cannot be updated.",
+				MarkdownDescription: "The type of the overridden quota, `user` for a `default-user` quota and `group` for a `default-group` quota. Cannot be updated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "group"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"persona": schema.StringAttribute{
+				Description:         "The member of the default quota, in the serialized form of a persona, which can be 'UID:0', 'USER:name', 'GID:0', 'GROUP:wheel', or 'SID:S-1-1'. Cannot be updated.",
+				MarkdownDescription: "The member of the default quota, in the serialized form of a persona, which can be 'UID:0', 'USER:name', 'GID:0', 'GROUP:wheel', or 'SID:S-1-1'. Cannot be updated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "Optional named zone to use for user and group resolution. Cannot be updated.",
+				MarkdownDescription: "Optional named zone to use for user and group resolution. Cannot be updated.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_snapshots": schema.BoolAttribute{
+				Description:         "If true, the default quota governs snapshot data as well as head data. Cannot be updated.",
+				MarkdownDescription: "If true, the default quota governs snapshot data as well as head data. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enforced": schema.BoolAttribute{
+				Description:         "True if the quota provides enforcement, otherwise an accounting quota.",
+				MarkdownDescription: "True if the quota provides enforcement, otherwise an accounting quota.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"thresholds_on": schema.StringAttribute{
+				Description:         "Thresholds apply on quota accounting metric.",
+				MarkdownDescription: "Thresholds apply on quota accounting metric.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("applogicalsize", "fslogicalsize", "physicalsize"),
+				},
+			},
+			"thresholds": schema.SingleNestedAttribute{
+				Description:         "The custom thresholds of the quota.",
+				MarkdownDescription: "The custom thresholds of the quota.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"advisory": schema.Int64Attribute{
+						Description:         "Usage bytes at which notifications will be sent but writes will not be denied.",
+						MarkdownDescription: "Usage bytes at which notifications will be sent but writes will not be denied.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"hard": schema.Int64Attribute{
+						Description:         "Usage bytes at which further writes will be denied.",
+						MarkdownDescription: "Usage bytes at which further writes will be denied.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"soft": schema.Int64Attribute{
+						Description:         "Usage bytes at which notifications will be sent and soft grace time will be started.",
+						MarkdownDescription: "Usage bytes at which notifications will be sent and soft grace time will be started.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"soft_grace": schema.Int64Attribute{
+						Description:         "Time in seconds after which the soft threshold has been hit before writes will be denied.",
+						MarkdownDescription: "Time in seconds after which the soft threshold has been hit before writes will be denied.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+			"linked": schema.BoolAttribute{
+				Description:         "True if the quota is linked and controlled by its default quota.",
+				MarkdownDescription: "True if the quota is linked and controlled by its default quota.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *QuotaOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating quota override")
+
+	var plan models.QuotaOverrideResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quota, err := helper.GetDefaultQuotaMemberQuota(ctx, r.client, plan)
+	if err != nil {
+		errStr := constants.CreateQuotaOverrideErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating quota override", message)
+		return
+	}
+	quotaID := quota.GetId()
+
+	if err := helper.OverrideQuota(ctx, r.client, quotaID, plan, quota.GetLinked()); err != nil {
+		errStr := constants.CreateQuotaOverrideErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating quota override", message)
+		return
+	}
+
+	quotaResponse, err := helper.GetQuota(ctx, r.client, quotaID, plan.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadQuotaOverrideErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating quota override", message)
+		return
+	}
+	if len(quotaResponse.Quotas) <= 0 {
+		resp.Diagnostics.AddError(
+			"Error creating quota override",
+			fmt.Sprintf("Could not get overridden quota %s with error: quota not found", quotaID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(helper.UpdateQuotaOverrideState(ctx, quotaResponse.Quotas[0], &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create quota override")
+}
+
+// Read reads the resource state.
+func (r *QuotaOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading quota override")
+
+	var state models.QuotaOverrideResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quotaID := state.ID.ValueString()
+	quota, httpResp, err := helper.GetOverriddenQuota(ctx, r.client, quotaID, state.Zone.ValueString())
+	// the quota has been deleted outside of Terraform, so the override has to be recreated
+	if (err != nil && httpResp != nil && httpResp.StatusCode == http.StatusNotFound) || (err == nil && quota == nil) {
+		tflog.Warn(ctx, fmt.Sprintf("Overridden quota %s not found, removing the quota override from state", quotaID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		errStr := constants.ReadQuotaOverrideErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota override", message)
+		return
+	}
+
+	resp.Diagnostics.Append(helper.UpdateQuotaOverrideState(ctx, *quota, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read quota override")
+}
+
+// Update updates the resource state.
+func (r *QuotaOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating quota override")
+
+	var plan models.QuotaOverrideResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.QuotaOverrideResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the quota may have been linked again outside of terraform, so it is unlinked before the update
+	quotaID := state.ID.ValueString()
+	if err := helper.OverrideQuota(ctx, r.client, quotaID, plan, state.Linked.ValueBool()); err != nil {
+		errStr := constants.UpdateQuotaOverrideErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating quota override", message)
+		return
+	}
+
+	quotaResponse, err := helper.GetQuota(ctx, r.client, quotaID, plan.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadQuotaOverrideErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating quota override", message)
+		return
+	}
+	if len(quotaResponse.Quotas) <= 0 {
+		resp.Diagnostics.AddError(
+			"Error updating quota override",
+			fmt.Sprintf("Could not read overridden quota %s with error: quota not found", quotaID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(helper.UpdateQuotaOverrideState(ctx, quotaResponse.Quotas[0], &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update quota override")
+}
+
+// Delete deletes the resource.
+func (r *QuotaOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting quota override")
+
+	var state models.QuotaOverrideResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the quota is linked to its default quota again rather than deleted, so it gets the default thresholds back
+	if err := helper.LinkQuota(ctx, r.client, state.ID.ValueString(), true); err != nil {
+		errStr := constants.DeleteQuotaOverrideErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting quota override", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete quota override")
+}
+
+// ImportState imports the resource state by the ID of the overridden quota.
+func (r *QuotaOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
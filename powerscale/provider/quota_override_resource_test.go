@@ -0,0 +1,273 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQuotaOverrideResource(t *testing.T) {
+	var quotaOverrideTerraformName = "powerscale_quota_override.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + QuotaOverrideResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(quotaOverrideTerraformName, "id"),
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "path", "/ifs/tfacc_quota_test"),
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "type", "user"),
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "persona", "UID:0"),
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "linked", "false"),
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "thresholds.hard", "20000"),
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "thresholds.advisory", "10000"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            quotaOverrideTerraformName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"persona", "zone"},
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + QuotaOverrideResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "linked", "false"),
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "thresholds.hard", "30000"),
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "thresholds.soft", "25000"),
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "thresholds.soft_grace", "3600"),
+					resource.TestCheckResourceAttr(quotaOverrideTerraformName, "enforced", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuotaOverrideResourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + QuotaOverrideResourceConfigInvalidType,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+			{
+				Config:      ProviderConfig + QuotaOverrideResourceConfigNoMember,
+				ExpectError: regexp.MustCompile(`.*no user quota found*.`),
+			},
+		},
+	})
+}
+
+func TestAccQuotaOverrideResourceCreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetDefaultQuotaMemberQuota).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaOverrideResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.OverrideQuota).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaOverrideResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetQuota).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaOverrideResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + QuotaOverrideResourceConfig,
+			},
+		},
+	})
+}
+
+func TestAccQuotaOverrideResourceUpdateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + QuotaOverrideResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.OverrideQuota).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaOverrideResourceConfigUpdate,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetQuota).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaOverrideResourceConfigUpdate,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + QuotaOverrideResourceConfig,
+			},
+		},
+	})
+}
+
+func TestAccQuotaOverrideResourceReadNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + QuotaOverrideResourceConfig,
+			},
+			// quota deleted outside of Terraform, the override is removed from state and planned for creation
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetOverriddenQuota).Return(nil, &http.Response{StatusCode: http.StatusNotFound}, fmt.Errorf("mock not found")).Build()
+				},
+				Config:             ProviderConfig + QuotaOverrideResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetOverriddenQuota).Return(nil, &http.Response{StatusCode: http.StatusInternalServerError}, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaOverrideResourceConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + QuotaOverrideResourceConfig,
+			},
+		},
+	})
+}
+
+// QuotaOverrideDefaultQuotaConfig creates a default-user quota on a directory owned by root,
+// so a linked quota is generated for root.
+var QuotaOverrideDefaultQuotaConfig = FileSystemResourceConfigCommon7 + `
+resource "powerscale_quota" "default_user" {
+	depends_on = [powerscale_filesystem.file_system_test]
+	path = "/ifs/tfacc_quota_test"
+	type = "default-user"
+	include_snapshots = false
+	thresholds = {
+		hard = 10000
+	}
+}
+`
+
+var QuotaOverrideResourceConfig = QuotaOverrideDefaultQuotaConfig + `
+resource "powerscale_quota_override" "test" {
+	depends_on = [powerscale_quota.default_user]
+	path = "/ifs/tfacc_quota_test"
+	type = "user"
+	persona = "UID:0"
+	thresholds = {
+		advisory = 10000
+		hard = 20000
+	}
+}
+`
+
+var QuotaOverrideResourceConfigUpdate = QuotaOverrideDefaultQuotaConfig + `
+resource "powerscale_quota_override" "test" {
+	depends_on = [powerscale_quota.default_user]
+	path = "/ifs/tfacc_quota_test"
+	type = "user"
+	persona = "UID:0"
+	enforced = true
+	thresholds = {
+		soft = 25000
+		soft_grace = 3600
+		hard = 30000
+	}
+}
+`
+
+var QuotaOverrideResourceConfigInvalidType = `
+resource "powerscale_quota_override" "test" {
+	path = "/ifs/tfacc_quota_test"
+	type = "default-user"
+	persona = "UID:0"
+	thresholds = {
+		hard = 20000
+	}
+}
+`
+
+var QuotaOverrideResourceConfigNoMember = QuotaOverrideDefaultQuotaConfig + `
+resource "powerscale_quota_override" "test" {
+	depends_on = [powerscale_quota.default_user]
+	path = "/ifs/tfacc_quota_test"
+	type = "user"
+	persona = "UID:987654"
+	thresholds = {
+		hard = 20000
+	}
+}
+`
@@ -0,0 +1,232 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QuotaReportDataSource{}
+
+// NewQuotaReportDataSource creates a new data source.
+func NewQuotaReportDataSource() datasource.DataSource {
+	return &QuotaReportDataSource{}
+}
+
+// QuotaReportDataSource defines the data source implementation.
+type QuotaReportDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *QuotaReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_report"
+}
+
+// Schema describes the data source arguments.
+func (d *QuotaReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the existing quota reports from PowerScale array, together with the usage of each quota at the time the report was generated. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing quota reports from PowerScale array, together with the usage of each quota at the time the report was generated. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Quota Report datasource.",
+				MarkdownDescription: "Identifier of the Quota Report datasource.",
+				Computed:            true,
+			},
+			"quota_reports_details": schema.ListNestedAttribute{
+				Description:         "List of quota reports.",
+				MarkdownDescription: "List of quota reports.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "The system ID given to the report.",
+							MarkdownDescription: "The system ID given to the report.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Whether report was scheduled or manually generated.",
+							MarkdownDescription: "Whether report was scheduled or manually generated.",
+							Computed:            true,
+						},
+						"generated": schema.Int64Attribute{
+							Description:         "Time the report was generated.",
+							MarkdownDescription: "Time the report was generated.",
+							Computed:            true,
+						},
+						"quotas": schema.ListNestedAttribute{
+							Description:         "The usage of the quotas at the time the report was generated.",
+							MarkdownDescription: "The usage of the quotas at the time the report was generated.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description:         "The system ID given to the quota.",
+										MarkdownDescription: "The system ID given to the quota.",
+										Computed:            true,
+									},
+									"path": schema.StringAttribute{
+										Description:         "The /ifs path governed.",
+										MarkdownDescription: "The /ifs path governed.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										Description:         "The type of quota.",
+										MarkdownDescription: "The type of quota.",
+										Computed:            true,
+									},
+									"usage": schema.SingleNestedAttribute{
+										Description:         "The usage of the quota at the time the report was generated.",
+										MarkdownDescription: "The usage of the quota at the time the report was generated.",
+										Computed:            true,
+										Attributes: map[string]schema.Attribute{
+											"applogical": schema.Int64Attribute{
+												Description:         "Bytes used by governed data apparent to application.",
+												MarkdownDescription: "Bytes used by governed data apparent to application.",
+												Computed:            true,
+											},
+											"fslogical": schema.Int64Attribute{
+												Description:         "Bytes used by governed data apparent to filesystem.",
+												MarkdownDescription: "Bytes used by governed data apparent to filesystem.",
+												Computed:            true,
+											},
+											"fsphysical": schema.Int64Attribute{
+												Description:         "Physical data usage adjusted to account for shadow store efficiency.",
+												MarkdownDescription: "Physical data usage adjusted to account for shadow store efficiency.",
+												Computed:            true,
+											},
+											"inodes": schema.Int64Attribute{
+												Description:         "Number of inodes (filesystem entities) used by governed data.",
+												MarkdownDescription: "Number of inodes (filesystem entities) used by governed data.",
+												Computed:            true,
+											},
+											"physical": schema.Int64Attribute{
+												Description:         "Bytes used for governed data and filesystem overhead.",
+												MarkdownDescription: "Bytes used for governed data and filesystem overhead.",
+												Computed:            true,
+											},
+										},
+									},
+									"thresholds": schema.SingleNestedAttribute{
+										Description:         "The thresholds of the quota at the time the report was generated.",
+										MarkdownDescription: "The thresholds of the quota at the time the report was generated.",
+										Computed:            true,
+										Attributes: map[string]schema.Attribute{
+											"advisory": schema.Int64Attribute{
+												Description:         "Usage bytes at which notifications will be sent but writes will not be denied.",
+												MarkdownDescription: "Usage bytes at which notifications will be sent but writes will not be denied.",
+												Computed:            true,
+											},
+											"soft": schema.Int64Attribute{
+												Description:         "Usage bytes at which notifications will be sent and soft grace time will be started.",
+												MarkdownDescription: "Usage bytes at which notifications will be sent and soft grace time will be started.",
+												Computed:            true,
+											},
+											"hard": schema.Int64Attribute{
+												Description:         "Usage bytes at which further writes will be denied.",
+												MarkdownDescription: "Usage bytes at which further writes will be denied.",
+												Computed:            true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"ids": schema.SetAttribute{
+						Description:         "Only list the quota reports with these IDs.",
+						MarkdownDescription: "Only list the quota reports with these IDs.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"type": schema.StringAttribute{
+						Description:         "Only list the quota reports of this type. Acceptable values: scheduled, ad-hoc, live.",
+						MarkdownDescription: "Only list the quota reports of this type. Acceptable values: scheduled, ad-hoc, live.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("scheduled", "ad-hoc", "live"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *QuotaReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *QuotaReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading quota report data source")
+
+	var state models.QuotaReportDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.ManageDataSourceQuotaReport(ctx, d.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading quota report data source ")
+}
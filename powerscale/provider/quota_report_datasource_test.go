@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQuotaReportDataSource(t *testing.T) {
+	var quotaReportTerraformName = "data.powerscale_quota_report.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read all the quota reports
+			{
+				Config: ProviderConfig + QuotaReportAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(quotaReportTerraformName, "id", "quota_report_datasource"),
+					resource.TestCheckResourceAttrSet(quotaReportTerraformName, "quota_reports_details.#"),
+				),
+			},
+			// Filter by type
+			{
+				Config: ProviderConfig + QuotaReportFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(quotaReportTerraformName, "id", "quota_report_datasource"),
+					resource.TestCheckResourceAttrSet(quotaReportTerraformName, "quota_reports_details.#"),
+				),
+			},
+			// Filter by an invalid type
+			{
+				Config:      ProviderConfig + QuotaReportInvalidFilterDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+		},
+	})
+}
+
+func TestAccQuotaReportDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListQuotaReports).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaReportAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetQuotaReportContents).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaReportAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var QuotaReportAllDataSourceConfig = `
+data "powerscale_quota_report" "test" {
+}
+`
+
+var QuotaReportFilterDataSourceConfig = `
+data "powerscale_quota_report" "test" {
+	filter {
+		type = "live"
+	}
+}
+`
+
+var QuotaReportInvalidFilterDataSourceConfig = `
+data "powerscale_quota_report" "test" {
+	filter {
+		type = "invalid"
+	}
+}
+`
@@ -0,0 +1,321 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &QuotaReportSettingsResource{}
+	_ resource.ResourceWithConfigure = &QuotaReportSettingsResource{}
+)
+
+// NewQuotaReportSettingsResource creates a new resource.
+func NewQuotaReportSettingsResource() resource.Resource {
+	return &QuotaReportSettingsResource{}
+}
+
+// QuotaReportSettingsResource defines the resource implementation.
+type QuotaReportSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (r *QuotaReportSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_report_settings"
+}
+
+// Schema describes the data source arguments.
+func (r *QuotaReportSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `This resource is used to manage the Quota Report Settings of PowerScale Array. We can Create, Update and Delete the Quota Report Settings using this resource.  
+Note that, Quota Report Settings is the native functionality of PowerScale. When creating the resource, we actually load Quota Report Settings from PowerScale to the resource.`,
+		Description: `This resource is used to manage the Quota Report Settings of PowerScale Array. We can Create, Update and Delete the Quota Report Settings using this resource.  
+Note that, Quota Report Settings is the native functionality of PowerScale. When creating the resource, we actually load Quota Report Settings from PowerScale to the resource.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of Quota report settings. Readonly. ",
+				MarkdownDescription: "Id of Quota report settings. Readonly. ",
+			},
+			"live_dir": schema.StringAttribute{
+				Description:         "The directory on /ifs where manual or live reports will be placed.",
+				MarkdownDescription: "The directory on /ifs where manual or live reports will be placed.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"live_retain": schema.Int64Attribute{
+				Description:         "The number of manual reports to keep.",
+				MarkdownDescription: "The number of manual reports to keep.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description:         "The isidate schedule used to generate reports.",
+				MarkdownDescription: "The isidate schedule used to generate reports.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"scheduled_dir": schema.StringAttribute{
+				Description:         "The directory on /ifs where schedule reports will be placed.",
+				MarkdownDescription: "The directory on /ifs where schedule reports will be placed.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"scheduled_retain": schema.Int64Attribute{
+				Description:         "The number of scheduled reports to keep.",
+				MarkdownDescription: "The number of scheduled reports to keep.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaReportSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *QuotaReportSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Quota Report Settings resource...")
+
+	var plan models.QuotaReportSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V1QuotaReportSettingsExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating quota report settings",
+			fmt.Sprintf("Could not read quota report settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateQuotaReportSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating quota report settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetQuotaReportSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota report settings", message)
+		return
+	}
+
+	var state models.QuotaReportSettingsModel
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of quota report settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("quota_report_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Create quota report settings resource")
+}
+
+// Read reads the resource state.
+func (r *QuotaReportSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading Quota Report Settings resource")
+
+	var state models.QuotaReportSettingsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := helper.GetQuotaReportSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota report settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of quota report settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("quota_report_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read quota report settings resource")
+}
+
+// Update updates the resource state.
+func (r *QuotaReportSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Quota Report Settings resource...")
+
+	var plan models.QuotaReportSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.QuotaReportSettingsModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V1QuotaReportSettingsExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating quota report settings",
+			fmt.Sprintf("Could not read quota report settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateQuotaReportSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating quota report settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetQuotaReportSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota report settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of quota report settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("quota_report_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Update quota report settings resource")
+}
+
+// Delete deletes the resource.
+func (r *QuotaReportSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Quota Report Settings resource")
+	var state models.QuotaReportSettingsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Quota report settings is the native functionality that cannot be deleted, so just remove state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete quota report settings resource")
+}
+
+// ImportState imports the resource state.
+func (r *QuotaReportSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Quota Report Settings resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,231 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"github.com/bytedance/mockey"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccQuotaReportSettingsImport(t *testing.T) {
+	var quotaReportSettings = "powerscale_quota_report_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + quotaReportSettingsResourceConfig,
+			},
+			// Import testing
+			{
+				ResourceName: quotaReportSettings,
+				ImportState:  true,
+				ExpectError:  nil,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					resource.TestCheckResourceAttrSet(quotaReportSettings, "id")
+					resource.TestCheckResourceAttrSet(quotaReportSettings, "live_dir")
+					resource.TestCheckResourceAttrSet(quotaReportSettings, "live_retain")
+					resource.TestCheckResourceAttrSet(quotaReportSettings, "schedule")
+					resource.TestCheckResourceAttrSet(quotaReportSettings, "scheduled_dir")
+					resource.TestCheckResourceAttrSet(quotaReportSettings, "scheduled_retain")
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccQuotaReportSettingsUpdate(t *testing.T) {
+	var quotaReportSettings = "powerscale_quota_report_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + quotaReportSettingsResourceConfig,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + quotaReportSettingsUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(quotaReportSettings, "live_retain", "10"),
+					resource.TestCheckResourceAttr(quotaReportSettings, "scheduled_retain", "10"),
+					resource.TestCheckResourceAttr(quotaReportSettings, "schedule", "Every day at 1:00 AM"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + quotaReportSettingsUpdateRevertResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(quotaReportSettings, "live_retain", "5"),
+					resource.TestCheckResourceAttr(quotaReportSettings, "scheduled_retain", "5"),
+					resource.TestCheckResourceAttr(quotaReportSettings, "schedule", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuotaReportSettingsCreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetQuotaReportSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + quotaReportSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateQuotaReportSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + quotaReportSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + quotaReportSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + quotaReportSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccQuotaReportSettingsUpdateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + quotaReportSettingsResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetQuotaReportSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + quotaReportSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateQuotaReportSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + quotaReportSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + quotaReportSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + quotaReportSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccQuotaReportSettingsImportMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + quotaReportSettingsResourceConfig,
+			},
+			// Import and read Error testing
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetQuotaReportSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:            ProviderConfig + quotaReportSettingsResourceConfig,
+				ResourceName:      "powerscale_quota_report_settings.test",
+				ImportState:       true,
+				ExpectError:       regexp.MustCompile(`.*mock error*.`),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var quotaReportSettingsResourceConfig = `
+resource "powerscale_quota_report_settings" "test" {
+
+}
+`
+
+var quotaReportSettingsUpdateResourceConfig = `
+resource "powerscale_quota_report_settings" "test" {
+	live_retain = 10
+	scheduled_retain = 10
+	schedule = "Every day at 1:00 AM"
+}
+`
+
+var quotaReportSettingsUpdateRevertResourceConfig = `
+resource "powerscale_quota_report_settings" "test" {
+	live_retain = 5
+	scheduled_retain = 5
+	schedule = ""
+}
+`
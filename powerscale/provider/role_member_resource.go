@@ -0,0 +1,330 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleMemberResource{}
+var _ resource.ResourceWithConfigure = &RoleMemberResource{}
+var _ resource.ResourceWithImportState = &RoleMemberResource{}
+
+// NewRoleMemberResource creates a new resource.
+func NewRoleMemberResource() resource.Resource {
+	return &RoleMemberResource{}
+}
+
+// RoleMemberResource defines the resource implementation.
+type RoleMemberResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *RoleMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_member"
+}
+
+// Schema describes the resource arguments.
+func (r *RoleMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage a single member of a role on PowerScale Array. " +
+			"We can Create and Delete the role member using this resource. We can also import an existing role member from PowerScale array. " +
+			"Other members of the role are left untouched, so that the same role can be shared by several configurations. " +
+			"Note that, the role should not be managed by a powerscale_role resource in authoritative membership mode, otherwise the members would overwrite each other.",
+		Description: "This resource is used to manage a single member of a role on PowerScale Array. " +
+			"We can Create and Delete the role member using this resource. We can also import an existing role member from PowerScale array. " +
+			"Other members of the role are left untouched, so that the same role can be shared by several configurations. " +
+			"Note that, the role should not be managed by a powerscale_role resource in authoritative membership mode, otherwise the members would overwrite each other.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Role member ID, in the format <role_id>/<member_id>.",
+				MarkdownDescription: "Role member ID, in the format <role_id>/<member_id>.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Specifies which access zone to use. Cannot be updated.",
+				MarkdownDescription: "Specifies which access zone to use. Cannot be updated.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the ID of the role. Cannot be updated.",
+				MarkdownDescription: "Specifies the ID of the role. Cannot be updated.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the serialized form of the member persona, which can be 'UID:0', 'GID:2000' or 'SID:S-1-5-21-1-2-3-1000'. Exactly one of member_id and member_name should be provided. Cannot be updated.",
+				MarkdownDescription: "Specifies the serialized form of the member persona, which can be 'UID:0', 'GID:2000' or 'SID:S-1-5-21-1-2-3-1000'. Exactly one of member_id and member_name should be provided. Cannot be updated.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 261),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("member_id"), path.MatchRoot("member_name")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"member_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the name of the member persona, e.g. 'DOMAIN\\group' for an Active Directory group. Exactly one of member_id and member_name should be provided. Cannot be updated.",
+				MarkdownDescription: "Specifies the name of the member persona, e.g. 'DOMAIN\\group' for an Active Directory group. Exactly one of member_id and member_name should be provided. Cannot be updated.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"member_type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Specifies the type of the member persona, which can be user, group or wellknown.",
+				MarkdownDescription: "Specifies the type of the member persona, which can be user, group or wellknown.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *RoleMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	powerscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = powerscaleClient
+}
+
+// Create allocates the resource.
+func (r *RoleMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating role member")
+
+	var plan models.RoleMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member := powerscale.V1AuthAccessAccessItemFileGroup{}
+	if plan.MemberID.ValueString() != "" {
+		member.Id = plan.MemberID.ValueStringPointer()
+	} else {
+		member.Name = plan.MemberName.ValueStringPointer()
+	}
+	if err := helper.AddRoleMember(ctx, r.client, plan.RoleID.ValueString(), member, plan.Zone.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating role member",
+			err.Error(),
+		)
+		return
+	}
+
+	createdMember, err := helper.GetRoleMember(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating role member",
+			err.Error(),
+		)
+		return
+	}
+	if createdMember == nil {
+		resp.Diagnostics.AddError(
+			"Error creating role member",
+			fmt.Sprintf("Could not read created member of role %s", plan.RoleID.ValueString()),
+		)
+		return
+	}
+
+	helper.UpdateRoleMemberResourceState(&plan, createdMember)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	tflog.Info(ctx, "create role member completed")
+}
+
+// Read reads the resource state.
+func (r *RoleMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "reading role member")
+
+	var state models.RoleMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := helper.GetRoleMember(ctx, r.client, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading role member",
+			err.Error(),
+		)
+		return
+	}
+	if member == nil {
+		// the member has been removed from the role outside of terraform, it will be added again on next apply.
+		tflog.Warn(ctx, fmt.Sprintf("member %s of role %s not found, removing it from state", state.MemberID.ValueString(), state.RoleID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	helper.UpdateRoleMemberResourceState(&state, member)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "read role member completed")
+}
+
+// Update updates the resource state.
+func (r *RoleMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating role member")
+
+	// all the configurable attributes require replacement, only refresh the computed attributes here.
+	var plan models.RoleMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := helper.GetRoleMember(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating role member",
+			err.Error(),
+		)
+		return
+	}
+	if member == nil {
+		resp.Diagnostics.AddError(
+			"Error updating role member",
+			fmt.Sprintf("Could not read member of role %s", plan.RoleID.ValueString()),
+		)
+		return
+	}
+
+	helper.UpdateRoleMemberResourceState(&plan, member)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	tflog.Info(ctx, "update role member completed")
+}
+
+// Delete deletes the resource.
+func (r *RoleMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting role member")
+
+	var state models.RoleMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.RemoveRoleMember(ctx, r.client, state.RoleID.ValueString(), state.MemberID.ValueString(), state.Zone.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting role member",
+			err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "delete role member completed")
+}
+
+// ImportState imports the resource state.
+func (r *RoleMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "importing role member")
+
+	params := strings.SplitN(req.ID, "/", 2)
+	if len(params) != 2 || strings.TrimSpace(params[1]) == "" {
+		resp.Diagnostics.AddError(
+			"Error importing role member",
+			fmt.Sprintf("Unexpected import ID %s, expected format: [<zone_id>:]<role_id>/<member_id>", req.ID),
+		)
+		return
+	}
+
+	var state models.RoleMemberResourceModel
+	roleID := params[0]
+	if strings.Contains(roleID, ":") {
+		roleParams := strings.SplitN(roleID, ":", 2)
+		state.Zone = types.StringValue(strings.Trim(roleParams[0], " "))
+		roleID = roleParams[1]
+	}
+	state.RoleID = types.StringValue(strings.Trim(roleID, " "))
+	state.MemberID = types.StringValue(strings.Trim(params[1], " "))
+
+	member, err := helper.GetRoleMember(ctx, r.client, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing role member",
+			err.Error(),
+		)
+		return
+	}
+	if member == nil {
+		resp.Diagnostics.AddError(
+			"Error importing role member",
+			fmt.Sprintf("Could not find member %s of role %s", state.MemberID.ValueString(), state.RoleID.ValueString()),
+		)
+		return
+	}
+
+	helper.UpdateRoleMemberResourceState(&state, member)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "import role member completed")
+}
@@ -0,0 +1,202 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccRoleMemberResource(t *testing.T) {
+	var roleMemberResourceName = "powerscale_role_member.member_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + RoleMemberResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(roleMemberResourceName, "id", roleName+"/UID:0"),
+					resource.TestCheckResourceAttr(roleMemberResourceName, "role_id", roleName),
+					resource.TestCheckResourceAttr(roleMemberResourceName, "member_id", "UID:0"),
+					resource.TestCheckResourceAttr(roleMemberResourceName, "member_name", "root"),
+					resource.TestCheckResourceAttr(roleMemberResourceName, "member_type", "user"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  roleMemberResourceName,
+				ImportState:   true,
+				ImportStateId: roleName + "/UID:0",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, roleName, states[0].Attributes["role_id"])
+					assert.Equal(t, "UID:0", states[0].Attributes["member_id"])
+					return nil
+				},
+			},
+			// Create by member name, replacing the member
+			{
+				Config: ProviderConfig + RoleMemberByNameResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(roleMemberResourceName, "member_name", "admin"),
+					resource.TestCheckResourceAttr(roleMemberResourceName, "member_id", "UID:10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoleMemberResourceErrorCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing member
+			{
+				Config:      ProviderConfig + RoleMemberMissingMemberResourceConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = Mock(helper.AddRoleMember).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + RoleMemberResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetRoleMember).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + RoleMemberResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+func TestAccRoleMemberResourceErrorImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + RoleMemberResourceConfig,
+			},
+			// Invalid import ID
+			{
+				ResourceName:  "powerscale_role_member.member_test",
+				ImportState:   true,
+				ImportStateId: roleName,
+				ExpectError:   regexp.MustCompile("Unexpected import ID"),
+			},
+			// Member not found
+			{
+				ResourceName:  "powerscale_role_member.member_test",
+				ImportState:   true,
+				ImportStateId: roleName + "/UID:12345",
+				ExpectError:   regexp.MustCompile("Could not find member"),
+			},
+			{
+				ResourceName:  "powerscale_role_member.member_test",
+				ImportState:   true,
+				ImportStateId: roleName + "/UID:0",
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = Mock(helper.GetRoleMember).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + RoleMemberResourceConfig,
+			},
+		},
+	})
+}
+
+func TestAccRoleMemberResourceErrorDelete(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + RoleMemberResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = Mock(helper.RemoveRoleMember).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + RoleMemberByNameResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + RoleMemberByNameResourceConfig,
+			},
+		},
+	})
+}
+
+var RoleMemberRoleConfig = fmt.Sprintf(`
+resource "powerscale_role" "role_test" {
+	name = "%s"
+	description = "%s"
+	membership_mode = "additive"
+}
+`, roleName, roleDescription)
+
+var RoleMemberResourceConfig = RoleMemberRoleConfig + `
+resource "powerscale_role_member" "member_test" {
+	role_id = powerscale_role.role_test.id
+	member_id = "UID:0"
+}
+`
+
+var RoleMemberByNameResourceConfig = RoleMemberRoleConfig + `
+resource "powerscale_role_member" "member_test" {
+	role_id = powerscale_role.role_test.id
+	member_name = "admin"
+}
+`
+
+var RoleMemberMissingMemberResourceConfig = RoleMemberRoleConfig + `
+resource "powerscale_role_member" "member_test" {
+	role_id = powerscale_role.role_test.id
+}
+`
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strings"
@@ -31,6 +32,7 @@ import (
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -113,6 +115,19 @@ func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					},
 				},
 			},
+			"membership_mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies how the members of the role are managed. Acceptable values: authoritative, additive. In authoritative mode, the role members are exactly the members in the configuration. In additive mode, the members in the configuration are added to the role, and members added outside of this resource (e.g. by powerscale_role_member) are left untouched. When switching from authoritative to additive mode, only the members previously configured in this resource are removed from the role. Defaults to authoritative.",
+				MarkdownDescription: "Specifies how the members of the role are managed. Acceptable values: authoritative, additive. In authoritative mode, the role members are exactly the members in the configuration. In additive mode, the members in the configuration are added to the role, and members added outside of this resource (e.g. by powerscale_role_member) are left untouched. When switching from authoritative to additive mode, only the members previously configured in this resource are removed from the role. Defaults to authoritative.",
+				Default:             stringdefault.StaticString(helper.RoleMembershipModeAuthoritative),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						helper.RoleMembershipModeAuthoritative,
+						helper.RoleMembershipModeAdditive,
+					),
+				},
+			},
 			"privileges": schema.ListNestedAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -268,7 +283,7 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	orderedMemberList, err := helper.ReorderRoleMembers(originalPlan.Members, plan.Members)
+	orderedMemberList, err := helper.ReorderRoleMembersWithMode(plan.MembershipMode, originalPlan.Members, plan.Members)
 	if err != nil {
 		errStr := constants.ReadRoleErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -296,6 +311,7 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	r.setConfiguredMembers(ctx, originalPlan.Members, resp.Private, &resp.Diagnostics)
 	tflog.Info(ctx, "create role completed")
 }
 
@@ -349,7 +365,7 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	orderedMemberList, err := helper.ReorderRoleMembers(originalState.Members, roleState.Members)
+	orderedMemberList, err := helper.ReorderRoleMembersWithMode(roleState.MembershipMode, originalState.Members, roleState.Members)
 	if err != nil {
 		errStr := constants.ReadRoleErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -417,6 +433,10 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		)
 		return
 	}
+	if rolePlan.MembershipMode.ValueString() == helper.RoleMembershipModeAdditive {
+		// members are updated one by one, so that members added outside of this resource are kept
+		roleToUpdate.Members = nil
+	}
 	err = helper.UpdateRole(ctx, r.client, rolePlan, roleToUpdate)
 	if err != nil {
 		errStr := constants.UpdateRoleErrorMsg + "with error: "
@@ -435,6 +455,23 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Role ID and name should be consistent after the update
 	rolePlan.ID = rolePlan.Name
 
+	if rolePlan.MembershipMode.ValueString() == helper.RoleMembershipModeAdditive {
+		configuredMembers, privateDiags := req.Private.GetKey(ctx, helper.RoleConfiguredMembersKey)
+		resp.Diagnostics.Append(privateDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		previousMemberIDs, err := helper.GetPreviousRoleMemberIDs(roleState, configuredMembers)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating role members", err.Error())
+			return
+		}
+		if diags := helper.UpdateRoleMembers(ctx, r.client, previousMemberIDs, rolePlan); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	updatedRole, err := helper.GetRole(ctx, r.client, rolePlan)
 	if err != nil {
 		errStr := constants.ReadRoleErrorMsg + "with error: "
@@ -466,7 +503,7 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	orderedMemberList, err := helper.ReorderRoleMembers(originalPlan.Members, rolePlan.Members)
+	orderedMemberList, err := helper.ReorderRoleMembersWithMode(rolePlan.MembershipMode, originalPlan.Members, rolePlan.Members)
 	if err != nil {
 		errStr := constants.ReadRoleErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -494,9 +531,26 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	r.setConfiguredMembers(ctx, originalPlan.Members, resp.Private, &resp.Diagnostics)
 	tflog.Info(ctx, "update role completed")
 }
 
+// setConfiguredMembers keeps the configured role members in the private state.
+// They are used to compute the members to remove when switching from authoritative to additive membership mode.
+func (r *RoleResource) setConfiguredMembers(ctx context.Context, members types.List, private privateStateSetter, respDiags *diag.Diagnostics) {
+	configuredMembers, err := helper.GetConfiguredRoleMembers(members)
+	if err != nil {
+		respDiags.AddError("Error saving role members", err.Error())
+		return
+	}
+	respDiags.Append(private.SetKey(ctx, helper.RoleConfiguredMembersKey, configuredMembers)...)
+}
+
+// privateStateSetter is implemented by the private state of the resource responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
 // Delete deletes the resource.
 func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "deleting role")
@@ -545,6 +599,7 @@ func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportState
 
 	roleState.ID = types.StringValue(roleID)
 	roleState.Zone = types.StringValue(zoneID)
+	roleState.MembershipMode = types.StringValue(helper.RoleMembershipModeAuthoritative)
 	tflog.Debug(ctx, "calling get role by ID", map[string]interface{}{
 		"roleID": roleState.ID,
 	})
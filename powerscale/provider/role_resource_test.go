@@ -19,6 +19,8 @@ import (
 	powerscale "dell/powerscale-go-client"
 	"fmt"
 	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -351,6 +353,137 @@ func TestAccRoleResourceErrorUpdate(t *testing.T) {
 	})
 }
 
+func TestAccRoleResourceAdditiveMembership(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, member added by powerscale_role_member should be ignored
+			{
+				Config: ProviderConfig + RoleAdditiveResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_role.role_test", "membership_mode", "additive"),
+					resource.TestCheckResourceAttr("powerscale_role.role_test", "members.#", "1"),
+					resource.TestCheckResourceAttr("powerscale_role.role_test", "members.0.id", "UID:10"),
+				),
+			},
+			// Update members error
+			{
+				Config: ProviderConfig + RoleAdditiveUpdatedResourceConfig,
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = Mock(helper.UpdateRoleMembers).Return(diag.Diagnostics{diag.NewErrorDiagnostic("mock error", "mock error")}).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// Update, member added by powerscale_role_member should be kept
+			{
+				Config: ProviderConfig + RoleAdditiveUpdatedResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_role.role_test", "members.#", "0"),
+					resource.TestCheckResourceAttr("powerscale_role_member.member_test", "member_id", "UID:0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoleResourceSwitchToAdditiveMembership(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + RoleAuthoritativeResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_role.role_test", "membership_mode", "authoritative"),
+					resource.TestCheckResourceAttr("powerscale_role.role_test", "members.#", "1"),
+				),
+			},
+			// Switch to additive mode after a member is added outside of terraform,
+			// only the previously configured member should be removed
+			{
+				PreConfig: func() {
+					client, err := getClientForRegion("dontCare")
+					assert.Nil(t, err)
+					member := powerscale.V1AuthAccessAccessItemFileGroup{Id: helper.New("UID:0")}
+					assert.Nil(t, helper.AddRoleMember(context.Background(), client, roleName, member, ""))
+				},
+				Config: ProviderConfig + RoleAdditiveEmptyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_role.role_test", "membership_mode", "additive"),
+					resource.TestCheckResourceAttr("powerscale_role.role_test", "members.#", "0"),
+					func(_ *terraform.State) error {
+						client, err := getClientForRegion("dontCare")
+						if err != nil {
+							return err
+						}
+						roles, err := helper.GetRole(context.Background(), client, models.RoleResourceModel{ID: types.StringValue(roleName), Zone: types.StringNull()})
+						if err != nil {
+							return err
+						}
+						if len(roles.Roles) == 0 {
+							return fmt.Errorf("role %s not found", roleName)
+						}
+						if helper.FindRoleMember(roles.Roles[0], "UID:0", "") == nil {
+							return fmt.Errorf("member UID:0 added outside of terraform should be kept")
+						}
+						if helper.FindRoleMember(roles.Roles[0], "UID:10", "") != nil {
+							return fmt.Errorf("previously configured member UID:10 should be removed")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestGetPreviousRoleMemberIDs(t *testing.T) {
+	memberType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+		"type": types.StringType,
+	}}
+	newMember := func(id string) attr.Value {
+		return types.ObjectValueMust(memberType.AttrTypes, map[string]attr.Value{
+			"id":   types.StringValue(id),
+			"name": types.StringNull(),
+			"type": types.StringNull(),
+		})
+	}
+	state := models.RoleResourceModel{
+		MembershipMode: types.StringValue(helper.RoleMembershipModeAuthoritative),
+		Members:        types.ListValueMust(memberType, []attr.Value{newMember("UID:10"), newMember("UID:0")}),
+	}
+
+	// authoritative state holds the members added outside of terraform, the configured members are used instead
+	configuredMembers, err := helper.GetConfiguredRoleMembers(types.ListValueMust(memberType, []attr.Value{newMember("UID:10")}))
+	assert.Nil(t, err)
+	memberIDs, err := helper.GetPreviousRoleMemberIDs(state, configuredMembers)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"UID:10"}, memberIDs)
+
+	// nothing is removed when the configured members are unknown, e.g. after import
+	memberIDs, err = helper.GetPreviousRoleMemberIDs(state, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, memberIDs)
+
+	_, err = helper.GetPreviousRoleMemberIDs(state, []byte("invalid"))
+	assert.NotNil(t, err)
+
+	// additive state only holds the managed members
+	state.MembershipMode = types.StringValue(helper.RoleMembershipModeAdditive)
+	memberIDs, err = helper.GetPreviousRoleMemberIDs(state, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"UID:10", "UID:0"}, memberIDs)
+}
+
 var roleName = "role_at_test"
 var roleDescription = "role_description"
 
@@ -435,3 +568,56 @@ resource "powerscale_role" "role_test" {
 	]
 }
 `, roleName, roleDescription+"_modified")
+
+var RoleAdditiveResourceConfig = fmt.Sprintf(`
+resource "powerscale_role" "role_test" {
+	name = "%s"
+	description = "%s"
+	membership_mode = "additive"
+	members = [
+		{
+			id = "UID:10"
+		}
+	]
+}
+
+resource "powerscale_role_member" "member_test" {
+	role_id = powerscale_role.role_test.id
+	member_id = "UID:0"
+}
+`, roleName, roleDescription)
+
+var RoleAdditiveUpdatedResourceConfig = fmt.Sprintf(`
+resource "powerscale_role" "role_test" {
+	name = "%s"
+	description = "%s"
+	membership_mode = "additive"
+	members = []
+}
+
+resource "powerscale_role_member" "member_test" {
+	role_id = powerscale_role.role_test.id
+	member_id = "UID:0"
+}
+`, roleName, roleDescription)
+
+var RoleAuthoritativeResourceConfig = fmt.Sprintf(`
+resource "powerscale_role" "role_test" {
+	name = "%s"
+	description = "%s"
+	members = [
+		{
+			id = "UID:10"
+		}
+	]
+}
+`, roleName, roleDescription)
+
+var RoleAdditiveEmptyResourceConfig = fmt.Sprintf(`
+resource "powerscale_role" "role_test" {
+	name = "%s"
+	description = "%s"
+	membership_mode = "additive"
+	members = []
+}
+`, roleName, roleDescription)
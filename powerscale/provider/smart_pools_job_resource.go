@@ -0,0 +1,282 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource = &SmartPoolsJobResource{}
+)
+
+// NewSmartPoolsJobResource returns the SmartPools job resource object.
+func NewSmartPoolsJobResource() resource.Resource {
+	return &SmartPoolsJobResource{}
+}
+
+// SmartPoolsJobResource defines the resource implementation.
+type SmartPoolsJobResource struct {
+	client *client.Client
+}
+
+// Configure configures the resource.
+func (r *SmartPoolsJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the resource arguments.
+func (r *SmartPoolsJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smartpools_job"
+}
+
+// Schema defines the schema for the resource.
+func (r *SmartPoolsJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to start a SmartPools or SmartPoolsTree job on PowerScale Array, so that changes to SmartPools settings and file pool policies are applied to the files, and to track the progress of the job. " +
+			"The job is started on Create and Update. Delete only removes the resource from the Terraform state, a running job is not cancelled.",
+		Description: "This resource is used to start a SmartPools or SmartPoolsTree job on PowerScale Array, so that changes to SmartPools settings and file pool policies are applied to the files, and to track the progress of the job. " +
+			"The job is started on Create and Update. Delete only removes the resource from the Terraform state, a running job is not cancelled.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the started job.",
+				MarkdownDescription: "The ID of the started job.",
+				Computed:            true,
+			},
+			"job_type": schema.StringAttribute{
+				Description: "The type of job to start. Acceptable values: SmartPools, SmartPoolsTree. " +
+					"SmartPools applies the file pool policies to the whole cluster, SmartPoolsTree applies them to the given paths only.",
+				MarkdownDescription: "The type of job to start. Acceptable values: SmartPools, SmartPoolsTree. " +
+					"SmartPools applies the file pool policies to the whole cluster, SmartPoolsTree applies them to the given paths only.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("SmartPools"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("SmartPools", "SmartPoolsTree"),
+				},
+			},
+			"paths": schema.ListAttribute{
+				Description:         "Paths to run the job on. Required for a SmartPoolsTree job.",
+				MarkdownDescription: "Paths to run the job on. Required for a SmartPoolsTree job.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Description:         "Impact policy of the job, e.g. LOW, MEDIUM, HIGH or OFF_HOURS. Defaults to the impact policy of the job type.",
+				MarkdownDescription: "Impact policy of the job, e.g. LOW, MEDIUM, HIGH or OFF_HOURS. Defaults to the impact policy of the job type.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description:         "Priority of the job, 1 being the highest. Defaults to the priority of the job type.",
+				MarkdownDescription: "Priority of the job, 1 being the highest. Defaults to the priority of the job type.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"allow_dup": schema.BoolAttribute{
+				Description:         "Whether the job may be started while another job of the same type is queued or running.",
+				MarkdownDescription: "Whether the job may be started while another job of the same type is queued or running.",
+				Optional:            true,
+			},
+			"wait": schema.BoolAttribute{
+				Description:         "Whether to wait for the job to finish. If false, the job is only started and its progress is refreshed on the next read.",
+				MarkdownDescription: "Whether to wait for the job to finish. If false, the job is only started and its progress is refreshed on the next read.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"poll_interval": schema.Int64Attribute{
+				Description:         "Seconds to wait between two polls of the job.",
+				MarkdownDescription: "Seconds to wait between two polls of the job.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Description:         "Seconds to wait for the job to finish. The job keeps running on the cluster if the timeout is reached.",
+				MarkdownDescription: "Seconds to wait for the job to finish. The job keeps running on the cluster if the timeout is reached.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3600),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"job_id": schema.Int64Attribute{
+				Description:         "The numeric ID of the started job.",
+				MarkdownDescription: "The numeric ID of the started job.",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				Description:         "The last known state of the job, e.g. running, succeeded, failed.",
+				MarkdownDescription: "The last known state of the job, e.g. running, succeeded, failed.",
+				Computed:            true,
+			},
+			"progress": schema.StringAttribute{
+				Description:         "The last known progress of the job.",
+				MarkdownDescription: "The last known progress of the job.",
+				Computed:            true,
+			},
+			"current_phase": schema.Int64Attribute{
+				Description:         "The last known phase of the job.",
+				MarkdownDescription: "The last known phase of the job.",
+				Computed:            true,
+			},
+			"total_phases": schema.Int64Attribute{
+				Description:         "The number of phases of the job.",
+				MarkdownDescription: "The number of phases of the job.",
+				Computed:            true,
+			},
+			"start_time": schema.Int64Attribute{
+				Description:         "The time the job started, in unix epoch seconds.",
+				MarkdownDescription: "The time the job started, in unix epoch seconds.",
+				Computed:            true,
+			},
+			"end_time": schema.Int64Attribute{
+				Description:         "The time the job ended, in unix epoch seconds.",
+				MarkdownDescription: "The time the job ended, in unix epoch seconds.",
+				Computed:            true,
+			},
+			"error_message": schema.StringAttribute{
+				Description:         "The error reported when the job did not succeed or could not be tracked.",
+				MarkdownDescription: "The error reported when the job did not succeed or could not be tracked.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Create starts the job and sets the initial Terraform state.
+func (r *SmartPoolsJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating SmartPools job resource state")
+	var plan models.SmartPoolsJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageSmartPoolsJob(ctx, r.client, plan)
+	response.Diagnostics.Append(diags...)
+	// Keep track of a started job even if it did not succeed
+	if state.ID.IsNull() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating SmartPools job resource state")
+}
+
+// Read refreshes the progress of a job that has not finished yet.
+func (r *SmartPoolsJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading SmartPools job resource state")
+	var state models.SmartPoolsJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ID.IsNull() && !helper.IsJobFinished(state.State.ValueString()) {
+		job, err := helper.GetSnapshotRestoreJob(ctx, r.client, state.ID.ValueString())
+		if err != nil {
+			// Finished jobs are eventually purged from the job engine, keep the last known state
+			tflog.Warn(ctx, fmt.Sprintf("Could not refresh SmartPools job %s: %s", state.ID.ValueString(), err.Error()))
+		} else {
+			helper.UpdateSmartPoolsJobState(&state, job)
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SmartPools job resource state")
+}
+
+// Update starts the job again and sets the updated Terraform state.
+func (r *SmartPoolsJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating SmartPools job resource state")
+	var plan models.SmartPoolsJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageSmartPoolsJob(ctx, r.client, plan)
+	response.Diagnostics.Append(diags...)
+	// Keep track of a started job even if it did not succeed
+	if state.ID.IsNull() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating SmartPools job resource state")
+}
+
+// Delete deletes the resource.
+func (r *SmartPoolsJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SmartPools job resource state")
+	var state models.SmartPoolsJobResourceModel
+
+	// Read Terraform prior state data into the model
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting SmartPools job resource state")
+}
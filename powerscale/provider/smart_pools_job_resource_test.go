@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSmartPoolsJobResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + smartPoolsJobTreeConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_smartpools_job.test", "job_type", "SmartPoolsTree"),
+					resource.TestCheckResourceAttr("powerscale_smartpools_job.test", "state", "succeeded"),
+					resource.TestCheckResourceAttr("powerscale_smartpools_job.test", "paths.#", "1"),
+					resource.TestCheckResourceAttrSet("powerscale_smartpools_job.test", "job_id"),
+					resource.TestCheckResourceAttrSet("powerscale_smartpools_job.test", "progress"),
+					resource.TestCheckNoResourceAttr("powerscale_smartpools_job.test", "error_message"),
+				),
+			},
+			{
+				Config: ProviderConfig + smartPoolsJobNoWaitConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_smartpools_job.test", "job_type", "SmartPools"),
+					resource.TestCheckResourceAttr("powerscale_smartpools_job.test", "wait", "false"),
+					resource.TestCheckResourceAttrSet("powerscale_smartpools_job.test", "job_id"),
+					resource.TestCheckResourceAttrSet("powerscale_smartpools_job.test", "state"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSmartPoolsJobResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + smartPoolsJobInvalidTypeConfig,
+				ExpectError: regexp.MustCompile(`.*value must be one of*.`),
+			},
+			{
+				Config:      ProviderConfig + smartPoolsJobTreeWithoutPathsConfig,
+				ExpectError: regexp.MustCompile(`.*paths must be set for a SmartPoolsTree job*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateSnapshotRestoreJob).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + smartPoolsJobTreeConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetSnapshotRestoreJob).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + smartPoolsJobTreeConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetSnapshotRestoreJob).Return(&powerscale.V10JobJobExtended{State: "failed"}, nil).Build()
+				},
+				Config:      ProviderConfig + smartPoolsJobTreeConfig,
+				ExpectError: regexp.MustCompile(`.*did not succeed*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetSnapshotRestoreJob).Return(&powerscale.V10JobJobExtended{State: "running"}, nil).Build()
+				},
+				Config:      ProviderConfig + smartPoolsJobTimeoutConfig,
+				ExpectError: regexp.MustCompile(`.*is still running after*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + smartPoolsJobTreeConfig,
+			},
+		},
+	})
+}
+
+var smartPoolsJobTreeConfig = `
+resource "powerscale_smartpools_job" "test" {
+	job_type      = "SmartPoolsTree"
+	paths         = ["/ifs/data"]
+	policy        = "LOW"
+	priority      = 5
+	allow_dup     = true
+	poll_interval = 1
+}
+`
+
+var smartPoolsJobNoWaitConfig = `
+resource "powerscale_smartpools_job" "test" {
+	allow_dup = true
+	wait      = false
+}
+`
+
+var smartPoolsJobTimeoutConfig = `
+resource "powerscale_smartpools_job" "test" {
+	job_type      = "SmartPoolsTree"
+	paths         = ["/ifs/data"]
+	allow_dup     = true
+	poll_interval = 1
+	timeout       = 1
+}
+`
+
+var smartPoolsJobInvalidTypeConfig = `
+resource "powerscale_smartpools_job" "test" {
+	job_type = "invalid"
+}
+`
+
+var smartPoolsJobTreeWithoutPathsConfig = `
+resource "powerscale_smartpools_job" "test" {
+	job_type = "SmartPoolsTree"
+}
+`
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SnapshotAliasDataSource{}
+
+// NewSnapshotAliasDataSource creates a new data source.
+func NewSnapshotAliasDataSource() datasource.DataSource {
+	return &SnapshotAliasDataSource{}
+}
+
+// SnapshotAliasDataSource defines the data source implementation.
+type SnapshotAliasDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SnapshotAliasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_alias"
+}
+
+// Schema describes the data source arguments.
+func (d *SnapshotAliasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the existing snapshot aliases from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing snapshot aliases from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Snapshot Alias datasource.",
+				MarkdownDescription: "Identifier of the Snapshot Alias datasource.",
+				Computed:            true,
+			},
+			"snapshot_aliases_details": schema.ListNestedAttribute{
+				Description:         "List of snapshot aliases.",
+				MarkdownDescription: "List of snapshot aliases.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "The ID of the snapshot alias.",
+							MarkdownDescription: "The ID of the snapshot alias.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "The name of the snapshot alias.",
+							MarkdownDescription: "The name of the snapshot alias.",
+							Computed:            true,
+						},
+						"target_id": schema.Int64Attribute{
+							Description:         "The ID of the snapshot the alias points to, -1 for the live file system.",
+							MarkdownDescription: "The ID of the snapshot the alias points to, -1 for the live file system.",
+							Computed:            true,
+						},
+						"target_name": schema.StringAttribute{
+							Description:         "The name of the snapshot the alias points to.",
+							MarkdownDescription: "The name of the snapshot the alias points to.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Description:         "Only list the snapshot aliases with these names.",
+						MarkdownDescription: "Only list the snapshot aliases with these names.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SnapshotAliasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SnapshotAliasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading snapshot alias data source")
+
+	var state models.SnapshotAliasDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.ManageDataSourceSnapshotAlias(ctx, d.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading snapshot alias data source ")
+}
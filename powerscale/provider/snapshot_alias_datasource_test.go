@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSnapshotAliasDataSource(t *testing.T) {
+	var snapshotAliasTerraformName = "data.powerscale_snapshot_alias.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read all the snapshot aliases
+			{
+				Config: ProviderConfig + SnapshotAliasAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotAliasTerraformName, "id", "snapshot_alias_datasource"),
+					resource.TestCheckResourceAttrSet(snapshotAliasTerraformName, "snapshot_aliases_details.#"),
+				),
+			},
+			// Filter by name
+			{
+				Config: ProviderConfig + SnapshotAliasFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotAliasTerraformName, "snapshot_aliases_details.#", "1"),
+					resource.TestCheckResourceAttr(snapshotAliasTerraformName, "snapshot_aliases_details.0.name", "tfacc_snapshot_alias"),
+					resource.TestCheckResourceAttr(snapshotAliasTerraformName, "snapshot_aliases_details.0.target_name", "tfacc_alias_snapshot_1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotAliasDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListSnapshotAliases).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotAliasAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var SnapshotAliasAllDataSourceConfig = `
+data "powerscale_snapshot_alias" "test" {
+}
+`
+
+var SnapshotAliasFilterDataSourceConfig = SnapshotAliasResourceConfig + `
+data "powerscale_snapshot_alias" "test" {
+	filter {
+		names = [powerscale_snapshot_alias.alias_test.name]
+	}
+}
+`
@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SnapshotAliasResource{}
+var _ resource.ResourceWithConfigure = &SnapshotAliasResource{}
+var _ resource.ResourceWithImportState = &SnapshotAliasResource{}
+
+// NewSnapshotAliasResource creates a new resource.
+func NewSnapshotAliasResource() resource.Resource {
+	return &SnapshotAliasResource{}
+}
+
+// SnapshotAliasResource defines the resource implementation.
+type SnapshotAliasResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SnapshotAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_alias"
+}
+
+// Schema describes the resource arguments.
+func (r *SnapshotAliasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the snapshot aliases of PowerScale Array. " +
+			"A snapshot alias is a stable name pointing to a snapshot, which can be repointed to a newer snapshot without changing the clients accessing it. " +
+			"We can Create, Update and Delete the snapshot aliases using this resource. We can also import an existing snapshot alias from PowerScale array.",
+		Description: "This resource is used to manage the snapshot aliases of PowerScale Array. " +
+			"A snapshot alias is a stable name pointing to a snapshot, which can be repointed to a newer snapshot without changing the clients accessing it. " +
+			"We can Create, Update and Delete the snapshot aliases using this resource. We can also import an existing snapshot alias from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the snapshot alias.",
+				MarkdownDescription: "The ID of the snapshot alias.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The name of the snapshot alias.",
+				MarkdownDescription: "The name of the snapshot alias.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target": schema.StringAttribute{
+				Description:         "The name or ID of the snapshot the alias points to. Use HEAD to point the alias to the live file system.",
+				MarkdownDescription: "The name or ID of the snapshot the alias points to. Use HEAD to point the alias to the live file system.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target_id": schema.Int64Attribute{
+				Description:         "The ID of the snapshot the alias points to, -1 for the live file system.",
+				MarkdownDescription: "The ID of the snapshot the alias points to, -1 for the live file system.",
+				Computed:            true,
+			},
+			"target_name": schema.StringAttribute{
+				Description:         "The name of the snapshot the alias points to.",
+				MarkdownDescription: "The name of the snapshot the alias points to.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SnapshotAliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *SnapshotAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating snapshot alias")
+
+	var plan models.SnapshotAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aliasID, err := helper.CreateSnapshotAlias(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating snapshot alias", err.Error())
+		return
+	}
+
+	alias, err := helper.GetSnapshotAlias(ctx, r.client, aliasID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating snapshot alias", err.Error())
+		return
+	}
+	helper.UpdateSnapshotAliasState(&plan, alias)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create snapshot alias")
+}
+
+// Read reads the resource state.
+func (r *SnapshotAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading snapshot alias")
+
+	var state models.SnapshotAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := helper.GetSnapshotAlias(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading snapshot alias", err.Error())
+		return
+	}
+	helper.UpdateSnapshotAliasState(&state, alias)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read snapshot alias")
+}
+
+// Update updates the resource state.
+func (r *SnapshotAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating snapshot alias")
+
+	var plan models.SnapshotAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.SnapshotAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateSnapshotAlias(ctx, r.client, state, plan); err != nil {
+		resp.Diagnostics.AddError("Error updating snapshot alias", err.Error())
+		return
+	}
+
+	alias, err := helper.GetSnapshotAlias(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating snapshot alias", err.Error())
+		return
+	}
+	helper.UpdateSnapshotAliasState(&plan, alias)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update snapshot alias")
+}
+
+// Delete deletes the resource.
+func (r *SnapshotAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting snapshot alias")
+
+	var state models.SnapshotAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteSnapshotAlias(ctx, r.client, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting snapshot alias", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete snapshot alias")
+}
+
+// ImportState imports the resource state by the snapshot alias ID or name.
+func (r *SnapshotAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing snapshot alias")
+
+	alias, err := helper.GetSnapshotAlias(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing snapshot alias", err.Error())
+		return
+	}
+
+	var state models.SnapshotAliasResourceModel
+	helper.UpdateSnapshotAliasState(&state, alias)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import snapshot alias")
+}
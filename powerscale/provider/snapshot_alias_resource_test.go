@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSnapshotAliasResource(t *testing.T) {
+	var snapshotAliasResourceName = "powerscale_snapshot_alias.alias_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + SnapshotAliasResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotAliasResourceName, "name", "tfacc_snapshot_alias"),
+					resource.TestCheckResourceAttr(snapshotAliasResourceName, "target", "tfacc_alias_snapshot_1"),
+					resource.TestCheckResourceAttr(snapshotAliasResourceName, "target_name", "tfacc_alias_snapshot_1"),
+					resource.TestCheckResourceAttrPair(snapshotAliasResourceName, "target_id", "powerscale_snapshot.alias_snap_1", "id"),
+					resource.TestCheckResourceAttrSet(snapshotAliasResourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  snapshotAliasResourceName,
+				ImportState:   true,
+				ImportStateId: "tfacc_snapshot_alias",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "tfacc_snapshot_alias", states[0].Attributes["name"])
+					assert.Equal(t, "tfacc_alias_snapshot_1", states[0].Attributes["target_name"])
+					return nil
+				},
+			},
+			// Repoint the alias to a newer snapshot and rename it
+			{
+				Config: ProviderConfig + SnapshotAliasUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotAliasResourceName, "name", "tfacc_snapshot_alias_latest"),
+					resource.TestCheckResourceAttr(snapshotAliasResourceName, "target_name", "tfacc_alias_snapshot_2"),
+					resource.TestCheckResourceAttrPair(snapshotAliasResourceName, "target_id", "powerscale_snapshot.alias_snap_2", "id"),
+				),
+			},
+			// Point the alias to the live file system
+			{
+				Config: ProviderConfig + SnapshotAliasLiveResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotAliasResourceName, "target", "HEAD"),
+					resource.TestCheckResourceAttr(snapshotAliasResourceName, "target_id", "-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotAliasResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateSnapshotAlias).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotAliasResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Read after create error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetSnapshotAlias).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotAliasResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SnapshotAliasResourceConfig,
+			},
+			// Update error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.UpdateSnapshotAlias).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotAliasUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Import error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetSnapshotAlias).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:        ProviderConfig + SnapshotAliasResourceConfig,
+				ResourceName:  "powerscale_snapshot_alias.alias_test",
+				ImportState:   true,
+				ImportStateId: "tfacc_snapshot_alias",
+				ExpectError:   regexp.MustCompile(`.*mock error*.`),
+			},
+			// Delete error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.DeleteSnapshotAlias).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotAliasResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SnapshotAliasResourceConfig,
+			},
+		},
+	})
+}
+
+var SnapshotAliasSnapshotsConfig = FileSystemResourceConfigCommon + `
+resource "powerscale_snapshot" "alias_snap_1" {
+	path = "/ifs/tfacc_file_system_test"
+	name = "tfacc_alias_snapshot_1"
+	depends_on = [powerscale_filesystem.file_system_test]
+}
+
+resource "powerscale_snapshot" "alias_snap_2" {
+	path = "/ifs/tfacc_file_system_test"
+	name = "tfacc_alias_snapshot_2"
+	depends_on = [powerscale_snapshot.alias_snap_1]
+}
+`
+
+var SnapshotAliasResourceConfig = SnapshotAliasSnapshotsConfig + `
+resource "powerscale_snapshot_alias" "alias_test" {
+	name = "tfacc_snapshot_alias"
+	target = powerscale_snapshot.alias_snap_1.name
+}
+`
+
+var SnapshotAliasUpdateResourceConfig = SnapshotAliasSnapshotsConfig + `
+resource "powerscale_snapshot_alias" "alias_test" {
+	name = "tfacc_snapshot_alias_latest"
+	target = powerscale_snapshot.alias_snap_2.name
+}
+`
+
+var SnapshotAliasLiveResourceConfig = SnapshotAliasSnapshotsConfig + `
+resource "powerscale_snapshot_alias" "alias_test" {
+	name = "tfacc_snapshot_alias_latest"
+	target = "HEAD"
+}
+`
@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SnapshotChangelistEntryDataSource{}
+
+// NewSnapshotChangelistEntryDataSource creates a new data source.
+func NewSnapshotChangelistEntryDataSource() datasource.DataSource {
+	return &SnapshotChangelistEntryDataSource{}
+}
+
+// SnapshotChangelistEntryDataSource defines the data source implementation.
+type SnapshotChangelistEntryDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SnapshotChangelistEntryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_changelist_entry"
+}
+
+// Schema describes the data source arguments.
+func (d *SnapshotChangelistEntryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the entries of a snapshot changelist from PowerScale array. " +
+			"The entries are the files and directories changed between the two snapshots of the changelist. " +
+			"The number of entries kept in the state is capped by the limit, so large changelists should be narrowed down with the filter block.",
+		Description: "This datasource is used to query the entries of a snapshot changelist from PowerScale array. " +
+			"The entries are the files and directories changed between the two snapshots of the changelist. " +
+			"The number of entries kept in the state is capped by the limit, so large changelists should be narrowed down with the filter block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Snapshot Changelist Entry datasource.",
+				MarkdownDescription: "Identifier of the Snapshot Changelist Entry datasource.",
+				Computed:            true,
+			},
+			"changelist": schema.StringAttribute{
+				Description:         "The ID of the snapshot changelist, in the format <older snapshot ID>_<newer snapshot ID>.",
+				MarkdownDescription: "The ID of the snapshot changelist, in the format <older snapshot ID>_<newer snapshot ID>.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description:         "The maximum number of matching entries to return. Defaults to 1000.",
+				MarkdownDescription: "The maximum number of matching entries to return. Defaults to 1000.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100000),
+				},
+			},
+			"truncated": schema.BoolAttribute{
+				Description:         "Whether more entries match the filter than the limit allows.",
+				MarkdownDescription: "Whether more entries match the filter than the limit allows.",
+				Computed:            true,
+			},
+			"changelist_entries": schema.ListNestedAttribute{
+				Description:         "List of snapshot changelist entries.",
+				MarkdownDescription: "List of snapshot changelist entries.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"lin": schema.Int64Attribute{
+							Description:         "The LIN of the changed file or directory.",
+							MarkdownDescription: "The LIN of the changed file or directory.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							Description:         "The path of the changed file or directory.",
+							MarkdownDescription: "The path of the changed file or directory.",
+							Computed:            true,
+						},
+						"change_types": schema.ListAttribute{
+							Description:         "The types of the change, among added, removed, path_changed and modified.",
+							MarkdownDescription: "The types of the change, among added, removed, path_changed and modified.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"size": schema.Int64Attribute{
+							Description:         "The logical size of the file in bytes.",
+							MarkdownDescription: "The logical size of the file in bytes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"path_prefix": schema.StringAttribute{
+						Description:         "Only list the entries whose path starts with this prefix.",
+						MarkdownDescription: "Only list the entries whose path starts with this prefix.",
+						Optional:            true,
+					},
+					"change_types": schema.SetAttribute{
+						Description:         "Only list the entries with any of these change types. Accepted values are added, removed, path_changed and modified.",
+						MarkdownDescription: "Only list the entries with any of these change types. Accepted values are added, removed, path_changed and modified.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf("added", "removed", "path_changed", "modified")),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SnapshotChangelistEntryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SnapshotChangelistEntryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading snapshot changelist entry data source")
+
+	var state models.SnapshotChangelistEntryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.ManageDataSourceSnapshotChangelistEntry(ctx, d.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading snapshot changelist entry data source ")
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSnapshotChangelistEntryDataSource(t *testing.T) {
+	var snapshotChangelistEntryTerraformName = "data.powerscale_snapshot_changelist_entry.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read all the entries
+			{
+				Config: ProviderConfig + SnapshotChangelistEntryAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotChangelistEntryTerraformName, "id", "snapshot_changelist_entry_datasource"),
+					resource.TestCheckResourceAttr(snapshotChangelistEntryTerraformName, "limit", "1000"),
+					resource.TestCheckResourceAttrSet(snapshotChangelistEntryTerraformName, "changelist_entries.#"),
+				),
+			},
+			// Filter and limit
+			{
+				Config: ProviderConfig + SnapshotChangelistEntryFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotChangelistEntryTerraformName, "limit", "1"),
+					resource.TestCheckResourceAttrSet(snapshotChangelistEntryTerraformName, "truncated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotChangelistEntryDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListSnapshotChangelistEntries).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotChangelistEntryAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config:      ProviderConfig + SnapshotChangelistEntryInvalidDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+		},
+	})
+}
+
+var SnapshotChangelistEntryAllDataSourceConfig = SnapshotChangelistResourceConfig + `
+data "powerscale_snapshot_changelist_entry" "test" {
+	changelist = powerscale_snapshot_changelist.changelist_test.id
+}
+`
+
+var SnapshotChangelistEntryFilterDataSourceConfig = SnapshotChangelistResourceConfig + `
+data "powerscale_snapshot_changelist_entry" "test" {
+	changelist = powerscale_snapshot_changelist.changelist_test.id
+	limit = 1
+	filter {
+		path_prefix = "/ifs/tfacc_file_system_test"
+		change_types = ["added", "modified"]
+	}
+}
+`
+
+var SnapshotChangelistEntryInvalidDataSourceConfig = `
+data "powerscale_snapshot_changelist_entry" "test" {
+	changelist = "1_2"
+	filter {
+		change_types = ["renamed"]
+	}
+}
+`
@@ -0,0 +1,325 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SnapshotChangelistResource{}
+var _ resource.ResourceWithConfigure = &SnapshotChangelistResource{}
+var _ resource.ResourceWithImportState = &SnapshotChangelistResource{}
+
+// NewSnapshotChangelistResource creates a new resource.
+func NewSnapshotChangelistResource() resource.Resource {
+	return &SnapshotChangelistResource{}
+}
+
+// SnapshotChangelistResource defines the resource implementation.
+type SnapshotChangelistResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SnapshotChangelistResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_changelist"
+}
+
+// Schema describes the resource arguments.
+func (r *SnapshotChangelistResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the snapshot changelists of PowerScale Array. " +
+			"A changelist records the files and directories changed between two snapshots of the same path, and is created by running the ChangelistCreate job. " +
+			"We can Create and Delete the snapshot changelists using this resource. We can also import an existing snapshot changelist from PowerScale array. " +
+			"The entries of the changelist can be read with the powerscale_snapshot_changelist_entry datasource.",
+		Description: "This resource is used to manage the snapshot changelists of PowerScale Array. " +
+			"A changelist records the files and directories changed between two snapshots of the same path, and is created by running the ChangelistCreate job. " +
+			"We can Create and Delete the snapshot changelists using this resource. We can also import an existing snapshot changelist from PowerScale array. " +
+			"The entries of the changelist can be read with the powerscale_snapshot_changelist_entry datasource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the snapshot changelist, in the format <older snapshot ID>_<newer snapshot ID>.",
+				MarkdownDescription: "The ID of the snapshot changelist, in the format <older snapshot ID>_<newer snapshot ID>.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"older_snapshot": schema.StringAttribute{
+				Description:         "The name or ID of the older snapshot. Cannot be updated.",
+				MarkdownDescription: "The name or ID of the older snapshot. Cannot be updated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"newer_snapshot": schema.StringAttribute{
+				Description:         "The name or ID of the newer snapshot. Cannot be updated.",
+				MarkdownDescription: "The name or ID of the newer snapshot. Cannot be updated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"older_snapshot_id": schema.Int64Attribute{
+				Description:         "The ID of the older snapshot.",
+				MarkdownDescription: "The ID of the older snapshot.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"newer_snapshot_id": schema.Int64Attribute{
+				Description:         "The ID of the newer snapshot.",
+				MarkdownDescription: "The ID of the newer snapshot.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"poll_interval": schema.Int64Attribute{
+				Description:         "Seconds to wait between two polls of the ChangelistCreate job.",
+				MarkdownDescription: "Seconds to wait between two polls of the ChangelistCreate job.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(helper.SnapshotChangelistDefaultPollInterval),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "Seconds to wait for the ChangelistCreate job to finish. " +
+					"Creating the changelist fails if the job does not succeed within the timeout, or if it is cancelled or paused.",
+				MarkdownDescription: "Seconds to wait for the ChangelistCreate job to finish. " +
+					"Creating the changelist fails if the job does not succeed within the timeout, or if it is cancelled or paused.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(helper.SnapshotChangelistDefaultTimeout),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"job_id": schema.Int64Attribute{
+				Description:         "The ID of the ChangelistCreate job which created the changelist. Not set for an imported changelist.",
+				MarkdownDescription: "The ID of the ChangelistCreate job which created the changelist. Not set for an imported changelist.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"num_entries": schema.Int64Attribute{
+				Description:         "The number of entries in the changelist.",
+				MarkdownDescription: "The number of entries in the changelist.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"root": schema.StringAttribute{
+				Description:         "The root path of the changelist.",
+				MarkdownDescription: "The root path of the changelist.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SnapshotChangelistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *SnapshotChangelistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating snapshot changelist")
+
+	var plan models.SnapshotChangelistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var snapshotIDs [2]int64
+	for i, snapshot := range []string{plan.OlderSnapshot.ValueString(), plan.NewerSnapshot.ValueString()} {
+		response, err := helper.GetSpecificSnapshot(ctx, r.client, snapshot)
+		if err != nil {
+			errStr := constants.ReadSnapshotErrorMessage + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError(fmt.Sprintf("Error getting the snapshot %s", snapshot), message)
+			return
+		}
+		snapshotIDs[i] = int64(response.Id)
+	}
+
+	interval := time.Duration(plan.PollInterval.ValueInt64()) * time.Second
+	timeout := time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	jobID, err := helper.CreateSnapshotChangelist(ctx, r.client, snapshotIDs[0], snapshotIDs[1], interval, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating snapshot changelist", err.Error())
+		return
+	}
+	plan.JobID = types.Int64Value(jobID)
+
+	changelist, _, err := helper.GetSnapshotChangelist(ctx, r.client, helper.GetSnapshotChangelistID(snapshotIDs[0], snapshotIDs[1]))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating snapshot changelist", err.Error())
+		return
+	}
+	if err := helper.UpdateSnapshotChangelistState(&plan, changelist); err != nil {
+		resp.Diagnostics.AddError("Error creating snapshot changelist", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create snapshot changelist")
+}
+
+// Read reads the resource state.
+func (r *SnapshotChangelistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading snapshot changelist")
+
+	var state models.SnapshotChangelistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changelist, httpResp, err := helper.GetSnapshotChangelist(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		// the changelist is removed along with its snapshots, so it has to be recreated
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("Snapshot changelist %s not found, removing it from state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading snapshot changelist", err.Error())
+		return
+	}
+	if err := helper.UpdateSnapshotChangelistState(&state, changelist); err != nil {
+		resp.Diagnostics.AddError("Error reading snapshot changelist", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read snapshot changelist")
+}
+
+// Update updates the resource state. All the arguments require replacement, so there is nothing to update on the array.
+func (r *SnapshotChangelistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating snapshot changelist")
+
+	var plan models.SnapshotChangelistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update snapshot changelist")
+}
+
+// Delete deletes the resource.
+func (r *SnapshotChangelistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting snapshot changelist")
+
+	var state models.SnapshotChangelistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteSnapshotChangelist(ctx, r.client, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting snapshot changelist", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete snapshot changelist")
+}
+
+// ImportState imports the resource state by the snapshot changelist ID.
+func (r *SnapshotChangelistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing snapshot changelist")
+
+	changelist, _, err := helper.GetSnapshotChangelist(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing snapshot changelist", err.Error())
+		return
+	}
+
+	state := models.SnapshotChangelistResourceModel{
+		JobID: types.Int64Null(),
+	}
+	if err := helper.UpdateSnapshotChangelistState(&state, changelist); err != nil {
+		resp.Diagnostics.AddError("Error importing snapshot changelist", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import snapshot changelist")
+}
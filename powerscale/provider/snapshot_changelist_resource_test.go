@@ -0,0 +1,216 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSnapshotChangelistResource(t *testing.T) {
+	var snapshotChangelistResourceName = "powerscale_snapshot_changelist.changelist_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + SnapshotChangelistResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotChangelistResourceName, "older_snapshot", "tfacc_changelist_snapshot_1"),
+					resource.TestCheckResourceAttr(snapshotChangelistResourceName, "newer_snapshot", "tfacc_changelist_snapshot_2"),
+					resource.TestCheckResourceAttrPair(snapshotChangelistResourceName, "older_snapshot_id", "powerscale_snapshot.changelist_snap_1", "id"),
+					resource.TestCheckResourceAttrPair(snapshotChangelistResourceName, "newer_snapshot_id", "powerscale_snapshot.changelist_snap_2", "id"),
+					resource.TestCheckResourceAttr(snapshotChangelistResourceName, "root", "/ifs/tfacc_file_system_test"),
+					resource.TestCheckResourceAttrSet(snapshotChangelistResourceName, "job_id"),
+					resource.TestCheckResourceAttrSet(snapshotChangelistResourceName, "num_entries"),
+					resource.TestCheckResourceAttrSet(snapshotChangelistResourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: snapshotChangelistResourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources[snapshotChangelistResourceName].Primary.ID, nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "/ifs/tfacc_file_system_test", states[0].Attributes["root"])
+					assert.Equal(t, states[0].Attributes["older_snapshot_id"], states[0].Attributes["older_snapshot"])
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccSnapshotChangelistResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateSnapshotChangelist).Return(int64(0), fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotChangelistResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Read after create error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetSnapshotChangelist).Return(nil, nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotChangelistResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Import error
+			{
+				Config:        ProviderConfig + SnapshotChangelistResourceConfig,
+				ResourceName:  "powerscale_snapshot_changelist.changelist_test",
+				ImportState:   true,
+				ImportStateId: "1_2",
+				ExpectError:   regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SnapshotChangelistResourceConfig,
+			},
+			// Delete error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.DeleteSnapshotChangelist).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotChangelistResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SnapshotChangelistResourceConfig,
+			},
+		},
+	})
+}
+
+func TestAccSnapshotChangelistResourceJobNotSucceeded(t *testing.T) {
+	mockJob := func(state string) {
+		if FunctionMocker != nil {
+			FunctionMocker.Release()
+		}
+		if FunctionMocker2 != nil {
+			FunctionMocker2.Release()
+		}
+		FunctionMocker = Mock(helper.CreateSnapshotRestoreJob).Return(&powerscale.Createv1JobJobResponse{Id: 1}, nil).Build()
+		FunctionMocker2 = Mock(helper.GetSnapshotRestoreJob).Return(&powerscale.V10JobJobExtended{State: state}, nil).Build()
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// cancelled and paused jobs stop waiting
+			{
+				PreConfig: func() {
+					mockJob("cancelled_user")
+				},
+				Config:      ProviderConfig + SnapshotChangelistResourceConfig,
+				ExpectError: regexp.MustCompile(`.*stopped in state cancelled_user*.`),
+			},
+			{
+				PreConfig: func() {
+					mockJob("paused_system")
+				},
+				Config:      ProviderConfig + SnapshotChangelistResourceConfig,
+				ExpectError: regexp.MustCompile(`.*stopped in state paused_system*.`),
+			},
+			// running job reaches the timeout
+			{
+				PreConfig: func() {
+					mockJob("running")
+				},
+				Config:      ProviderConfig + SnapshotChangelistTimeoutResourceConfig,
+				ExpectError: regexp.MustCompile(`.*is still running after*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker2.Release()
+				},
+				Config: ProviderConfig + SnapshotChangelistResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_snapshot_changelist.changelist_test", "poll_interval", "5"),
+					resource.TestCheckResourceAttr("powerscale_snapshot_changelist.changelist_test", "timeout", "3600"),
+				),
+			},
+		},
+	})
+}
+
+var SnapshotChangelistResourceConfig = FileSystemResourceConfigCommon + `
+resource "powerscale_snapshot" "changelist_snap_1" {
+	path = "/ifs/tfacc_file_system_test"
+	name = "tfacc_changelist_snapshot_1"
+	depends_on = [powerscale_filesystem.file_system_test]
+}
+
+resource "powerscale_snapshot" "changelist_snap_2" {
+	path = "/ifs/tfacc_file_system_test"
+	name = "tfacc_changelist_snapshot_2"
+	depends_on = [powerscale_snapshot.changelist_snap_1]
+}
+
+resource "powerscale_snapshot_changelist" "changelist_test" {
+	older_snapshot = powerscale_snapshot.changelist_snap_1.name
+	newer_snapshot = powerscale_snapshot.changelist_snap_2.name
+}
+`
+
+var SnapshotChangelistTimeoutResourceConfig = FileSystemResourceConfigCommon + `
+resource "powerscale_snapshot" "changelist_snap_1" {
+	path = "/ifs/tfacc_file_system_test"
+	name = "tfacc_changelist_snapshot_1"
+	depends_on = [powerscale_filesystem.file_system_test]
+}
+
+resource "powerscale_snapshot" "changelist_snap_2" {
+	path = "/ifs/tfacc_file_system_test"
+	name = "tfacc_changelist_snapshot_2"
+	depends_on = [powerscale_snapshot.changelist_snap_1]
+}
+
+resource "powerscale_snapshot_changelist" "changelist_test" {
+	older_snapshot = powerscale_snapshot.changelist_snap_1.name
+	newer_snapshot = powerscale_snapshot.changelist_snap_2.name
+	poll_interval  = 1
+	timeout        = 1
+}
+`
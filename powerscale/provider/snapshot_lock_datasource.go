@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SnapshotLockDataSource{}
+
+// NewSnapshotLockDataSource creates a new data source.
+func NewSnapshotLockDataSource() datasource.DataSource {
+	return &SnapshotLockDataSource{}
+}
+
+// SnapshotLockDataSource defines the data source implementation.
+type SnapshotLockDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SnapshotLockDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_lock"
+}
+
+// Schema describes the data source arguments.
+func (d *SnapshotLockDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the locks of the existing snapshots from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the locks of the existing snapshots from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Snapshot Lock datasource.",
+				MarkdownDescription: "Identifier of the Snapshot Lock datasource.",
+				Computed:            true,
+			},
+			"snapshot_locks_details": schema.ListNestedAttribute{
+				Description:         "List of snapshot locks.",
+				MarkdownDescription: "List of snapshot locks.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "The ID of the snapshot lock.",
+							MarkdownDescription: "The ID of the snapshot lock.",
+							Computed:            true,
+						},
+						"snapshot_id": schema.StringAttribute{
+							Description:         "The ID of the locked snapshot.",
+							MarkdownDescription: "The ID of the locked snapshot.",
+							Computed:            true,
+						},
+						"snapshot_name": schema.StringAttribute{
+							Description:         "The name of the locked snapshot.",
+							MarkdownDescription: "The name of the locked snapshot.",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							Description:         "The comment of the snapshot lock.",
+							MarkdownDescription: "The comment of the snapshot lock.",
+							Computed:            true,
+						},
+						"expires": schema.Int64Attribute{
+							Description:         "The Unix Epoch time the snapshot lock expires, 0 if it never expires.",
+							MarkdownDescription: "The Unix Epoch time the snapshot lock expires, 0 if it never expires.",
+							Computed:            true,
+						},
+						"lock_count": schema.Int64Attribute{
+							Description:         "The number of times the snapshot lock is held.",
+							MarkdownDescription: "The number of times the snapshot lock is held.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"snapshots": schema.SetAttribute{
+						Description:         "Names or IDs of the snapshots to list the locks of. If not set, the locks of all the locked snapshots are listed.",
+						MarkdownDescription: "Names or IDs of the snapshots to list the locks of. If not set, the locks of all the locked snapshots are listed.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SnapshotLockDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SnapshotLockDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading snapshot lock data source")
+
+	var state models.SnapshotLockDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.ManageDataSourceSnapshotLock(ctx, d.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading snapshot lock data source ")
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSnapshotLockDataSource(t *testing.T) {
+	var snapshotLockTerraformName = "data.powerscale_snapshot_lock.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read the locks of all the locked snapshots
+			{
+				Config: ProviderConfig + SnapshotLockAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotLockTerraformName, "id", "snapshot_lock_datasource"),
+					resource.TestCheckResourceAttrSet(snapshotLockTerraformName, "snapshot_locks_details.#"),
+				),
+			},
+			// Filter by snapshot
+			{
+				Config: ProviderConfig + SnapshotLockFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotLockTerraformName, "snapshot_locks_details.#", "1"),
+					resource.TestCheckResourceAttr(snapshotLockTerraformName, "snapshot_locks_details.0.snapshot_name", "tfacc_lock_snapshot"),
+					resource.TestCheckResourceAttr(snapshotLockTerraformName, "snapshot_locks_details.0.comment", "tfacc backup in progress"),
+					resource.TestCheckResourceAttrPair(snapshotLockTerraformName, "snapshot_locks_details.0.id", "powerscale_snapshot_lock.lock_test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotLockDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListSnapshotLocks).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotLockFilterDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config:      ProviderConfig + SnapshotLockInvalidDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*Error getting the snapshot*.`),
+			},
+		},
+	})
+}
+
+var SnapshotLockAllDataSourceConfig = `
+data "powerscale_snapshot_lock" "test" {
+}
+`
+
+var SnapshotLockFilterDataSourceConfig = SnapshotLockResourceConfig + `
+data "powerscale_snapshot_lock" "test" {
+	filter {
+		snapshots = [powerscale_snapshot_lock.lock_test.snapshot_id]
+	}
+}
+`
+
+var SnapshotLockInvalidDataSourceConfig = `
+data "powerscale_snapshot_lock" "test" {
+	filter {
+		snapshots = ["tfacc_invalid_snapshot"]
+	}
+}
+`
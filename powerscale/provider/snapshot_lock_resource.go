@@ -0,0 +1,303 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SnapshotLockResource{}
+var _ resource.ResourceWithConfigure = &SnapshotLockResource{}
+var _ resource.ResourceWithImportState = &SnapshotLockResource{}
+
+// NewSnapshotLockResource creates a new resource.
+func NewSnapshotLockResource() resource.Resource {
+	return &SnapshotLockResource{}
+}
+
+// SnapshotLockResource defines the resource implementation.
+type SnapshotLockResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SnapshotLockResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_lock"
+}
+
+// Schema describes the resource arguments.
+func (r *SnapshotLockResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the locks of the snapshots of PowerScale Array. " +
+			"A locked snapshot cannot be deleted, which allows holding a snapshot while its data is being copied. " +
+			"We can Create, Update and Delete the snapshot locks using this resource. We can also import an existing snapshot lock from PowerScale array.",
+		Description: "This resource is used to manage the locks of the snapshots of PowerScale Array. " +
+			"A locked snapshot cannot be deleted, which allows holding a snapshot while its data is being copied. " +
+			"We can Create, Update and Delete the snapshot locks using this resource. We can also import an existing snapshot lock from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the snapshot lock.",
+				MarkdownDescription: "The ID of the snapshot lock.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot": schema.StringAttribute{
+				Description:         "The name or ID of the snapshot to lock. Cannot be updated.",
+				MarkdownDescription: "The name or ID of the snapshot to lock. Cannot be updated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description:         "The ID of the locked snapshot.",
+				MarkdownDescription: "The ID of the locked snapshot.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description:         "The comment of the snapshot lock. Cannot be updated.",
+				MarkdownDescription: "The comment of the snapshot lock. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires": schema.Int64Attribute{
+				Description:         "The Unix Epoch time the snapshot lock expires, 0 if it never expires.",
+				MarkdownDescription: "The Unix Epoch time the snapshot lock expires, 0 if it never expires.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, math.MaxInt32),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"lock_count": schema.Int64Attribute{
+				Description:         "The number of times the snapshot lock is held.",
+				MarkdownDescription: "The number of times the snapshot lock is held.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SnapshotLockResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *SnapshotLockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating snapshot lock")
+
+	var plan models.SnapshotLockResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshotID, _, err := helper.GetLockedSnapshot(ctx, r.client, plan.Snapshot.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating snapshot lock", err.Error())
+		return
+	}
+
+	lockID, err := helper.CreateSnapshotLock(ctx, r.client, snapshotID, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating snapshot lock", err.Error())
+		return
+	}
+
+	lock, _, err := helper.GetSnapshotLock(ctx, r.client, snapshotID, lockID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating snapshot lock", err.Error())
+		return
+	}
+	helper.UpdateSnapshotLockState(&plan, snapshotID, lock)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create snapshot lock")
+}
+
+// Read reads the resource state.
+func (r *SnapshotLockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading snapshot lock")
+
+	var state models.SnapshotLockResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lock, httpResp, err := helper.GetSnapshotLock(ctx, r.client, state.SnapshotID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		// the lock has expired or the snapshot is gone, so the lock has to be recreated
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("Snapshot lock %s not found, removing it from state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading snapshot lock", err.Error())
+		return
+	}
+	helper.UpdateSnapshotLockState(&state, state.SnapshotID.ValueString(), lock)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read snapshot lock")
+}
+
+// Update updates the resource state.
+func (r *SnapshotLockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating snapshot lock")
+
+	var plan models.SnapshotLockResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.SnapshotLockResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateSnapshotLock(ctx, r.client, state, plan); err != nil {
+		resp.Diagnostics.AddError("Error updating snapshot lock", err.Error())
+		return
+	}
+
+	lock, _, err := helper.GetSnapshotLock(ctx, r.client, state.SnapshotID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating snapshot lock", err.Error())
+		return
+	}
+	helper.UpdateSnapshotLockState(&plan, state.SnapshotID.ValueString(), lock)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update snapshot lock")
+}
+
+// Delete deletes the resource.
+func (r *SnapshotLockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting snapshot lock")
+
+	var state models.SnapshotLockResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteSnapshotLock(ctx, r.client, state.SnapshotID.ValueString(), state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting snapshot lock", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete snapshot lock")
+}
+
+// ImportState imports the resource state by <snapshot name or ID>:<lock ID>.
+func (r *SnapshotLockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing snapshot lock")
+
+	idx := strings.LastIndex(req.ID, ":")
+	if idx <= 0 || idx == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Error importing snapshot lock",
+			fmt.Sprintf("Invalid import ID %s, the expected format is <snapshot name or ID>:<lock ID>", req.ID),
+		)
+		return
+	}
+	snapshot, lockID := req.ID[:idx], req.ID[idx+1:]
+
+	snapshotID, _, err := helper.GetLockedSnapshot(ctx, r.client, snapshot)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing snapshot lock", err.Error())
+		return
+	}
+
+	lock, _, err := helper.GetSnapshotLock(ctx, r.client, snapshotID, lockID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing snapshot lock", err.Error())
+		return
+	}
+
+	state := models.SnapshotLockResourceModel{
+		Snapshot: types.StringValue(snapshot),
+	}
+	helper.UpdateSnapshotLockState(&state, snapshotID, lock)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import snapshot lock")
+}
@@ -0,0 +1,168 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSnapshotLockResource(t *testing.T) {
+	var snapshotLockResourceName = "powerscale_snapshot_lock.lock_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + SnapshotLockResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotLockResourceName, "snapshot", "tfacc_lock_snapshot"),
+					resource.TestCheckResourceAttr(snapshotLockResourceName, "comment", "tfacc backup in progress"),
+					resource.TestCheckResourceAttr(snapshotLockResourceName, "expires", "0"),
+					resource.TestCheckResourceAttr(snapshotLockResourceName, "lock_count", "1"),
+					resource.TestCheckResourceAttrPair(snapshotLockResourceName, "snapshot_id", "powerscale_snapshot.lock_snap", "id"),
+					resource.TestCheckResourceAttrSet(snapshotLockResourceName, "id"),
+					resource.TestCheckResourceAttr("powerscale_snapshot.lock_snap", "has_locks", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: snapshotLockResourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "tfacc_lock_snapshot:" + s.RootModule().Resources[snapshotLockResourceName].Primary.ID, nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "tfacc_lock_snapshot", states[0].Attributes["snapshot"])
+					assert.Equal(t, "tfacc backup in progress", states[0].Attributes["comment"])
+					return nil
+				},
+			},
+			// Update the expiration
+			{
+				Config: ProviderConfig + SnapshotLockUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotLockResourceName, "expires", "2145916800"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotLockResourceInvalidImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        ProviderConfig + SnapshotLockResourceConfig,
+				ResourceName:  "powerscale_snapshot_lock.lock_test",
+				ImportState:   true,
+				ImportStateId: "tfacc_lock_snapshot",
+				ExpectError:   regexp.MustCompile(`.*Invalid import ID*.`),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotLockResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateSnapshotLock).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotLockResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Read after create error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetSnapshotLock).Return(nil, nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotLockResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SnapshotLockResourceConfig,
+			},
+			// Update error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.UpdateSnapshotLock).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotLockUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Delete error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.DeleteSnapshotLock).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotLockResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SnapshotLockResourceConfig,
+			},
+		},
+	})
+}
+
+var SnapshotLockSnapshotConfig = FileSystemResourceConfigCommon + `
+resource "powerscale_snapshot" "lock_snap" {
+	path = "/ifs/tfacc_file_system_test"
+	name = "tfacc_lock_snapshot"
+	depends_on = [powerscale_filesystem.file_system_test]
+}
+`
+
+var SnapshotLockResourceConfig = SnapshotLockSnapshotConfig + `
+resource "powerscale_snapshot_lock" "lock_test" {
+	snapshot = powerscale_snapshot.lock_snap.name
+	comment = "tfacc backup in progress"
+}
+`
+
+var SnapshotLockUpdateResourceConfig = SnapshotLockSnapshotConfig + `
+resource "powerscale_snapshot_lock" "lock_test" {
+	snapshot = powerscale_snapshot.lock_snap.name
+	comment = "tfacc backup in progress"
+	expires = 2145916800
+}
+`
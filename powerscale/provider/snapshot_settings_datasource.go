@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &SnapshotSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SnapshotSettingsDataSource{}
+)
+
+// NewSnapshotSettingsDataSource creates a new cluster email settings data source.
+func NewSnapshotSettingsDataSource() datasource.DataSource {
+	return &SnapshotSettingsDataSource{}
+}
+
+// SnapshotSettingsDataSource defines the data source implementation.
+type SnapshotSettingsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SnapshotSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_settings"
+}
+
+// Schema describes the data source arguments.
+func (d *SnapshotSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the Snapshot Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the Snapshot Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of Snapshot settings. Readonly. ",
+				MarkdownDescription: "Id of Snapshot settings. Readonly. ",
+			},
+			"autocreate": schema.BoolAttribute{
+				Description:         "True if the scheduled snapshot creation services are on.",
+				MarkdownDescription: "True if the scheduled snapshot creation services are on.",
+				Computed:            true,
+			},
+			"autodelete": schema.BoolAttribute{
+				Description:         "True if the scheduled snapshot deletion services are on.",
+				MarkdownDescription: "True if the scheduled snapshot deletion services are on.",
+				Computed:            true,
+			},
+			"global_visible_accessible": schema.BoolAttribute{
+				Description:         "Global switch for the other accessible and visible settings. When true, the other settings apply, and when false, the .snapshot directory is neither visible nor accessible.",
+				MarkdownDescription: "Global switch for the other accessible and visible settings. When true, the other settings apply, and when false, the .snapshot directory is neither visible nor accessible.",
+				Computed:            true,
+			},
+			"local_root_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible at the root of the local file system.",
+				MarkdownDescription: "True if the .snapshot directory is accessible at the root of the local file system.",
+				Computed:            true,
+			},
+			"local_root_visible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is visible at the root of the local file system.",
+				MarkdownDescription: "True if the .snapshot directory is visible at the root of the local file system.",
+				Computed:            true,
+			},
+			"local_subdir_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible in the subdirectories of the local file system.",
+				MarkdownDescription: "True if the .snapshot directory is accessible in the subdirectories of the local file system.",
+				Computed:            true,
+			},
+			"nfs_root_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible at the root of NFS exports.",
+				MarkdownDescription: "True if the .snapshot directory is accessible at the root of NFS exports.",
+				Computed:            true,
+			},
+			"nfs_root_visible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is visible at the root of NFS exports.",
+				MarkdownDescription: "True if the .snapshot directory is visible at the root of NFS exports.",
+				Computed:            true,
+			},
+			"nfs_subdir_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible in the subdirectories of NFS exports.",
+				MarkdownDescription: "True if the .snapshot directory is accessible in the subdirectories of NFS exports.",
+				Computed:            true,
+			},
+			"reserve": schema.Int64Attribute{
+				Description:         "Percentage of the space to reserve for snapshots.",
+				MarkdownDescription: "Percentage of the space to reserve for snapshots.",
+				Computed:            true,
+			},
+			"service": schema.BoolAttribute{
+				Description:         "True if the system allows snapshot creation.",
+				MarkdownDescription: "True if the system allows snapshot creation.",
+				Computed:            true,
+			},
+			"smb_root_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible at the root of SMB shares.",
+				MarkdownDescription: "True if the .snapshot directory is accessible at the root of SMB shares.",
+				Computed:            true,
+			},
+			"smb_root_visible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is visible at the root of SMB shares.",
+				MarkdownDescription: "True if the .snapshot directory is visible at the root of SMB shares.",
+				Computed:            true,
+			},
+			"smb_subdir_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible in the subdirectories of SMB shares.",
+				MarkdownDescription: "True if the .snapshot directory is accessible in the subdirectories of SMB shares.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SnapshotSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SnapshotSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Snapshot Settings data source ")
+
+	var settingsState models.SnapshotSettingsModel
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &settingsState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshotSettings, err := helper.GetSnapshotSettings(ctx, d.client)
+
+	if err != nil {
+		errStr := constants.ReadSnapshotSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error reading snapshot settings",
+			message,
+		)
+		return
+	}
+
+	err = helper.CopyFields(ctx, snapshotSettings.GetSettings(), &settingsState)
+	if err != nil {
+		resp.Diagnostics.AddError("Error copying fields of snapshot settings datasource", err.Error())
+		return
+	}
+
+	settingsState.ID = types.StringValue("snapshot_settings")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settingsState)...)
+	tflog.Info(ctx, "Done with Read Snapshot Settings data source ")
+}
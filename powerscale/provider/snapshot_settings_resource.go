@@ -0,0 +1,365 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &SnapshotSettingsResource{}
+	_ resource.ResourceWithConfigure = &SnapshotSettingsResource{}
+)
+
+// NewSnapshotSettingsResource creates a new resource.
+func NewSnapshotSettingsResource() resource.Resource {
+	return &SnapshotSettingsResource{}
+}
+
+// SnapshotSettingsResource defines the resource implementation.
+type SnapshotSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (r *SnapshotSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_settings"
+}
+
+// Schema describes the data source arguments.
+func (r *SnapshotSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `This resource is used to manage the Snapshot Settings of PowerScale Array. We can Create, Update and Delete the Snapshot Settings using this resource.  
+Note that, Snapshot Settings is the native functionality of PowerScale. When creating the resource, we actually load Snapshot Settings from PowerScale to the resource.`,
+		Description: `This resource is used to manage the Snapshot Settings of PowerScale Array. We can Create, Update and Delete the Snapshot Settings using this resource.  
+Note that, Snapshot Settings is the native functionality of PowerScale. When creating the resource, we actually load Snapshot Settings from PowerScale to the resource.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of Snapshot settings. Readonly. ",
+				MarkdownDescription: "Id of Snapshot settings. Readonly. ",
+			},
+			"autocreate": schema.BoolAttribute{
+				Description:         "True if the scheduled snapshot creation services are on.",
+				MarkdownDescription: "True if the scheduled snapshot creation services are on.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"autodelete": schema.BoolAttribute{
+				Description:         "True if the scheduled snapshot deletion services are on.",
+				MarkdownDescription: "True if the scheduled snapshot deletion services are on.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"global_visible_accessible": schema.BoolAttribute{
+				Description:         "Global switch for the other accessible and visible settings. When true, the other settings apply, and when false, the .snapshot directory is neither visible nor accessible.",
+				MarkdownDescription: "Global switch for the other accessible and visible settings. When true, the other settings apply, and when false, the .snapshot directory is neither visible nor accessible.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"local_root_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible at the root of the local file system.",
+				MarkdownDescription: "True if the .snapshot directory is accessible at the root of the local file system.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"local_root_visible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is visible at the root of the local file system.",
+				MarkdownDescription: "True if the .snapshot directory is visible at the root of the local file system.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"local_subdir_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible in the subdirectories of the local file system.",
+				MarkdownDescription: "True if the .snapshot directory is accessible in the subdirectories of the local file system.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"nfs_root_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible at the root of NFS exports.",
+				MarkdownDescription: "True if the .snapshot directory is accessible at the root of NFS exports.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"nfs_root_visible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is visible at the root of NFS exports.",
+				MarkdownDescription: "True if the .snapshot directory is visible at the root of NFS exports.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"nfs_subdir_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible in the subdirectories of NFS exports.",
+				MarkdownDescription: "True if the .snapshot directory is accessible in the subdirectories of NFS exports.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"reserve": schema.Int64Attribute{
+				Description:         "Percentage of the space to reserve for snapshots.",
+				MarkdownDescription: "Percentage of the space to reserve for snapshots.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"service": schema.BoolAttribute{
+				Description:         "True if the system allows snapshot creation.",
+				MarkdownDescription: "True if the system allows snapshot creation.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"smb_root_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible at the root of SMB shares.",
+				MarkdownDescription: "True if the .snapshot directory is accessible at the root of SMB shares.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"smb_root_visible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is visible at the root of SMB shares.",
+				MarkdownDescription: "True if the .snapshot directory is visible at the root of SMB shares.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"smb_subdir_accessible": schema.BoolAttribute{
+				Description:         "True if the .snapshot directory is accessible in the subdirectories of SMB shares.",
+				MarkdownDescription: "True if the .snapshot directory is accessible in the subdirectories of SMB shares.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SnapshotSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *SnapshotSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Snapshot Settings resource...")
+
+	var plan models.SnapshotSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V1SnapshotSettingsExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateSnapshotSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating snapshot settings",
+			fmt.Sprintf("Could not read snapshot settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateSnapshotSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateSnapshotSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating snapshot settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetSnapshotSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadSnapshotSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading snapshot settings", message)
+		return
+	}
+
+	var state models.SnapshotSettingsModel
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of snapshot settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("snapshot_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Create snapshot settings resource")
+}
+
+// Read reads the resource state.
+func (r *SnapshotSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading Snapshot Settings resource")
+
+	var state models.SnapshotSettingsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := helper.GetSnapshotSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadSnapshotSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading snapshot settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of snapshot settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("snapshot_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read snapshot settings resource")
+}
+
+// Update updates the resource state.
+func (r *SnapshotSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Snapshot Settings resource...")
+
+	var plan models.SnapshotSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.SnapshotSettingsModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V1SnapshotSettingsExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateSnapshotSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating snapshot settings",
+			fmt.Sprintf("Could not read snapshot settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateSnapshotSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateSnapshotSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating snapshot settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetSnapshotSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadSnapshotSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading snapshot settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of snapshot settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("snapshot_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Update snapshot settings resource")
+}
+
+// Delete deletes the resource.
+func (r *SnapshotSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Snapshot Settings resource")
+	var state models.SnapshotSettingsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Snapshot settings is the native functionality that cannot be deleted, so just remove state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete snapshot settings resource")
+}
+
+// ImportState imports the resource state.
+func (r *SnapshotSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Snapshot Settings resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
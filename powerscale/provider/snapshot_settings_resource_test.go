@@ -0,0 +1,240 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"github.com/bytedance/mockey"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSnapshotSettingsImport(t *testing.T) {
+	var snapshotSettings = "powerscale_snapshot_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + snapshotSettingsResourceConfig,
+			},
+			// Import testing
+			{
+				ResourceName: snapshotSettings,
+				ImportState:  true,
+				ExpectError:  nil,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					resource.TestCheckResourceAttrSet(snapshotSettings, "id")
+					resource.TestCheckResourceAttrSet(snapshotSettings, "service")
+					resource.TestCheckResourceAttrSet(snapshotSettings, "autocreate")
+					resource.TestCheckResourceAttrSet(snapshotSettings, "autodelete")
+					resource.TestCheckResourceAttrSet(snapshotSettings, "global_visible_accessible")
+					resource.TestCheckResourceAttrSet(snapshotSettings, "smb_root_visible")
+					resource.TestCheckResourceAttrSet(snapshotSettings, "reserve")
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccSnapshotSettingsUpdate(t *testing.T) {
+	var snapshotSettings = "powerscale_snapshot_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + snapshotSettingsResourceConfig,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + snapshotSettingsUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotSettings, "smb_root_accessible", "false"),
+					resource.TestCheckResourceAttr(snapshotSettings, "smb_root_visible", "false"),
+					resource.TestCheckResourceAttr(snapshotSettings, "smb_subdir_accessible", "false"),
+					resource.TestCheckResourceAttr(snapshotSettings, "nfs_root_visible", "false"),
+					resource.TestCheckResourceAttr(snapshotSettings, "reserve", "5"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + snapshotSettingsUpdateRevertResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotSettings, "smb_root_accessible", "true"),
+					resource.TestCheckResourceAttr(snapshotSettings, "smb_root_visible", "true"),
+					resource.TestCheckResourceAttr(snapshotSettings, "smb_subdir_accessible", "true"),
+					resource.TestCheckResourceAttr(snapshotSettings, "nfs_root_visible", "true"),
+					resource.TestCheckResourceAttr(snapshotSettings, "reserve", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotSettingsCreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetSnapshotSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + snapshotSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateSnapshotSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + snapshotSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + snapshotSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + snapshotSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotSettingsUpdateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + snapshotSettingsResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetSnapshotSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + snapshotSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateSnapshotSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + snapshotSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + snapshotSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + snapshotSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotSettingsImportMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + snapshotSettingsResourceConfig,
+			},
+			// Import and read Error testing
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSnapshotSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:            ProviderConfig + snapshotSettingsResourceConfig,
+				ResourceName:      "powerscale_snapshot_settings.test",
+				ImportState:       true,
+				ExpectError:       regexp.MustCompile(`.*mock error*.`),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var snapshotSettingsResourceConfig = `
+resource "powerscale_snapshot_settings" "test" {
+
+}
+`
+
+var snapshotSettingsUpdateResourceConfig = `
+resource "powerscale_snapshot_settings" "test" {
+	smb_root_accessible = false
+	smb_root_visible = false
+	smb_subdir_accessible = false
+	nfs_root_visible = false
+	reserve = 5
+}
+`
+
+var snapshotSettingsUpdateRevertResourceConfig = `
+resource "powerscale_snapshot_settings" "test" {
+	smb_root_accessible = true
+	smb_root_visible = true
+	smb_subdir_accessible = true
+	nfs_root_visible = true
+	reserve = 0
+}
+`
@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StoragepoolCompatibilityResource{}
+var _ resource.ResourceWithConfigure = &StoragepoolCompatibilityResource{}
+var _ resource.ResourceWithImportState = &StoragepoolCompatibilityResource{}
+
+// NewStoragepoolCompatibilityResource creates a new resource.
+func NewStoragepoolCompatibilityResource() resource.Resource {
+	return &StoragepoolCompatibilityResource{}
+}
+
+// StoragepoolCompatibilityResource defines the resource implementation.
+type StoragepoolCompatibilityResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *StoragepoolCompatibilityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storagepool_compatibility"
+}
+
+// Schema describes the resource arguments.
+func (r *StoragepoolCompatibilityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the node class compatibilities of PowerScale Array. We can Create and Delete the compatibilities using this resource. " +
+			"A compatibility lets the nodes of two compatible node classes be provisioned into the same node pool. Any change to the compatibility recreates it.",
+		Description: "This resource is used to manage the node class compatibilities of PowerScale Array. We can Create and Delete the compatibilities using this resource. " +
+			"A compatibility lets the nodes of two compatible node classes be provisioned into the same node pool. Any change to the compatibility recreates it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The system ID given to the compatibility.",
+				MarkdownDescription: "The system ID given to the compatibility.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"class_1": schema.StringAttribute{
+				Description:         "The first node class of the compatibility.",
+				MarkdownDescription: "The first node class of the compatibility.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"class_2": schema.StringAttribute{
+				Description:         "The second node class of the compatibility.",
+				MarkdownDescription: "The second node class of the compatibility.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *StoragepoolCompatibilityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *StoragepoolCompatibilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating storagepool compatibility")
+
+	var plan models.StoragepoolCompatibilityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compatibilityID, err := helper.CreateStoragepoolCompatibility(ctx, r.client, plan)
+	if err != nil {
+		errStr := constants.CreateStoragepoolCompatibilityErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating storagepool compatibility", message)
+		return
+	}
+
+	compatibility, _, err := helper.GetStoragepoolCompatibility(ctx, r.client, compatibilityID)
+	if err != nil {
+		errStr := constants.ReadStoragepoolCompatibilityErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating storagepool compatibility", message)
+		return
+	}
+	if err := helper.UpdateStoragepoolCompatibilityState(ctx, compatibility, &plan); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of storagepool compatibility resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create storagepool compatibility")
+}
+
+// Read reads the resource state.
+func (r *StoragepoolCompatibilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading storagepool compatibility")
+
+	var state models.StoragepoolCompatibilityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compatibility, httpResp, err := helper.GetStoragepoolCompatibility(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("Storagepool compatibility %s not found, removing it from state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		errStr := constants.ReadStoragepoolCompatibilityErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading storagepool compatibility", message)
+		return
+	}
+	if err := helper.UpdateStoragepoolCompatibilityState(ctx, compatibility, &state); err != nil {
+		resp.Diagnostics.AddError("Error copying fields of storagepool compatibility resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read storagepool compatibility")
+}
+
+// Update updates the resource state.
+func (r *StoragepoolCompatibilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the attributes require replacement, so there is nothing to update
+	var plan models.StoragepoolCompatibilityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource.
+func (r *StoragepoolCompatibilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting storagepool compatibility")
+
+	var state models.StoragepoolCompatibilityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteStoragepoolCompatibility(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteStoragepoolCompatibilityErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting storagepool compatibility", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete storagepool compatibility")
+}
+
+// ImportState imports the resource state by the ID of the compatibility.
+func (r *StoragepoolCompatibilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
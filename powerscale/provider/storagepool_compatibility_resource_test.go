@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStoragepoolCompatibilityResource(t *testing.T) {
+	var compatibilityTerraformName = "powerscale_storagepool_compatibility.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + StoragepoolCompatibilityResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(compatibilityTerraformName, "id"),
+					resource.TestCheckResourceAttr(compatibilityTerraformName, "class_1", "S200"),
+					resource.TestCheckResourceAttr(compatibilityTerraformName, "class_2", "S210"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      compatibilityTerraformName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStoragepoolCompatibilityResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateStoragepoolCompatibility).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + StoragepoolCompatibilityResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetStoragepoolCompatibility).Return(nil, nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + StoragepoolCompatibilityResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateStoragepoolCompatibilityState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + StoragepoolCompatibilityResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + StoragepoolCompatibilityResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.DeleteStoragepoolCompatibility).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + StoragepoolCompatibilityResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + StoragepoolCompatibilityResourceConfig,
+			},
+		},
+	})
+}
+
+var StoragepoolCompatibilityResourceConfig = `
+resource "powerscale_storagepool_compatibility" "test" {
+	class_1 = "S200"
+	class_2 = "S210"
+}
+`
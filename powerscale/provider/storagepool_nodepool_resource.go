@@ -0,0 +1,389 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StoragepoolNodepoolResource{}
+var _ resource.ResourceWithConfigure = &StoragepoolNodepoolResource{}
+var _ resource.ResourceWithImportState = &StoragepoolNodepoolResource{}
+
+// NewStoragepoolNodepoolResource creates a new resource.
+func NewStoragepoolNodepoolResource() resource.Resource {
+	return &StoragepoolNodepoolResource{}
+}
+
+// StoragepoolNodepoolResource defines the resource implementation.
+type StoragepoolNodepoolResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *StoragepoolNodepoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storagepool_nodepool"
+}
+
+// Schema describes the resource arguments.
+func (r *StoragepoolNodepoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the storagepool nodepool entity of PowerScale Array. We can Create, Update and Delete manual node pools using this resource. " +
+			"The node pools provisioned automatically by the array can be imported to manage their settings, destroying them only removes them from the state.",
+		Description: "This resource is used to manage the storagepool nodepool entity of PowerScale Array. We can Create, Update and Delete manual node pools using this resource. " +
+			"The node pools provisioned automatically by the array can be imported to manage their settings, destroying them only removes them from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description:         "The system ID given to the node pool.",
+				MarkdownDescription: "The system ID given to the node pool.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The node pool name.",
+				MarkdownDescription: "The node pool name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"lnns": schema.ListAttribute{
+				Description:         "The logical node numbers of the nodes that are part of this node pool.",
+				MarkdownDescription: "The logical node numbers of the nodes that are part of this node pool.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"l3": schema.BoolAttribute{
+				Description:         "Use SSDs in this node pool for L3 cache.",
+				MarkdownDescription: "Use SSDs in this node pool for L3 cache.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"protection_policy": schema.StringAttribute{
+				Description:         "The node pool protection policy, such as +2d:1n.",
+				MarkdownDescription: "The node pool protection policy, such as `+2d:1n`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"tier": schema.StringAttribute{
+				Description:         "The name or ID of the node pool's owning tier. The configured value is kept while it refers to the owning tier, otherwise the tier ID is read from the array.",
+				MarkdownDescription: "The name or ID of the node pool's owning tier. The configured value is kept while it refers to the owning tier, otherwise the tier ID is read from the array.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"transfer_limit_pct": schema.Int64Attribute{
+				Description:         "Stop moving files to this pool when this limit is met.",
+				MarkdownDescription: "Stop moving files to this pool when this limit is met.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"transfer_limit_state": schema.StringAttribute{
+				Description:         "How the transfer limit value is being applied.",
+				MarkdownDescription: "How the transfer limit value is being applied.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "disabled", "user"),
+				},
+			},
+			"manual": schema.BoolAttribute{
+				Description:         "Whether the node pool was manually created.",
+				MarkdownDescription: "Whether the node pool was manually created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"l3_status": schema.StringAttribute{
+				Description:         "Whether the L3 cache of the node pool is enabled, disabled or in the middle of a transition.",
+				MarkdownDescription: "Whether the L3 cache of the node pool is enabled, disabled or in the middle of a transition.",
+				Computed:            true,
+			},
+			"node_type_ids": schema.ListAttribute{
+				Description:         "The node type IDs of the node pool.",
+				MarkdownDescription: "The node type IDs of the node pool.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"health_flags": schema.ListAttribute{
+				Description:         "The health status of the node pool.",
+				MarkdownDescription: "The health status of the node pool.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"ssd_usage": schema.SingleNestedAttribute{
+				Description:         "The SSD usage of the node pool.",
+				MarkdownDescription: "The SSD usage of the node pool.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"avail_ssd_bytes": schema.StringAttribute{
+						Description:         "Available SSD bytes.",
+						MarkdownDescription: "Available SSD bytes.",
+						Computed:            true,
+					},
+					"free_ssd_bytes": schema.StringAttribute{
+						Description:         "Free SSD bytes.",
+						MarkdownDescription: "Free SSD bytes.",
+						Computed:            true,
+					},
+					"pct_used_ssd": schema.StringAttribute{
+						Description:         "Percentage of the usable SSD space used.",
+						MarkdownDescription: "Percentage of the usable SSD space used.",
+						Computed:            true,
+					},
+					"total_ssd_bytes": schema.StringAttribute{
+						Description:         "Total SSD bytes.",
+						MarkdownDescription: "Total SSD bytes.",
+						Computed:            true,
+					},
+					"usable_ssd_bytes": schema.StringAttribute{
+						Description:         "Usable SSD bytes.",
+						MarkdownDescription: "Usable SSD bytes.",
+						Computed:            true,
+					},
+					"used_ssd_bytes": schema.StringAttribute{
+						Description:         "Used SSD bytes.",
+						MarkdownDescription: "Used SSD bytes.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *StoragepoolNodepoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *StoragepoolNodepoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating storagepool nodepool")
+
+	var plan models.StoragepoolNodepoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.CreateStoragepoolNodepool(ctx, r.client, plan); err != nil {
+		errStr := constants.CreateStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating storagepool nodepool", message)
+		return
+	}
+
+	// the node pool names are unique, so the created node pool is read back by name
+	nodepool, _, err := helper.GetStoragepoolNodepool(ctx, r.client, plan.Name.ValueString())
+	if err != nil {
+		errStr := constants.ReadStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating storagepool nodepool", message)
+		return
+	}
+
+	resp.Diagnostics.Append(helper.UpdateStoragepoolNodepoolState(ctx, r.client, nodepool, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create storagepool nodepool")
+}
+
+// Read reads the resource state.
+func (r *StoragepoolNodepoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading storagepool nodepool")
+
+	var state models.StoragepoolNodepoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodepoolID := strconv.FormatInt(state.ID.ValueInt64(), 10)
+	nodepool, httpResp, err := helper.GetStoragepoolNodepool(ctx, r.client, nodepoolID)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("Storagepool nodepool %s not found, removing it from state", nodepoolID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		errStr := constants.ReadStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading storagepool nodepool", message)
+		return
+	}
+
+	resp.Diagnostics.Append(helper.UpdateStoragepoolNodepoolState(ctx, r.client, nodepool, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read storagepool nodepool")
+}
+
+// Update updates the resource state.
+func (r *StoragepoolNodepoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating storagepool nodepool")
+
+	var plan models.StoragepoolNodepoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.StoragepoolNodepoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the nodes of an automatically provisioned node pool are managed by the array
+	if !state.Manual.ValueBool() && !plan.Lnns.Equal(state.Lnns) {
+		resp.Diagnostics.AddError(
+			"Error updating storagepool nodepool",
+			fmt.Sprintf("The nodes of node pool %s are provisioned automatically and cannot be updated", state.Name.ValueString()),
+		)
+		return
+	}
+	toUpdate := plan
+	if !state.Manual.ValueBool() {
+		toUpdate.Lnns = types.ListNull(types.Int64Type)
+	}
+
+	if err := helper.UpdateStoragepoolNodepool(ctx, r.client, state.ID.ValueInt64(), toUpdate); err != nil {
+		errStr := constants.UpdateStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating storagepool nodepool", message)
+		return
+	}
+
+	nodepool, _, err := helper.GetStoragepoolNodepool(ctx, r.client, strconv.FormatInt(state.ID.ValueInt64(), 10))
+	if err != nil {
+		errStr := constants.ReadStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating storagepool nodepool", message)
+		return
+	}
+
+	resp.Diagnostics.Append(helper.UpdateStoragepoolNodepoolState(ctx, r.client, nodepool, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update storagepool nodepool")
+}
+
+// Delete deletes the resource.
+func (r *StoragepoolNodepoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting storagepool nodepool")
+
+	var state models.StoragepoolNodepoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only manual node pools can be deleted, the provisioned ones are just removed from the state
+	if state.Manual.ValueBool() {
+		if err := helper.DeleteStoragepoolNodepool(ctx, r.client, state.ID.ValueInt64()); err != nil {
+			errStr := constants.DeleteStoragepoolNodepoolErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error deleting storagepool nodepool", message)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete storagepool nodepool")
+}
+
+// ImportState imports the resource state by the name or ID of the node pool.
+func (r *StoragepoolNodepoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing storagepool nodepool")
+
+	nodepool, _, err := helper.GetStoragepoolNodepool(ctx, r.client, req.ID)
+	if err != nil {
+		errStr := constants.ReadStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing storagepool nodepool", message)
+		return
+	}
+
+	var state models.StoragepoolNodepoolResourceModel
+	resp.Diagnostics.Append(helper.UpdateStoragepoolNodepoolState(ctx, r.client, nodepool, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import storagepool nodepool")
+}
@@ -0,0 +1,237 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccStoragepoolNodepoolResource(t *testing.T) {
+	var nodepoolTerraformName = "powerscale_storagepool_nodepool.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + StoragepoolNodepoolResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(nodepoolTerraformName, "id"),
+					resource.TestCheckResourceAttr(nodepoolTerraformName, "name", "tfacc_nodepool"),
+					resource.TestCheckResourceAttr(nodepoolTerraformName, "manual", "true"),
+					resource.TestCheckResourceAttr(nodepoolTerraformName, "lnns.#", "3"),
+					resource.TestCheckResourceAttr(nodepoolTerraformName, "protection_policy", "+2d:1n"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            nodepoolTerraformName,
+				ImportState:             true,
+				ImportStateId:           "tfacc_nodepool",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ssd_usage"},
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + StoragepoolNodepoolResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(nodepoolTerraformName, "name", "tfacc_nodepool_updated"),
+					resource.TestCheckResourceAttr(nodepoolTerraformName, "protection_policy", "+2n"),
+					resource.TestCheckResourceAttr(nodepoolTerraformName, "transfer_limit_pct", "90"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStoragepoolNodepoolResourceTier(t *testing.T) {
+	var nodepoolTerraformName = "powerscale_storagepool_nodepool.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the tier configured by name is kept
+			{
+				Config: ProviderConfig + StoragepoolNodepoolTierResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(nodepoolTerraformName, "tier", "tfacc_nodepool_tier"),
+				),
+			},
+			// the tier is read back as ID on import
+			{
+				ResourceName:  nodepoolTerraformName,
+				ImportState:   true,
+				ImportStateId: "tfacc_nodepool",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if states[0].Attributes["tier"] == "" {
+						return fmt.Errorf("expected the tier of the imported node pool to be set")
+					}
+					return nil
+				},
+			},
+			// the tier is read from the array when it does not match the configured tier
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetStoragepoolTier).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:             ProviderConfig + StoragepoolNodepoolTierResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + StoragepoolNodepoolTierResourceConfig,
+			},
+		},
+	})
+}
+
+func TestAccStoragepoolNodepoolResourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + StoragepoolNodepoolResourceConfigNoNodes,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value*.`),
+			},
+			{
+				Config:      ProviderConfig + StoragepoolNodepoolResourceConfigInvalidTransferLimit,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value*.`),
+			},
+		},
+	})
+}
+
+func TestAccStoragepoolNodepoolResourceMockErr(t *testing.T) {
+	var diags diag.Diagnostics
+	diags.AddError("mock error", "mock error")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateStoragepoolNodepool).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + StoragepoolNodepoolResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateStoragepoolNodepoolState).Return(diags).Build()
+				},
+				Config:      ProviderConfig + StoragepoolNodepoolResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + StoragepoolNodepoolResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateStoragepoolNodepool).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + StoragepoolNodepoolResourceConfigUpdate,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetStoragepoolNodepool).Return(nil, nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + StoragepoolNodepoolResourceConfigUpdate,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + StoragepoolNodepoolResourceConfig,
+			},
+		},
+	})
+}
+
+var StoragepoolNodepoolResourceConfig = `
+resource "powerscale_storagepool_nodepool" "test" {
+	name = "tfacc_nodepool"
+	lnns = [1, 2, 3]
+	protection_policy = "+2d:1n"
+}
+`
+
+var StoragepoolNodepoolResourceConfigUpdate = `
+resource "powerscale_storagepool_nodepool" "test" {
+	name = "tfacc_nodepool_updated"
+	lnns = [1, 2, 3]
+	protection_policy = "+2n"
+	transfer_limit_pct = 90
+}
+`
+
+var StoragepoolNodepoolTierResourceConfig = `
+resource "powerscale_storagepool_tier" "test" {
+	name = "tfacc_nodepool_tier"
+	children = []
+}
+
+resource "powerscale_storagepool_nodepool" "test" {
+	name = "tfacc_nodepool"
+	lnns = [1, 2, 3]
+	tier = powerscale_storagepool_tier.test.name
+}
+`
+
+var StoragepoolNodepoolResourceConfigNoNodes = `
+resource "powerscale_storagepool_nodepool" "test" {
+	name = "tfacc_nodepool"
+	lnns = []
+}
+`
+
+var StoragepoolNodepoolResourceConfigInvalidTransferLimit = `
+resource "powerscale_storagepool_nodepool" "test" {
+	name = "tfacc_nodepool"
+	lnns = [1, 2, 3]
+	transfer_limit_pct = 110
+}
+`
@@ -0,0 +1,337 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SyncIQFailoverResource{}
+var _ resource.ResourceWithConfigure = &SyncIQFailoverResource{}
+var _ resource.ResourceWithModifyPlan = &SyncIQFailoverResource{}
+
+// NewSyncIQFailoverResource creates a new resource.
+func NewSyncIQFailoverResource() resource.Resource {
+	return &SyncIQFailoverResource{}
+}
+
+// SyncIQFailoverResource defines the resource implementation.
+type SyncIQFailoverResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SyncIQFailoverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_failover"
+}
+
+// Schema describes the resource arguments.
+func (r *SyncIQFailoverResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to fail over a SyncIQ policy to the secondary cluster and fail it back to the primary cluster of PowerScale Array. " +
+			"The resource must use the provider of the secondary (target) cluster, the primary (source) cluster is reached through the peer block. " +
+			"Each step of the sequence starts a replication job and waits for it to finish before the next one starts. " +
+			"If a step fails, the completed steps are recorded in the state and the next apply resumes the sequence. " +
+			"When the resource is replaced after a failed create, the progress of an interrupted failback is read from the target policies of the clusters. " +
+			"Note that the connection details of the peer block, including the password, are stored in the Terraform state. " +
+			"Destroying this resource only removes it from the Terraform state.",
+		Description: "This resource is used to fail over a SyncIQ policy to the secondary cluster and fail it back to the primary cluster of PowerScale Array. " +
+			"The resource must use the provider of the secondary (target) cluster, the primary (source) cluster is reached through the peer block. " +
+			"Each step of the sequence starts a replication job and waits for it to finish before the next one starts. " +
+			"If a step fails, the completed steps are recorded in the state and the next apply resumes the sequence. " +
+			"When the resource is replaced after a failed create, the progress of an interrupted failback is read from the target policies of the clusters. " +
+			"Note that the connection details of the peer block, including the password, are stored in the Terraform state. " +
+			"Destroying this resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the target policy on the secondary cluster.",
+				MarkdownDescription: "The ID of the target policy on the secondary cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Description:         "The name of the SyncIQ policy.",
+				MarkdownDescription: "The name of the SyncIQ policy.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"failover_state": schema.StringAttribute{
+				Description: "The desired failover state of the policy. Acceptable values: source_active, failed_over. " +
+					"failed_over runs allow_write on the secondary cluster. source_active fails back according to failback_mode.",
+				MarkdownDescription: "The desired failover state of the policy. Acceptable values: source_active, failed_over. " +
+					"failed_over runs allow_write on the secondary cluster. source_active fails back according to failback_mode.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SyncIQFailoverSourceActive, helper.SyncIQFailoverFailedOver),
+				},
+			},
+			"failback_mode": schema.StringAttribute{
+				Description: "How to fail back from failed_over to source_active. Acceptable values: resync, revert. " +
+					"resync runs resync_prep on the primary cluster, the mirror policy on the secondary cluster, allow_write of the mirror policy on the primary cluster " +
+					"and resync_prep of the mirror policy on the secondary cluster, so the changes made on the secondary cluster are kept. " +
+					"revert runs allow_write_revert on the secondary cluster and discards the changes made there.",
+				MarkdownDescription: "How to fail back from failed_over to source_active. Acceptable values: resync, revert. " +
+					"resync runs resync_prep on the primary cluster, the mirror policy on the secondary cluster, allow_write of the mirror policy on the primary cluster " +
+					"and resync_prep of the mirror policy on the secondary cluster, so the changes made on the secondary cluster are kept. " +
+					"revert runs allow_write_revert on the secondary cluster and discards the changes made there.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("resync"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("resync", "revert"),
+				},
+			},
+			"poll_interval": schema.Int64Attribute{
+				Description:         "Seconds to wait between two polls of a running job.",
+				MarkdownDescription: "Seconds to wait between two polls of a running job.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Description:         "Seconds to wait for each job to finish.",
+				MarkdownDescription: "Seconds to wait for each job to finish.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3600),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"completed_steps": schema.ListAttribute{
+				Description:         "The steps of an interrupted failover or failback that already completed. They are skipped when the next apply resumes the sequence.",
+				MarkdownDescription: "The steps of an interrupted failover or failback that already completed. They are skipped when the next apply resumes the sequence.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"peer": schema.SingleNestedAttribute{
+				Description: "The connection details of the primary (source) cluster. Usually the same values as the provider alias of the primary cluster. Required to fail back with failback_mode resync. " +
+					"These values, including the password, are stored in the Terraform state, so the state must be protected like the provider credentials.",
+				MarkdownDescription: "The connection details of the primary (source) cluster. Usually the same values as the provider alias of the primary cluster. Required to fail back with failback_mode resync. " +
+					"These values, including the password, are stored in the Terraform state, so the state must be protected like the provider credentials.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						Description:         "The API endpoint of the primary cluster, ex. https://172.17.177.230:8080",
+						MarkdownDescription: "The API endpoint of the primary cluster, ex. https://172.17.177.230:8080",
+						Required:            true,
+					},
+					"username": schema.StringAttribute{
+						Description:         "The username",
+						MarkdownDescription: "The username",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"password": schema.StringAttribute{
+						Description:         "The password. It is stored in the Terraform state.",
+						MarkdownDescription: "The password. It is stored in the Terraform state.",
+						Required:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"insecure": schema.BoolAttribute{
+						Description:         "whether to skip SSL validation",
+						MarkdownDescription: "whether to skip SSL validation",
+						Required:            true,
+					},
+					"auth_type": schema.Int64Attribute{
+						Description:         "what should be the auth type, 0 for basic and 1 for session-based",
+						MarkdownDescription: "what should be the auth type, 0 for basic and 1 for session-based",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.OneOf(0, 1),
+						},
+					},
+					"timeout": schema.Int64Attribute{
+						Description:         "specifies a time limit for requests",
+						MarkdownDescription: "specifies a time limit for requests",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SyncIQFailoverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ModifyPlan clears the completed steps of an abandoned sequence and reports failbacks discarding data.
+func (r *SyncIQFailoverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var state, plan models.SyncIQFailoverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.FailoverState.Equal(state.FailoverState) {
+		if len(state.CompletedSteps.Elements()) > 0 {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("completed_steps"), types.ListValueMust(types.StringType, []attr.Value{}))...)
+		}
+		return
+	}
+	if plan.FailoverState.ValueString() == helper.SyncIQFailoverSourceActive && plan.FailbackMode.ValueString() == "revert" {
+		resp.Diagnostics.AddWarning(
+			"SyncIQ failover will be reverted",
+			fmt.Sprintf("allow_write_revert will be run for policy %s, the changes made on the secondary cluster since the failover will be lost.", plan.Policy.ValueString()),
+		)
+	}
+}
+
+// Create runs the sequence from the current failover state of the policy to the planned one.
+func (r *SyncIQFailoverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating SyncIQ failover resource")
+	var plan models.SyncIQFailoverResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, current, err := helper.GetSyncIQFailoverState(ctx, r.client, plan.Policy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the SyncIQ failover state", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	// resume a failback interrupted by a previous failed create
+	completedSteps, err := helper.GetSyncIQFailoverCompletedSteps(ctx, r.client, plan, current)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the SyncIQ failover state", err.Error())
+		return
+	}
+
+	state, diags := helper.ManageSyncIQFailover(ctx, r.client, plan, current, completedSteps)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating SyncIQ failover resource")
+}
+
+// Read refreshes the failover state of the policy, unless a sequence is in progress.
+func (r *SyncIQFailoverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ failover resource")
+	var state models.SyncIQFailoverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(state.CompletedSteps.Elements()) == 0 {
+		id, current, err := helper.GetSyncIQFailoverState(ctx, r.client, state.Policy.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting the SyncIQ failover state", err.Error())
+			return
+		}
+		state.ID = types.StringValue(id)
+		state.FailoverState = types.StringValue(current)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SyncIQ failover resource")
+}
+
+// Update runs the remaining steps from the failover state in the state to the planned one.
+func (r *SyncIQFailoverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating SyncIQ failover resource")
+	var state, plan models.SyncIQFailoverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var completedSteps []string
+	resp.Diagnostics.Append(state.CompletedSteps.ElementsAs(ctx, &completedSteps, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := helper.ManageSyncIQFailover(ctx, r.client, plan, state.FailoverState.ValueString(), completedSteps)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	tflog.Info(ctx, "Done with updating SyncIQ failover resource")
+}
+
+// Delete removes the resource from the Terraform state.
+func (r *SyncIQFailoverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SyncIQ failover resource")
+	var state models.SyncIQFailoverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting SyncIQ failover resource")
+}
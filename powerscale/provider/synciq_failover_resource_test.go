@@ -0,0 +1,291 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var syncIQFailoverTargetState string
+var syncIQFailoverMirrorTargetState string
+var syncIQFailoverRunSteps []string
+
+func mockSyncIQFailover(failStep string) {
+	FunctionMocker = mockey.Mock(helper.GetSyncIQTargetPolicy).To(func(ctx context.Context, client *client.Client, policy string) (*powerscale.V14TargetPolicy, error) {
+		failoverFailbackState := syncIQFailoverTargetState
+		if strings.HasSuffix(policy, "_mirror") {
+			failoverFailbackState = syncIQFailoverMirrorTargetState
+		}
+		return &powerscale.V14TargetPolicy{
+			Id:                    "f0a2a45b1e6c3b8a7d9e",
+			Name:                  policy,
+			FailoverFailbackState: failoverFailbackState,
+			LastJobState:          "finished",
+		}, nil
+	}).Build()
+	FunctionMocker2 = mockey.Mock(helper.RunSyncIQFailoverStep).To(func(ctx context.Context, client *client.Client, step helper.SyncIQFailoverStep, interval, timeout time.Duration) error {
+		if step.Name == failStep {
+			return fmt.Errorf("mock error")
+		}
+		syncIQFailoverRunSteps = append(syncIQFailoverRunSteps, step.Name)
+		switch step.Name {
+		case "allow_write":
+			syncIQFailoverTargetState = "writes_enabled"
+		case "resync_prep":
+			syncIQFailoverTargetState = "resync_policy_created"
+		case "mirror_allow_write":
+			syncIQFailoverMirrorTargetState = "writes_enabled"
+		case "allow_write_revert":
+			syncIQFailoverTargetState = "writes_disabled"
+		case "mirror_resync_prep":
+			syncIQFailoverTargetState = "writes_disabled"
+			syncIQFailoverMirrorTargetState = "resync_policy_created"
+		}
+		return nil
+	}).Build()
+}
+
+func releaseSyncIQFailoverMocks() {
+	FunctionMocker.Release()
+	FunctionMocker2.Release()
+}
+
+func checkSyncIQFailoverRunSteps(expected ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if !slices.Equal(syncIQFailoverRunSteps, expected) {
+			return fmt.Errorf("expected steps %v to run, got %v", expected, syncIQFailoverRunSteps)
+		}
+		return nil
+	}
+}
+
+func TestAccSyncIQFailoverResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					syncIQFailoverTargetState = "writes_disabled"
+					syncIQFailoverMirrorTargetState = "writes_disabled"
+					syncIQFailoverRunSteps = nil
+					mockSyncIQFailover("")
+				},
+				Config: ProviderConfig + syncIQFailoverConfig("source_active", "resync"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "id", "f0a2a45b1e6c3b8a7d9e"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "failover_state", "source_active"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "completed_steps.#", "0"),
+					checkSyncIQFailoverRunSteps(),
+				),
+			},
+			{
+				Config: ProviderConfig + syncIQFailoverConfig("failed_over", "resync"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "failover_state", "failed_over"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "completed_steps.#", "0"),
+					checkSyncIQFailoverRunSteps("allow_write"),
+				),
+			},
+			{
+				PreConfig: func() {
+					syncIQFailoverRunSteps = nil
+				},
+				Config: ProviderConfig + syncIQFailoverConfig("source_active", "resync"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "failover_state", "source_active"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "completed_steps.#", "0"),
+					checkSyncIQFailoverRunSteps("resync_prep", "mirror_sync", "mirror_allow_write", "mirror_resync_prep"),
+				),
+			},
+			{
+				PreConfig: func() {
+					syncIQFailoverRunSteps = nil
+				},
+				Config: ProviderConfig + syncIQFailoverConfig("failed_over", "revert"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "failover_state", "failed_over"),
+					checkSyncIQFailoverRunSteps("allow_write"),
+				),
+			},
+			{
+				PreConfig: func() {
+					syncIQFailoverRunSteps = nil
+				},
+				Config: ProviderConfig + syncIQFailoverConfig("source_active", "revert"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "failover_state", "source_active"),
+					checkSyncIQFailoverRunSteps("allow_write_revert"),
+				),
+			},
+		},
+	})
+	releaseSyncIQFailoverMocks()
+}
+
+func TestAccSyncIQFailoverResourceResume(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					syncIQFailoverTargetState = "writes_enabled"
+					syncIQFailoverMirrorTargetState = "writes_disabled"
+					syncIQFailoverRunSteps = nil
+					mockSyncIQFailover("mirror_allow_write")
+				},
+				Config: ProviderConfig + syncIQFailoverConfig("failed_over", "resync"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "failover_state", "failed_over"),
+					checkSyncIQFailoverRunSteps(),
+				),
+			},
+			{
+				Config:      ProviderConfig + syncIQFailoverConfig("source_active", "resync"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					releaseSyncIQFailoverMocks()
+					syncIQFailoverRunSteps = nil
+					mockSyncIQFailover("")
+				},
+				Config: ProviderConfig + syncIQFailoverConfig("source_active", "resync"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "failover_state", "source_active"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "completed_steps.#", "0"),
+					checkSyncIQFailoverRunSteps("mirror_allow_write", "mirror_resync_prep"),
+				),
+			},
+		},
+	})
+	releaseSyncIQFailoverMocks()
+}
+
+func TestAccSyncIQFailoverResourceResumeCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the failed create taints the resource
+			{
+				PreConfig: func() {
+					syncIQFailoverTargetState = "writes_enabled"
+					syncIQFailoverMirrorTargetState = "writes_disabled"
+					syncIQFailoverRunSteps = nil
+					mockSyncIQFailover("mirror_sync")
+				},
+				Config:      ProviderConfig + syncIQFailoverConfig("source_active", "resync"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// the replacing resource resumes after resync_prep
+			{
+				PreConfig: func() {
+					releaseSyncIQFailoverMocks()
+					syncIQFailoverRunSteps = nil
+					mockSyncIQFailover("")
+				},
+				Config: ProviderConfig + syncIQFailoverConfig("source_active", "resync"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "failover_state", "source_active"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "completed_steps.#", "0"),
+					checkSyncIQFailoverRunSteps("mirror_sync", "mirror_allow_write", "mirror_resync_prep"),
+				),
+			},
+		},
+	})
+	releaseSyncIQFailoverMocks()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// allow_write of the mirror policy already ran on the primary cluster
+			{
+				PreConfig: func() {
+					syncIQFailoverTargetState = "resync_policy_created"
+					syncIQFailoverMirrorTargetState = "writes_enabled"
+					syncIQFailoverRunSteps = nil
+					mockSyncIQFailover("")
+				},
+				Config: ProviderConfig + syncIQFailoverConfig("source_active", "resync"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "failover_state", "source_active"),
+					checkSyncIQFailoverRunSteps("mirror_resync_prep"),
+				),
+			},
+		},
+	})
+	releaseSyncIQFailoverMocks()
+}
+
+func TestAccSyncIQFailoverResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + syncIQFailoverConfig("invalid", "resync"),
+				ExpectError: regexp.MustCompile(`.*value must be one of*.`),
+			},
+			{
+				Config:      ProviderConfig + syncIQFailoverConfig("failed_over", "invalid"),
+				ExpectError: regexp.MustCompile(`.*value must be one of*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAllSyncIQTargetPolicies).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + syncIQFailoverConfig("failed_over", "resync"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func syncIQFailoverConfig(failoverState, failbackMode string) string {
+	return fmt.Sprintf(`
+resource "powerscale_synciq_failover" "test" {
+	policy         = "tfaccFailoverPolicy"
+	failover_state = "%s"
+	failback_mode  = "%s"
+	poll_interval  = 1
+	peer = {
+		endpoint  = "%s"
+		username  = "%s"
+		password  = "%s"
+		insecure  = true
+		auth_type = 0
+	}
+}
+`, failoverState, failbackMode, powerscaleEndpoint, powerscaleUsername, powerscalePassword)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource = &SyncIQPolicyActionResource{}
+)
+
+// NewSyncIQPolicyActionResource returns the SyncIQ policy action resource object.
+func NewSyncIQPolicyActionResource() resource.Resource {
+	return &SyncIQPolicyActionResource{}
+}
+
+// SyncIQPolicyActionResource defines the resource implementation.
+type SyncIQPolicyActionResource struct {
+	client *client.Client
+}
+
+// Configure configures the resource.
+func (r *SyncIQPolicyActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the resource arguments.
+func (r *SyncIQPolicyActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_policy_action"
+}
+
+// Schema defines the schema for the resource.
+func (r *SyncIQPolicyActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to reset or resolve a conflicted SyncIQ policy on PowerScale Array, and to wait for the policy to become runnable again. " +
+			"The action is performed on Create and Update. Delete only removes the resource from the Terraform state.",
+		Description: "This resource is used to reset or resolve a conflicted SyncIQ policy on PowerScale Array, and to wait for the policy to become runnable again. " +
+			"The action is performed on Create and Update. Delete only removes the resource from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the policy the action was performed on.",
+				MarkdownDescription: "The ID of the policy the action was performed on.",
+				Computed:            true,
+			},
+			"policy": schema.StringAttribute{
+				Description:         "The name or ID of the SyncIQ policy.",
+				MarkdownDescription: "The name or ID of the SyncIQ policy.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "Specifies the action to perform. Acceptable values: reset, resolve. " +
+					"reset discards the incremental sync state of the policy, the next job runs a full replication. " +
+					"resolve clears the conflicted flag of the policy and keeps its incremental sync state.",
+				MarkdownDescription: "Specifies the action to perform. Acceptable values: reset, resolve. " +
+					"reset discards the incremental sync state of the policy, the next job runs a full replication. " +
+					"resolve clears the conflicted flag of the policy and keeps its incremental sync state.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("reset", "resolve"),
+				},
+			},
+			"poll_interval": schema.Int64Attribute{
+				Description:         "Seconds to wait between two polls of the policy.",
+				MarkdownDescription: "Seconds to wait between two polls of the policy.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Description:         "Seconds to wait for the policy to be no longer conflicted.",
+				MarkdownDescription: "Seconds to wait for the policy to be no longer conflicted.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Create performs the action and sets the initial Terraform state.
+func (r *SyncIQPolicyActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating SyncIQ policy action resource state")
+	var plan models.SyncIQPolicyActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageSyncIQPolicyAction(ctx, r.client, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating SyncIQ policy action resource state")
+}
+
+// Read refreshes the Terraform state with the latest value.
+func (r *SyncIQPolicyActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ policy action resource state")
+	var state models.SyncIQPolicyActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SyncIQ policy action resource state")
+}
+
+// Update performs the action again and sets the updated Terraform state.
+func (r *SyncIQPolicyActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating SyncIQ policy action resource state")
+	var plan models.SyncIQPolicyActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageSyncIQPolicyAction(ctx, r.client, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating SyncIQ policy action resource state")
+}
+
+// Delete deletes the resource.
+func (r *SyncIQPolicyActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SyncIQ policy action resource state")
+	var state models.SyncIQPolicyActionResourceModel
+
+	// Read Terraform prior state data into the model
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting SyncIQ policy action resource state")
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSyncIQPolicyActionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + syncIQPolicyActionConfig("resolve"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("powerscale_synciq_policy_action.test", "id", "powerscale_synciq_policy.policy", "id"),
+					resource.TestCheckResourceAttr("powerscale_synciq_policy_action.test", "action", "resolve"),
+					resource.TestCheckResourceAttr("powerscale_synciq_policy_action.test", "poll_interval", "1"),
+				),
+			},
+			{
+				Config: ProviderConfig + syncIQPolicyActionConfig("reset"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_policy_action.test", "action", "reset"),
+					resource.TestCheckResourceAttr("powerscale_synciq_policy.policy", "conflicted", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyncIQPolicyActionResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + syncIQPolicyActionInvalidActionConfig,
+				ExpectError: regexp.MustCompile(`.*value must be one of*.`),
+			},
+			{
+				Config:      ProviderConfig + syncIQPolicyActionInvalidPolicyConfig,
+				ExpectError: regexp.MustCompile(`.*not found*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ResetSyncIQPolicy).Return(fmt.Errorf("mock reset error")).Build()
+				},
+				Config:      ProviderConfig + syncIQPolicyActionConfig("reset"),
+				ExpectError: regexp.MustCompile(`.*mock reset error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.WaitForSyncIQPolicyRunnable).Return(fmt.Errorf("mock wait error")).Build()
+				},
+				Config:      ProviderConfig + syncIQPolicyActionConfig("resolve"),
+				ExpectError: regexp.MustCompile(`.*mock wait error*.`),
+			},
+		},
+	})
+}
+
+func syncIQPolicyActionConfig(action string) string {
+	return fmt.Sprintf(`
+resource "powerscale_synciq_policy" "policy" {
+	name = "tfaccPolicyAction"
+	action = "sync"
+	source_root_path = "/ifs"
+	target_host = "10.10.10.10"
+	target_path = "/ifs/tfaccSinkAction"
+}
+
+resource "powerscale_synciq_policy_action" "test" {
+	policy        = powerscale_synciq_policy.policy.name
+	action        = "%s"
+	poll_interval = 1
+}
+`, action)
+}
+
+var syncIQPolicyActionInvalidActionConfig = `
+resource "powerscale_synciq_policy_action" "test" {
+	policy = "tfaccPolicyAction"
+	action = "invalid"
+}
+`
+
+var syncIQPolicyActionInvalidPolicyConfig = `
+resource "powerscale_synciq_policy_action" "test" {
+	policy = "invalid_synciq_policy"
+	action = "reset"
+}
+`
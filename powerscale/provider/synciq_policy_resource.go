@@ -149,6 +149,15 @@ func (s *synciqPolicyResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	if state.Conflicted.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"SyncIQ Policy is conflicted",
+			fmt.Sprintf("The most recent job of SyncIQ Policy %s encountered an error, and the policy will not start any more scheduled jobs. "+
+				"Use the powerscale_synciq_policy_action resource with action resolve to clear the conflict, "+
+				"or with action reset to discard the sync state and run a full replication on the next job.", state.Name.ValueString()),
+		)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SyncIQRPOStatusDataSource{}
+
+// NewSyncIQRPOStatusDataSource creates a new data source.
+func NewSyncIQRPOStatusDataSource() datasource.DataSource {
+	return &SyncIQRPOStatusDataSource{}
+}
+
+// SyncIQRPOStatusDataSource defines the data source implementation.
+type SyncIQRPOStatusDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SyncIQRPOStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_rpo_status"
+}
+
+// Schema describes the data source arguments.
+func (d *SyncIQRPOStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to evaluate the RPO compliance of the SyncIQ policies of the PowerScale array. For each policy, the time since the last successful job is compared with the rpo_alert of the policy, or with its schedule. The result can be used in Terraform check blocks.",
+		Description:         "This datasource is used to evaluate the RPO compliance of the SyncIQ policies of the PowerScale array. For each policy, the time since the last successful job is compared with the rpo_alert of the policy, or with its schedule. The result can be used in Terraform check blocks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the SyncIQ RPO status datasource.",
+				MarkdownDescription: "Identifier of the SyncIQ RPO status datasource.",
+				Computed:            true,
+			},
+			"breached_policies": schema.ListAttribute{
+				Description:         "Names of the policies whose RPO is breached.",
+				MarkdownDescription: "Names of the policies whose RPO is breached.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"synciq_rpo_status": schema.ListNestedAttribute{
+				Description:         "List of the RPO status of the SyncIQ policies.",
+				MarkdownDescription: "List of the RPO status of the SyncIQ policies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "The unique identifier of the policy.",
+							MarkdownDescription: "The unique identifier of the policy.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "The name of the policy.",
+							MarkdownDescription: "The name of the policy.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description:         "Whether the policy is enabled.",
+							MarkdownDescription: "Whether the policy is enabled.",
+							Computed:            true,
+						},
+						"schedule": schema.StringAttribute{
+							Description:         "The schedule of the policy. Empty for manual policies.",
+							MarkdownDescription: "The schedule of the policy. Empty for manual policies.",
+							Computed:            true,
+						},
+						"rpo_alert": schema.Int64Attribute{
+							Description:         "The RPO alert threshold of the policy in seconds, 0 if not set.",
+							MarkdownDescription: "The RPO alert threshold of the policy in seconds, 0 if not set.",
+							Computed:            true,
+						},
+						"schedule_interval": schema.Int64Attribute{
+							Description:         "The interval in seconds between two jobs derived from the schedule, 0 for manual, event driven or unrecognized schedules.",
+							MarkdownDescription: "The interval in seconds between two jobs derived from the schedule, 0 for manual, event driven or unrecognized schedules.",
+							Computed:            true,
+						},
+						"rpo_threshold": schema.Int64Attribute{
+							Description:         "The threshold in seconds the time since the last successful job is compared with. It is rpo_alert if set, otherwise twice the schedule_interval.",
+							MarkdownDescription: "The threshold in seconds the time since the last successful job is compared with. It is rpo_alert if set, otherwise twice the schedule_interval.",
+							Computed:            true,
+						},
+						"last_job_state": schema.StringAttribute{
+							Description:         "The state of the last job of the policy.",
+							MarkdownDescription: "The state of the last job of the policy.",
+							Computed:            true,
+						},
+						"last_success": schema.Int64Attribute{
+							Description:         "The unix epoch time of the last successful job of the policy.",
+							MarkdownDescription: "The unix epoch time of the last successful job of the policy.",
+							Computed:            true,
+						},
+						"seconds_since_last_success": schema.Int64Attribute{
+							Description:         "Seconds elapsed since the last successful job of the policy.",
+							MarkdownDescription: "Seconds elapsed since the last successful job of the policy.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							Description:         "The evaluated RPO status: ok, breached, never_succeeded, disabled, or not_evaluated if the policy has neither rpo_alert nor a recognized schedule.",
+							MarkdownDescription: "The evaluated RPO status: ok, breached, never_succeeded, disabled, or not_evaluated if the policy has neither rpo_alert nor a recognized schedule.",
+							Computed:            true,
+						},
+						"breached": schema.BoolAttribute{
+							Description:         "Whether the RPO of the policy is breached, i.e. status is breached or never_succeeded.",
+							MarkdownDescription: "Whether the RPO of the policy is breached, i.e. status is breached or never_succeeded.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Description:         "Only evaluate the policies with these names.",
+						MarkdownDescription: "Only evaluate the policies with these names.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SyncIQRPOStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SyncIQRPOStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ RPO status data source")
+
+	var state models.SyncIQRPOStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.ManageDataSourceSyncIQRPOStatus(ctx, d.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SyncIQ RPO status data source ")
+}
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSyncIQRPOStatusDataSource(t *testing.T) {
+	var rpoStatusTerraformName = "data.powerscale_synciq_rpo_status.test"
+	var lastSuccessAgo int64
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read the RPO status of all the policies
+			{
+				Config: ProviderConfig + SyncIQRPOStatusAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rpoStatusTerraformName, "id", "synciq_rpo_status_datasource"),
+					resource.TestCheckResourceAttrSet(rpoStatusTerraformName, "synciq_rpo_status.#"),
+				),
+			},
+			// The last success is older than rpo_alert
+			{
+				PreConfig: func() {
+					lastSuccessAgo = 7200
+					FunctionMocker = mockey.Mock(helper.GetSyncIQLastSuccessTimes).To(func(ctx context.Context, client *client.Client) (map[string]int64, error) {
+						return map[string]int64{"tfaccRPOPolicy": time.Now().Unix() - lastSuccessAgo}, nil
+					}).Build()
+				},
+				Config: ProviderConfig + SyncIQRPOStatusFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rpoStatusTerraformName, "synciq_rpo_status.#", "2"),
+					resource.TestCheckResourceAttr(rpoStatusTerraformName, "breached_policies.#", "1"),
+					resource.TestCheckResourceAttr(rpoStatusTerraformName, "breached_policies.0", "tfaccRPOPolicy"),
+					resource.TestCheckTypeSetElemNestedAttrs(rpoStatusTerraformName, "synciq_rpo_status.*", map[string]string{
+						"name":              "tfaccRPOPolicy",
+						"rpo_alert":         "3600",
+						"schedule_interval": "86400",
+						"rpo_threshold":     "3600",
+						"status":            "breached",
+						"breached":          "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(rpoStatusTerraformName, "synciq_rpo_status.*", map[string]string{
+						"name":          "tfaccRPOManualPolicy",
+						"rpo_threshold": "0",
+						"status":        "not_evaluated",
+						"breached":      "false",
+					}),
+				),
+			},
+			// The last success is within rpo_alert
+			{
+				PreConfig: func() {
+					lastSuccessAgo = 60
+				},
+				Config: ProviderConfig + SyncIQRPOStatusFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rpoStatusTerraformName, "breached_policies.#", "0"),
+					resource.TestCheckTypeSetElemNestedAttrs(rpoStatusTerraformName, "synciq_rpo_status.*", map[string]string{
+						"name":     "tfaccRPOPolicy",
+						"status":   "ok",
+						"breached": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyncIQRPOStatusDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAllSyncIQPolicies).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SyncIQRPOStatusAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetReplicationReports).Return(nil, fmt.Errorf("mock reports error")).Build()
+				},
+				Config:      ProviderConfig + SyncIQRPOStatusAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock reports error*.`),
+			},
+		},
+	})
+}
+
+var SyncIQRPOStatusAllDataSourceConfig = `
+data "powerscale_synciq_rpo_status" "test" {
+}
+`
+
+var SyncIQRPOStatusFilterDataSourceConfig = `
+resource "powerscale_synciq_policy" "rpo" {
+	name = "tfaccRPOPolicy"
+	action = "sync"
+	source_root_path = "/ifs"
+	target_host = "10.10.10.10"
+	target_path = "/ifs/tfaccSinkRPO"
+	schedule = "every 1 days at 12:00 AM"
+	rpo_alert = 3600
+}
+
+resource "powerscale_synciq_policy" "manual" {
+	name = "tfaccRPOManualPolicy"
+	action = "sync"
+	source_root_path = "/ifs"
+	target_host = "10.10.10.10"
+	target_path = "/ifs/tfaccSinkRPOManual"
+}
+
+data "powerscale_synciq_rpo_status" "test" {
+	filter {
+		names = [powerscale_synciq_policy.rpo.name, powerscale_synciq_policy.manual.name]
+	}
+}
+`
@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource = &SyncIQTargetPolicyActionResource{}
+)
+
+// NewSyncIQTargetPolicyActionResource returns the SyncIQ target policy action resource object.
+func NewSyncIQTargetPolicyActionResource() resource.Resource {
+	return &SyncIQTargetPolicyActionResource{}
+}
+
+// SyncIQTargetPolicyActionResource defines the resource implementation.
+type SyncIQTargetPolicyActionResource struct {
+	client *client.Client
+}
+
+// Configure configures the resource.
+func (r *SyncIQTargetPolicyActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the resource arguments.
+func (r *SyncIQTargetPolicyActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_target_policy_action"
+}
+
+// Schema defines the schema for the resource.
+func (r *SyncIQTargetPolicyActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to break the association of a SyncIQ target policy, or to cancel the running job of a SyncIQ policy from the target side on PowerScale Array. " +
+			"The action is performed on Create and Update. Delete only removes the resource from the Terraform state.",
+		Description: "This resource is used to break the association of a SyncIQ target policy, or to cancel the running job of a SyncIQ policy from the target side on PowerScale Array. " +
+			"The action is performed on Create and Update. Delete only removes the resource from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the target policy the action was performed on.",
+				MarkdownDescription: "The ID of the target policy the action was performed on.",
+				Computed:            true,
+			},
+			"policy": schema.StringAttribute{
+				Description:         "The name or ID of the SyncIQ target policy.",
+				MarkdownDescription: "The name or ID of the SyncIQ target policy.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description:         "Specifies the action to perform. Acceptable values: break_association, cancel. break_association makes the target directory writable and the next job of the policy on the source cluster runs a full replication.",
+				MarkdownDescription: "Specifies the action to perform. Acceptable values: break_association, cancel. break_association makes the target directory writable and the next job of the policy on the source cluster runs a full replication.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("break_association", "cancel"),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description:         "If true, the association is broken locally even if the source cluster cannot be contacted. Only applicable when action is break_association.",
+				MarkdownDescription: "If true, the association is broken locally even if the source cluster cannot be contacted. Only applicable when action is break_association.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Create performs the action and sets the initial Terraform state.
+func (r *SyncIQTargetPolicyActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating SyncIQ target policy action resource state")
+	var plan models.SyncIQTargetPolicyActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageSyncIQTargetPolicyAction(ctx, r.client, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating SyncIQ target policy action resource state")
+}
+
+// Read refreshes the Terraform state with the latest value.
+func (r *SyncIQTargetPolicyActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ target policy action resource state")
+	var state models.SyncIQTargetPolicyActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SyncIQ target policy action resource state")
+}
+
+// Update performs the action again and sets the updated Terraform state.
+func (r *SyncIQTargetPolicyActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating SyncIQ target policy action resource state")
+	var plan models.SyncIQTargetPolicyActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.ManageSyncIQTargetPolicyAction(ctx, r.client, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating SyncIQ target policy action resource state")
+}
+
+// Delete deletes the resource.
+func (r *SyncIQTargetPolicyActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SyncIQ target policy action resource state")
+	var state models.SyncIQTargetPolicyActionResourceModel
+
+	// Read Terraform prior state data into the model
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting SyncIQ target policy action resource state")
+}
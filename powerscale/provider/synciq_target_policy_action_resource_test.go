@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSyncIQTargetPolicyActionResource(t *testing.T) {
+	targetPolicy := &powerscale.V14TargetPolicy{
+		Id:   "f0a2a45b1e6c3b8a7d9e",
+		Name: "tfaccTargetPolicy",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetSyncIQTargetPolicy).Return(targetPolicy, nil).Build()
+					FunctionMocker2 = mockey.Mock(helper.CancelSyncIQTargetPolicyJob).Return(nil).Build()
+				},
+				Config: ProviderConfig + syncIQTargetPolicyCancelConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_target_policy_action.test", "id", "f0a2a45b1e6c3b8a7d9e"),
+					resource.TestCheckResourceAttr("powerscale_synciq_target_policy_action.test", "action", "cancel"),
+				),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker2.Release()
+					FunctionMocker2 = mockey.Mock(helper.BreakSyncIQTargetPolicy).Return(nil).Build()
+				},
+				Config: ProviderConfig + syncIQTargetPolicyBreakConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_target_policy_action.test", "action", "break_association"),
+					resource.TestCheckResourceAttr("powerscale_synciq_target_policy_action.test", "force", "true"),
+				),
+			},
+		},
+	})
+	FunctionMocker2.Release()
+}
+
+func TestAccSyncIQTargetPolicyActionResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + syncIQTargetPolicyInvalidActionConfig,
+				ExpectError: regexp.MustCompile(`.*value must be one of*.`),
+			},
+			{
+				Config:      ProviderConfig + syncIQTargetPolicyInvalidPolicyConfig,
+				ExpectError: regexp.MustCompile(`.*not found*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAllSyncIQTargetPolicies).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + syncIQTargetPolicyCancelConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var syncIQTargetPolicyCancelConfig = `
+resource "powerscale_synciq_target_policy_action" "test" {
+	policy = "tfaccTargetPolicy"
+	action = "cancel"
+}
+`
+
+var syncIQTargetPolicyBreakConfig = `
+resource "powerscale_synciq_target_policy_action" "test" {
+	policy = "tfaccTargetPolicy"
+	action = "break_association"
+	force  = true
+}
+`
+
+var syncIQTargetPolicyInvalidActionConfig = `
+resource "powerscale_synciq_target_policy_action" "test" {
+	policy = "tfaccTargetPolicy"
+	action = "invalid"
+}
+`
+
+var syncIQTargetPolicyInvalidPolicyConfig = `
+resource "powerscale_synciq_target_policy_action" "test" {
+	policy = "invalid_target_policy"
+	action = "cancel"
+}
+`
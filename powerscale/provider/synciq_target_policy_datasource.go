@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SyncIQTargetPolicyDataSource{}
+
+// NewSyncIQTargetPolicyDataSource creates a new data source.
+func NewSyncIQTargetPolicyDataSource() datasource.DataSource {
+	return &SyncIQTargetPolicyDataSource{}
+}
+
+// SyncIQTargetPolicyDataSource defines the data source implementation.
+type SyncIQTargetPolicyDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SyncIQTargetPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_target_policy"
+}
+
+// Schema describes the data source arguments.
+func (d *SyncIQTargetPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the SyncIQ policies targeting the PowerScale array, i.e. the target side of the replication. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the SyncIQ policies targeting the PowerScale array, i.e. the target side of the replication. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the SyncIQ Target Policy datasource.",
+				MarkdownDescription: "Identifier of the SyncIQ Target Policy datasource.",
+				Computed:            true,
+			},
+			"synciq_target_policies_details": schema.ListNestedAttribute{
+				Description:         "List of SyncIQ target policies.",
+				MarkdownDescription: "List of SyncIQ target policies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "The unique identifier of the policy.",
+							MarkdownDescription: "The unique identifier of the policy.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "The name of the policy.",
+							MarkdownDescription: "The name of the policy.",
+							Computed:            true,
+						},
+						"source_cluster_guid": schema.StringAttribute{
+							Description:         "The GUID of the source cluster.",
+							MarkdownDescription: "The GUID of the source cluster.",
+							Computed:            true,
+						},
+						"source_cluster_name": schema.StringAttribute{
+							Description:         "The name of the source cluster.",
+							MarkdownDescription: "The name of the source cluster.",
+							Computed:            true,
+						},
+						"source_host": schema.StringAttribute{
+							Description:         "The host of the source cluster.",
+							MarkdownDescription: "The host of the source cluster.",
+							Computed:            true,
+						},
+						"last_source_coordinator_ip": schema.StringAttribute{
+							Description:         "The IP address of the source cluster node that coordinated the last job.",
+							MarkdownDescription: "The IP address of the source cluster node that coordinated the last job.",
+							Computed:            true,
+						},
+						"target_path": schema.StringAttribute{
+							Description:         "The target directory of the policy.",
+							MarkdownDescription: "The target directory of the policy.",
+							Computed:            true,
+						},
+						"last_job_state": schema.StringAttribute{
+							Description:         "The state of the last job of the policy, e.g. finished, failed or running.",
+							MarkdownDescription: "The state of the last job of the policy, e.g. finished, failed or running.",
+							Computed:            true,
+						},
+						"failover_failback_state": schema.StringAttribute{
+							Description:         "The failover-failback state of the target directory, e.g. writes_disabled, enabling_writes, writes_enabled, disabling_writes, creating_resync_policy or resync_policy_created.",
+							MarkdownDescription: "The failover-failback state of the target directory, e.g. writes_disabled, enabling_writes, writes_enabled, disabling_writes, creating_resync_policy or resync_policy_created.",
+							Computed:            true,
+						},
+						"last_update_from_source": schema.Int64Attribute{
+							Description:         "The unix epoch time of the last update from the source cluster.",
+							MarkdownDescription: "The unix epoch time of the last update from the source cluster.",
+							Computed:            true,
+						},
+						"legacy_policy": schema.BoolAttribute{
+							Description:         "Whether the policy is a legacy policy.",
+							MarkdownDescription: "Whether the policy is a legacy policy.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Description:         "Only list the target policies with these names.",
+						MarkdownDescription: "Only list the target policies with these names.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"source_cluster_names": schema.SetAttribute{
+						Description:         "Only list the target policies replicating from these source clusters.",
+						MarkdownDescription: "Only list the target policies replicating from these source clusters.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SyncIQTargetPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SyncIQTargetPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ target policy data source")
+
+	var state models.SyncIQTargetPolicyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.ManageDataSourceSyncIQTargetPolicy(ctx, d.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SyncIQ target policy data source ")
+}
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSyncIQTargetPolicyDataSource(t *testing.T) {
+	var targetPolicyTerraformName = "data.powerscale_synciq_target_policy.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read all the target policies
+			{
+				Config: ProviderConfig + SyncIQTargetPolicyAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(targetPolicyTerraformName, "id", "synciq_target_policy_datasource"),
+					resource.TestCheckResourceAttrSet(targetPolicyTerraformName, "synciq_target_policies_details.#"),
+				),
+			},
+			// Filter by a name which does not exist
+			{
+				Config: ProviderConfig + SyncIQTargetPolicyFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(targetPolicyTerraformName, "synciq_target_policies_details.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyncIQTargetPolicyDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAllSyncIQTargetPolicies).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SyncIQTargetPolicyAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var SyncIQTargetPolicyAllDataSourceConfig = `
+data "powerscale_synciq_target_policy" "test" {
+}
+`
+
+var SyncIQTargetPolicyFilterDataSourceConfig = `
+data "powerscale_synciq_target_policy" "test" {
+	filter {
+		names = ["tfacc_invalid_target_policy"]
+		source_cluster_names = ["tfacc_invalid_cluster"]
+	}
+}
+`
@@ -0,0 +1,310 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &UserGroupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &UserGroupMembershipResource{}
+	_ resource.ResourceWithImportState = &UserGroupMembershipResource{}
+)
+
+// NewUserGroupMembershipResource creates a new resource.
+func NewUserGroupMembershipResource() resource.Resource {
+	return &UserGroupMembershipResource{}
+}
+
+// UserGroupMembershipResource defines the resource implementation.
+type UserGroupMembershipResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *UserGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group_membership"
+}
+
+// Schema describes the resource arguments.
+func (r *UserGroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage a subset of the members of an existing User Group on PowerScale Array. We can Create, Update and Delete the User Group members using this resource. We can also import the given members of an existing User Group from PowerScale array. " +
+			"Only the members listed in this resource are managed, other members of the User Group are left untouched, so that several configurations can add members to the same User Group.",
+		Description: "This resource is used to manage a subset of the members of an existing User Group on PowerScale Array. We can Create, Update and Delete the User Group members using this resource. We can also import the given members of an existing User Group from PowerScale array. " +
+			"Only the members listed in this resource are managed, other members of the User Group are left untouched, so that several configurations can add members to the same User Group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "User Group Membership ID.",
+				MarkdownDescription: "User Group Membership ID.",
+				Computed:            true,
+			},
+			"group": schema.StringAttribute{
+				Description:         "Specifies the name of the existing User Group. Cannot be updated.",
+				MarkdownDescription: "Specifies the name of the existing User Group. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "Specifies the zone that the User Group belongs to. Cannot be updated.",
+				MarkdownDescription: "Specifies the zone that the User Group belongs to. Cannot be updated.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				Description:         "Specifies the user members managed by this resource.",
+				MarkdownDescription: "Specifies the user members managed by this resource.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"groups": schema.SetAttribute{
+				Description:         "Specifies the group members managed by this resource.",
+				MarkdownDescription: "Specifies the group members managed by this resource.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"well_knowns": schema.SetAttribute{
+				Description:         "Specifies the well-known members managed by this resource.",
+				MarkdownDescription: "Specifies the well-known members managed by this resource.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *UserGroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *UserGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating User Group Membership resource state")
+	// Read Terraform plan into the model
+	var plan models.UserGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the user group should exist before adding members
+	if _, err := helper.GetUserGroupWithZone(ctx, r.client, plan.Group.ValueString(), plan.Zone.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error creating the User Group Membership - %s", plan.Group.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	state := models.UserGroupMembershipResourceModel{
+		Group:      plan.Group,
+		Zone:       plan.Zone,
+		Users:      types.SetNull(types.StringType),
+		Groups:     types.SetNull(types.StringType),
+		WellKnowns: types.SetNull(types.StringType),
+	}
+	if diags := helper.UpdateUserGroupMembership(ctx, r.client, &state, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	groupMembers, err := helper.GetAllGroupMembersWithZone(ctx, r.client, plan.Group.ValueString(), plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error getting the members of User Group - %s", plan.Group.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	helper.UpdateUserGroupMembershipState(&plan, groupMembers)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	tflog.Info(ctx, "Done with Create User Group Membership resource state")
+}
+
+// Read reads the resource state.
+func (r *UserGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading User Group Membership resource")
+	var state models.UserGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupMembers, err := helper.GetAllGroupMembersWithZone(ctx, r.client, state.Group.ValueString(), state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error getting the members of User Group - %s", state.Group.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	// members removed outside of terraform are dropped from state, so that they are added again on next apply
+	helper.UpdateUserGroupMembershipState(&state, groupMembers)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Done with Read User Group Membership resource")
+}
+
+// Update updates the resource state.
+func (r *UserGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating User Group Membership resource")
+	var plan models.UserGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.UserGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if diags := helper.UpdateUserGroupMembership(ctx, r.client, &state, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	groupMembers, err := helper.GetAllGroupMembersWithZone(ctx, r.client, plan.Group.ValueString(), plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error getting the members of User Group - %s", plan.Group.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	helper.UpdateUserGroupMembershipState(&plan, groupMembers)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	tflog.Info(ctx, "Done with Update User Group Membership resource")
+}
+
+// Delete deletes the resource.
+func (r *UserGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting User Group Membership resource")
+	var state models.UserGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the managed members are removed from the user group
+	plan := models.UserGroupMembershipResourceModel{
+		Group:      state.Group,
+		Zone:       state.Zone,
+		Users:      types.SetNull(types.StringType),
+		Groups:     types.SetNull(types.StringType),
+		WellKnowns: types.SetNull(types.StringType),
+	}
+	if diags := helper.UpdateUserGroupMembership(ctx, r.client, &state, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete User Group Membership resource")
+}
+
+// ImportState imports the resource state.
+func (r *UserGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing User Group Membership resource")
+	var state models.UserGroupMembershipResourceModel
+
+	//requestID format is [<zoneID>:]<groupName>:<member1>,<member2>
+	params := strings.Split(req.ID, ":")
+	if len(params) < 2 || len(params) > 3 || strings.Trim(params[len(params)-1], " ") == "" {
+		resp.Diagnostics.AddError(
+			"Error importing User Group Membership",
+			fmt.Sprintf("Unexpected import ID %s, the expected format is [<zoneID>:]<groupName>:<member1>,<member2>", req.ID),
+		)
+		return
+	}
+	var zoneID string
+	groupName := strings.Trim(params[len(params)-2], " ")
+	if len(params) == 3 {
+		zoneID = strings.Trim(params[0], " ")
+	}
+	var memberNames []string
+	for _, name := range strings.Split(params[len(params)-1], ",") {
+		if name = strings.Trim(name, " "); name != "" {
+			memberNames = append(memberNames, name)
+		}
+	}
+
+	state.Group = types.StringValue(groupName)
+	state.Zone = types.StringNull()
+	if zoneID != "" {
+		state.Zone = types.StringValue(zoneID)
+	}
+
+	groupMembers, err := helper.GetAllGroupMembersWithZone(ctx, r.client, groupName, zoneID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error getting the members of User Group - %s", groupName),
+			err.Error(),
+		)
+		return
+	}
+
+	// only the members listed in the import ID are taken into state, other members are left unmanaged
+	if err := helper.ImportUserGroupMembershipState(&state, groupMembers, memberNames); err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing User Group Membership",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Done with Import User Group Membership resource")
+}
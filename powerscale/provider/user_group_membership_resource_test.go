@@ -0,0 +1,264 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccUserGroupMembershipResource(t *testing.T) {
+	var membershipResourceName = "powerscale_user_group_membership.team_a"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, two memberships share the same user group
+			{
+				Config: ProviderConfig + userGroupMembershipResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(membershipResourceName, "id", "tfaccMembershipGroup"),
+					resource.TestCheckResourceAttr(membershipResourceName, "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(membershipResourceName, "users.*", "tfaccMembershipUser"),
+					resource.TestCheckResourceAttr("powerscale_user_group_membership.team_b", "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerscale_user_group_membership.team_b", "users.*", "tfaccMembershipUser2"),
+					resource.TestCheckResourceAttr("powerscale_user_group_membership.team_b", "well_knowns.#", "1"),
+				),
+			},
+			// ImportState testing, only the given members are imported
+			{
+				ResourceName:  membershipResourceName,
+				ImportState:   true,
+				ImportStateId: "tfaccMembershipGroup:tfaccMembershipUser",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "tfaccMembershipGroup", states[0].Attributes["group"])
+					assert.Equal(t, "1", states[0].Attributes["users.#"])
+					assert.Equal(t, "tfaccMembershipUser", states[0].Attributes["users.0"])
+					assert.Equal(t, "", states[0].Attributes["well_knowns.#"])
+					return nil
+				},
+			},
+			// ImportState testing, invalid import ID
+			{
+				ResourceName:  membershipResourceName,
+				ImportState:   true,
+				ImportStateId: "tfaccMembershipGroup",
+				ExpectError:   regexp.MustCompile(`.*Unexpected import ID*.`),
+			},
+			// ImportState testing, not a member of the user group
+			{
+				ResourceName:  membershipResourceName,
+				ImportState:   true,
+				ImportStateId: "tfaccMembershipGroup:tfaccInvalidMembershipUser",
+				ExpectError:   regexp.MustCompile(`.*is not a member of user group*.`),
+			},
+			// Update, the members of the other membership are kept
+			{
+				Config: ProviderConfig + userGroupMembershipUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(membershipResourceName, "users.#", "0"),
+					resource.TestCheckResourceAttr(membershipResourceName, "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttr(membershipResourceName, "groups.*", "admin"),
+					resource.TestCheckResourceAttr("powerscale_user_group_membership.team_b", "users.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUpdateUserGroupMembershipState(t *testing.T) {
+	groupMembers := []powerscale.V1AuthAccessAccessItemFileGroup{
+		{Name: helper.New("DOMAIN\\user1"), Type: helper.New("user")},
+		{Name: helper.New("user2"), Type: helper.New("user")},
+		{Name: helper.New("NETWORK"), Type: helper.New("wellknown")},
+	}
+	model := models.UserGroupMembershipResourceModel{
+		Group:      types.StringValue("group"),
+		Zone:       types.StringNull(),
+		Users:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DOMAIN\\user1"), types.StringValue("user3")}),
+		Groups:     types.SetNull(types.StringType),
+		WellKnowns: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("NT AUTHORITY\\NETWORK")}),
+	}
+
+	// members with backslash are kept, removed members are dropped
+	helper.UpdateUserGroupMembershipState(&model, groupMembers)
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DOMAIN\\user1")}), model.Users)
+	assert.True(t, model.Groups.IsNull())
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("NT AUTHORITY\\NETWORK")}), model.WellKnowns)
+	assert.Equal(t, "group", model.ID.ValueString())
+
+	// only the given members are imported
+	imported := models.UserGroupMembershipResourceModel{Group: types.StringValue("group"), Zone: types.StringValue("zone")}
+	assert.Nil(t, helper.ImportUserGroupMembershipState(&imported, groupMembers, []string{"DOMAIN\\user1"}))
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DOMAIN\\user1")}), imported.Users)
+	assert.True(t, imported.WellKnowns.IsNull())
+	assert.Equal(t, "zone:group", imported.ID.ValueString())
+
+	assert.NotNil(t, helper.ImportUserGroupMembershipState(&imported, groupMembers, []string{"user3"}))
+}
+
+func TestUpdateUserGroupMembershipBackslash(t *testing.T) {
+	var added, removed []string
+	addMocker := Mock(helper.AddUserGroupMemberWithZone).To(func(ctx context.Context, powerscaleClient *client.Client, memberIdentity powerscale.V1AuthAccessAccessItemFileGroup, userGroupName, zone string) error {
+		added = append(added, memberIdentity.GetName())
+		return nil
+	}).Build()
+	defer addMocker.Release()
+	removeMocker := Mock(helper.RemoveUserGroupMemberWithZone).To(func(ctx context.Context, powerscaleClient *client.Client, memberAuthID, userGroupName, zone string) error {
+		removed = append(removed, memberAuthID)
+		return nil
+	}).Build()
+	defer removeMocker.Release()
+
+	state := models.UserGroupMembershipResourceModel{
+		Group:      types.StringValue("group"),
+		Zone:       types.StringNull(),
+		Users:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DOMAIN\\user1")}),
+		Groups:     types.SetValueMust(types.StringType, []attr.Value{}),
+		WellKnowns: types.SetValueMust(types.StringType, []attr.Value{}),
+	}
+	plan := state
+	plan.Users = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DOMAIN\\user2")})
+	plan.Groups = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DOMAIN\\domain users")})
+
+	// member names are sent as configured, without escaping the backslash
+	diags := helper.UpdateUserGroupMembership(context.Background(), nil, &state, &plan)
+	assert.False(t, diags.HasError())
+	assert.ElementsMatch(t, []string{"DOMAIN\\user2", "DOMAIN\\domain users"}, added)
+	assert.Equal(t, []string{"USER:DOMAIN\\user1"}, removed)
+}
+
+func TestAccUserGroupMembershipResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Not existing user group
+			{
+				Config:      ProviderConfig + userGroupMembershipInvalidGroupResourceConfig,
+				ExpectError: regexp.MustCompile(`.*Error creating the User Group Membership*.`),
+			},
+			// Add member error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = Mock(helper.AddUserGroupMemberWithZone).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + userGroupMembershipResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Get members error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetAllGroupMembersWithZone).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + userGroupMembershipResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + userGroupMembershipResourceConfig,
+			},
+			// Remove member error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.RemoveUserGroupMemberWithZone).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + userGroupMembershipUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + userGroupMembershipUpdateResourceConfig,
+			},
+		},
+	})
+}
+
+var userGroupMembershipPreConfig = `
+resource "powerscale_user" "member_user" {
+	name = "tfaccMembershipUser"
+}
+
+resource "powerscale_user" "member_user2" {
+	name = "tfaccMembershipUser2"
+}
+
+resource "powerscale_user_group" "shared" {
+	name = "tfaccMembershipGroup"
+	lifecycle {
+		ignore_changes = [users, groups, well_knowns]
+	}
+}
+`
+
+var userGroupMembershipResourceConfig = userGroupMembershipPreConfig + `
+resource "powerscale_user_group_membership" "team_a" {
+	group = powerscale_user_group.shared.name
+	users = [powerscale_user.member_user.name]
+}
+
+resource "powerscale_user_group_membership" "team_b" {
+	group = powerscale_user_group.shared.name
+	users = [powerscale_user.member_user2.name]
+	well_knowns = ["Everyone"]
+	depends_on = [powerscale_user_group_membership.team_a]
+}
+`
+
+var userGroupMembershipUpdateResourceConfig = userGroupMembershipPreConfig + `
+resource "powerscale_user_group_membership" "team_a" {
+	group = powerscale_user_group.shared.name
+	users = []
+	groups = ["admin"]
+}
+
+resource "powerscale_user_group_membership" "team_b" {
+	group = powerscale_user_group.shared.name
+	users = [powerscale_user.member_user2.name]
+	well_knowns = ["Everyone"]
+	depends_on = [powerscale_user_group_membership.team_a]
+}
+`
+
+var userGroupMembershipInvalidGroupResourceConfig = `
+resource "powerscale_user_group_membership" "team_a" {
+	group = "tfaccInvalidMembershipGroup"
+	users = ["admin"]
+}
+`
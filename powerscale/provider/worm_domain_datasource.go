@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WormDomainDataSource{}
+
+// NewWormDomainDataSource creates a new data source.
+func NewWormDomainDataSource() datasource.DataSource {
+	return &WormDomainDataSource{}
+}
+
+// WormDomainDataSource defines the data source implementation.
+type WormDomainDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *WormDomainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worm_domain"
+}
+
+// Schema describes the data source arguments.
+func (d *WormDomainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the existing SmartLock WORM domains from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing SmartLock WORM domains from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the WORM Domain datasource.",
+				MarkdownDescription: "Identifier of the WORM Domain datasource.",
+				Computed:            true,
+			},
+			"worm_domains_details": schema.ListNestedAttribute{
+				Description:         "List of WORM domains.",
+				MarkdownDescription: "List of WORM domains.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "The unique identifier of the WORM domain.",
+							MarkdownDescription: "The unique identifier of the WORM domain.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							Description:         "The root path of the WORM domain.",
+							MarkdownDescription: "The root path of the WORM domain.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "The type of the WORM domain, enterprise or compliance.",
+							MarkdownDescription: "The type of the WORM domain, enterprise or compliance.",
+							Computed:            true,
+						},
+						"default_retention": schema.StringAttribute{
+							Description:         "The default retention period of files committed in the domain, e.g. 1Y6M.",
+							MarkdownDescription: "The default retention period of files committed in the domain, e.g. 1Y6M.",
+							Computed:            true,
+						},
+						"min_retention": schema.StringAttribute{
+							Description:         "The minimum retention period of files committed in the domain, e.g. 1Y6M.",
+							MarkdownDescription: "The minimum retention period of files committed in the domain, e.g. 1Y6M.",
+							Computed:            true,
+						},
+						"max_retention": schema.StringAttribute{
+							Description:         "The maximum retention period of files committed in the domain, e.g. 1Y6M.",
+							MarkdownDescription: "The maximum retention period of files committed in the domain, e.g. 1Y6M.",
+							Computed:            true,
+						},
+						"autocommit_offset": schema.Int64Attribute{
+							Description:         "The autocommit time period in seconds.",
+							MarkdownDescription: "The autocommit time period in seconds.",
+							Computed:            true,
+						},
+						"override_date": schema.Int64Attribute{
+							Description:         "The retention date override as a unix epoch timestamp.",
+							MarkdownDescription: "The retention date override as a unix epoch timestamp.",
+							Computed:            true,
+						},
+						"privileged_delete": schema.StringAttribute{
+							Description:         "The privileged delete setting, on, off or disabled.",
+							MarkdownDescription: "The privileged delete setting, on, off or disabled.",
+							Computed:            true,
+						},
+						"lin": schema.Int64Attribute{
+							Description:         "The LIN of the root directory of the WORM domain.",
+							MarkdownDescription: "The LIN of the root directory of the WORM domain.",
+							Computed:            true,
+						},
+						"incomplete": schema.BoolAttribute{
+							Description:         "Whether the WORM domain is incomplete.",
+							MarkdownDescription: "Whether the WORM domain is incomplete.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"paths": schema.SetAttribute{
+						Description:         "Only list the WORM domains with these root paths.",
+						MarkdownDescription: "Only list the WORM domains with these root paths.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"types": schema.SetAttribute{
+						Description:         "Only list the WORM domains of these types. Acceptable values: enterprise, compliance.",
+						MarkdownDescription: "Only list the WORM domains of these types. Acceptable values: enterprise, compliance.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf("enterprise", "compliance")),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *WormDomainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *WormDomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading WORM domain data source")
+
+	var state models.WormDomainDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains, err := helper.ListWormDomains(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the list of WORM domains", err.Error())
+		return
+	}
+
+	var paths, domainTypes []string
+	if state.WormDomainFilter != nil {
+		for _, p := range state.WormDomainFilter.Paths {
+			paths = append(paths, p.ValueString())
+		}
+		for _, t := range state.WormDomainFilter.Types {
+			domainTypes = append(domainTypes, t.ValueString())
+		}
+	}
+
+	wormDomains := []models.WormDomainResourceModel{}
+	for _, domain := range domains {
+		if len(paths) > 0 && !slices.Contains(paths, domain.GetPath()) {
+			continue
+		}
+		if len(domainTypes) > 0 && !slices.Contains(domainTypes, domain.GetType()) {
+			continue
+		}
+		var model models.WormDomainResourceModel
+		item := domain
+		helper.UpdateWormDomainState(&model, &item)
+		wormDomains = append(wormDomains, model)
+	}
+
+	if len(paths) > 0 && len(wormDomains) < len(paths) && len(domainTypes) == 0 {
+		resp.Diagnostics.AddError(
+			"Error reading WORM domain data source",
+			fmt.Sprintf("Could not find all the WORM domains with paths %v", paths),
+		)
+		return
+	}
+
+	state.WormDomains = wormDomains
+	state.ID = types.StringValue("worm_domain_datasource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading WORM domain data source ")
+}
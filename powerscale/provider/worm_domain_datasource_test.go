@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWormDomainDataSource(t *testing.T) {
+	var wormDomainTerraformName = "data.powerscale_worm_domain.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read all the WORM domains
+			{
+				Config: ProviderConfig + WormDomainAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(wormDomainTerraformName, "id", "worm_domain_datasource"),
+					resource.TestCheckResourceAttrSet(wormDomainTerraformName, "worm_domains_details.#"),
+				),
+			},
+			// Filter by path
+			{
+				Config: ProviderConfig + WormDomainDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(wormDomainTerraformName, "worm_domains_details.#", "1"),
+					resource.TestCheckResourceAttr(wormDomainTerraformName, "worm_domains_details.0.path", "/ifs/tfacc_worm_domain"),
+					resource.TestCheckResourceAttr(wormDomainTerraformName, "worm_domains_details.0.default_retention", "1D"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWormDomainDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Path not found
+			{
+				Config:      ProviderConfig + WormDomainInvalidPathDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*Could not find all the WORM domains*.`),
+			},
+			// List error
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListWormDomains).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + WormDomainAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var WormDomainAllDataSourceConfig = `
+data "powerscale_worm_domain" "test" {
+}
+`
+
+var WormDomainDataSourceConfig = WormDomainResourceConfig + `
+data "powerscale_worm_domain" "test" {
+	filter {
+		paths = [powerscale_worm_domain.worm_test.path]
+	}
+}
+`
+
+var WormDomainInvalidPathDataSourceConfig = `
+data "powerscale_worm_domain" "test" {
+	filter {
+		paths = ["/ifs/invalid_worm_domain_path"]
+	}
+}
+`
@@ -0,0 +1,335 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WormDomainResource{}
+var _ resource.ResourceWithConfigure = &WormDomainResource{}
+var _ resource.ResourceWithImportState = &WormDomainResource{}
+var _ resource.ResourceWithModifyPlan = &WormDomainResource{}
+
+// NewWormDomainResource creates a new resource.
+func NewWormDomainResource() resource.Resource {
+	return &WormDomainResource{}
+}
+
+// WormDomainResource defines the resource implementation.
+type WormDomainResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *WormDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worm_domain"
+}
+
+// Schema describes the resource arguments.
+func (r *WormDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	retentionValidator := stringvalidator.RegexMatches(helper.WormRetentionRegex, "must be a retention period in the format <integer><unit>, where unit is one of Y, M, W, D, H, m, s, e.g. 1Y6M")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the SmartLock WORM domains of PowerScale Array. " +
+			"We can Create and Update the WORM domains using this resource. We can also import an existing WORM domain from PowerScale array. " +
+			"Note that, PowerScale does not allow deleting WORM domains, so destroying this resource only removes it from the Terraform state. " +
+			"The path and type of a WORM domain cannot be changed, and disabling privileged delete is permanent. Such changes are reported at plan time.",
+		Description: "This resource is used to manage the SmartLock WORM domains of PowerScale Array. " +
+			"We can Create and Update the WORM domains using this resource. We can also import an existing WORM domain from PowerScale array. " +
+			"Note that, PowerScale does not allow deleting WORM domains, so destroying this resource only removes it from the Terraform state. " +
+			"The path and type of a WORM domain cannot be changed, and disabling privileged delete is permanent. Such changes are reported at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The unique identifier of the WORM domain.",
+				MarkdownDescription: "The unique identifier of the WORM domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description:         "The root path of the WORM domain, e.g. /ifs/data/archive. The directory must exist and be empty. Cannot be updated.",
+				MarkdownDescription: "The root path of the WORM domain, e.g. /ifs/data/archive. The directory must exist and be empty. Cannot be updated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "The type of the WORM domain. Acceptable values: enterprise, compliance. compliance is only available on clusters in SmartLock compliance mode. Cannot be updated.",
+				MarkdownDescription: "The type of the WORM domain. Acceptable values: enterprise, compliance. compliance is only available on clusters in SmartLock compliance mode. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("enterprise"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("enterprise", "compliance"),
+				},
+			},
+			"default_retention": schema.StringAttribute{
+				Description:         "The default retention period of files committed in the domain without an explicit retention date, in the format <integer><unit> where unit is one of Y, M, W, D, H, m, s, e.g. 1Y6M.",
+				MarkdownDescription: "The default retention period of files committed in the domain without an explicit retention date, in the format <integer><unit> where unit is one of Y, M, W, D, H, m, s, e.g. 1Y6M.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{retentionValidator},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"min_retention": schema.StringAttribute{
+				Description:         "The minimum retention period of files committed in the domain, in the format <integer><unit> where unit is one of Y, M, W, D, H, m, s, e.g. 1Y6M.",
+				MarkdownDescription: "The minimum retention period of files committed in the domain, in the format <integer><unit> where unit is one of Y, M, W, D, H, m, s, e.g. 1Y6M.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{retentionValidator},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_retention": schema.StringAttribute{
+				Description:         "The maximum retention period of files committed in the domain, in the format <integer><unit> where unit is one of Y, M, W, D, H, m, s, e.g. 1Y6M.",
+				MarkdownDescription: "The maximum retention period of files committed in the domain, in the format <integer><unit> where unit is one of Y, M, W, D, H, m, s, e.g. 1Y6M.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{retentionValidator},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"autocommit_offset": schema.Int64Attribute{
+				Description:         "The autocommit time period in seconds. Files which have not been modified for this period are committed automatically.",
+				MarkdownDescription: "The autocommit time period in seconds. Files which have not been modified for this period are committed automatically.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"override_date": schema.Int64Attribute{
+				Description:         "The retention date override as a unix epoch timestamp. Files committed in the domain are retained at least until this date.",
+				MarkdownDescription: "The retention date override as a unix epoch timestamp. Files committed in the domain are retained at least until this date.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"privileged_delete": schema.StringAttribute{
+				Description:         "Whether committed files can be deleted by a privileged user before expiry. Acceptable values: on, off, disabled. disabled is permanent. Only applicable to enterprise domains.",
+				MarkdownDescription: "Whether committed files can be deleted by a privileged user before expiry. Acceptable values: on, off, disabled. disabled is permanent. Only applicable to enterprise domains.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("on", "off", "disabled"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"lin": schema.Int64Attribute{
+				Description:         "The LIN of the root directory of the WORM domain.",
+				MarkdownDescription: "The LIN of the root directory of the WORM domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"incomplete": schema.BoolAttribute{
+				Description:         "Whether the WORM domain is incomplete, e.g. while the domain is still being created on existing files.",
+				MarkdownDescription: "Whether the WORM domain is incomplete, e.g. while the domain is still being created on existing files.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *WormDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ModifyPlan reports the irreversible effects of the planned changes.
+func (r *WormDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state, plan *models.WormDomainResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &models.WormDomainResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+	if !req.Plan.Raw.IsNull() {
+		plan = &models.WormDomainResourceModel{}
+		resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	}
+	if resp.Diagnostics.HasError() || (state == nil && plan == nil) {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.WormDomainPlanDiagnostics(state, plan)...)
+}
+
+// Create allocates the resource.
+func (r *WormDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating WORM domain")
+
+	var plan models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID, err := helper.CreateWormDomain(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating WORM domain", err.Error())
+		return
+	}
+
+	domain, err := helper.GetWormDomain(ctx, r.client, domainID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating WORM domain", err.Error())
+		return
+	}
+	helper.UpdateWormDomainState(&plan, domain)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create WORM domain")
+}
+
+// Read reads the resource state.
+func (r *WormDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading WORM domain")
+
+	var state models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := helper.GetWormDomain(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading WORM domain", err.Error())
+		return
+	}
+	helper.UpdateWormDomainState(&state, domain)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read WORM domain")
+}
+
+// Update updates the resource state.
+func (r *WormDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating WORM domain")
+
+	var plan models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateWormDomain(ctx, r.client, state, plan); err != nil {
+		resp.Diagnostics.AddError("Error updating WORM domain", err.Error())
+		return
+	}
+
+	domain, err := helper.GetWormDomain(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating WORM domain", err.Error())
+		return
+	}
+	helper.UpdateWormDomainState(&plan, domain)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update WORM domain")
+}
+
+// Delete removes the resource from the state. WORM domains cannot be deleted from PowerScale.
+func (r *WormDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting WORM domain")
+
+	var state models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete WORM domain")
+}
+
+// ImportState imports the resource state by the WORM domain ID or path.
+func (r *WormDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing WORM domain")
+
+	domain, err := helper.GetWormDomain(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing WORM domain", err.Error())
+		return
+	}
+
+	var state models.WormDomainResourceModel
+	helper.UpdateWormDomainState(&state, domain)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import WORM domain")
+}
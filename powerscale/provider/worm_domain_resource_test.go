@@ -0,0 +1,257 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccWormDomainResource(t *testing.T) {
+	var wormDomainResourceName = "powerscale_worm_domain.worm_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + WormDomainResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(wormDomainResourceName, "path", "/ifs/tfacc_worm_domain"),
+					resource.TestCheckResourceAttr(wormDomainResourceName, "type", "enterprise"),
+					resource.TestCheckResourceAttr(wormDomainResourceName, "default_retention", "1D"),
+					resource.TestCheckResourceAttr(wormDomainResourceName, "min_retention", "1H"),
+					resource.TestCheckResourceAttr(wormDomainResourceName, "max_retention", "1Y"),
+					resource.TestCheckResourceAttr(wormDomainResourceName, "privileged_delete", "off"),
+					resource.TestCheckResourceAttrSet(wormDomainResourceName, "id"),
+					resource.TestCheckResourceAttrSet(wormDomainResourceName, "lin"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: wormDomainResourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources[wormDomainResourceName].Primary.ID, nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "/ifs/tfacc_worm_domain", states[0].Attributes["path"])
+					assert.Equal(t, "1D", states[0].Attributes["default_retention"])
+					return nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + WormDomainUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(wormDomainResourceName, "default_retention", "2W"),
+					resource.TestCheckResourceAttr(wormDomainResourceName, "autocommit_offset", "3600"),
+					resource.TestCheckResourceAttr(wormDomainResourceName, "privileged_delete", "on"),
+				),
+			},
+			// Changing the path or type is rejected at plan time
+			{
+				Config:      ProviderConfig + WormDomainUpdateTypeResourceConfig,
+				ExpectError: regexp.MustCompile(`.*WORM domain type cannot be changed*.`),
+			},
+			{
+				Config:      ProviderConfig + WormDomainUpdatePathResourceConfig,
+				ExpectError: regexp.MustCompile(`.*WORM domain path cannot be changed*.`),
+			},
+		},
+	})
+}
+
+func TestAccWormDomainResourceInvalidRetention(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + WormDomainInvalidRetentionResourceConfig,
+				ExpectError: regexp.MustCompile(`.*must be a retention period*.`),
+			},
+		},
+	})
+}
+
+func TestUpdateWormDomainStateRetention(t *testing.T) {
+	domain := &powerscale.V1WormDomainExtended{
+		DefaultRetention: &powerscale.V1WormDomainRetention{Years: helper.New(int32(1)), Months: helper.New(int32(6))},
+		MinRetention:     &powerscale.V1WormDomainRetention{Years: helper.New(int32(1)), Months: helper.New(int32(0))},
+		MaxRetention:     &powerscale.V1WormDomainRetention{Days: helper.New(int32(0))},
+	}
+
+	// equivalent configured forms are kept
+	model := models.WormDomainResourceModel{
+		DefaultRetention: types.StringValue("6M1Y"),
+		MinRetention:     types.StringValue("1Y0M"),
+		MaxRetention:     types.StringValue("0D"),
+	}
+	helper.UpdateWormDomainState(&model, domain)
+	assert.Equal(t, "6M1Y", model.DefaultRetention.ValueString())
+	assert.Equal(t, "1Y0M", model.MinRetention.ValueString())
+	assert.Equal(t, "0D", model.MaxRetention.ValueString())
+
+	// changes on the cluster are read back in the canonical form
+	model.DefaultRetention = types.StringValue("2Y")
+	model.MinRetention = types.StringNull()
+	helper.UpdateWormDomainState(&model, domain)
+	assert.Equal(t, "1Y6M", model.DefaultRetention.ValueString())
+	assert.Equal(t, "1Y", model.MinRetention.ValueString())
+}
+
+func TestAccWormDomainResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateWormDomain).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + WormDomainResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Read after create error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetWormDomain).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + WormDomainResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + WormDomainResourceConfig,
+			},
+			// Update error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.UpdateWormDomain).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + WormDomainUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Import error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetWormDomain).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:        ProviderConfig + WormDomainResourceConfig,
+				ResourceName:  "powerscale_worm_domain.worm_test",
+				ImportState:   true,
+				ImportStateId: "/ifs/tfacc_worm_domain",
+				ExpectError:   regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + WormDomainResourceConfig,
+			},
+		},
+	})
+}
+
+var WormDomainDirectoryConfig = `
+resource "powerscale_filesystem" "worm_dir" {
+	directory_path = "/ifs"
+	name = "tfacc_worm_domain"
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+}
+`
+
+var WormDomainResourceConfig = WormDomainDirectoryConfig + `
+resource "powerscale_worm_domain" "worm_test" {
+	depends_on = [powerscale_filesystem.worm_dir]
+	path = "/ifs/tfacc_worm_domain"
+	default_retention = "1D"
+	min_retention = "1H"
+	max_retention = "1Y"
+	privileged_delete = "off"
+}
+`
+
+var WormDomainUpdateResourceConfig = WormDomainDirectoryConfig + `
+resource "powerscale_worm_domain" "worm_test" {
+	depends_on = [powerscale_filesystem.worm_dir]
+	path = "/ifs/tfacc_worm_domain"
+	default_retention = "2W"
+	min_retention = "1H"
+	max_retention = "1Y"
+	autocommit_offset = 3600
+	privileged_delete = "on"
+}
+`
+
+var WormDomainUpdateTypeResourceConfig = WormDomainDirectoryConfig + `
+resource "powerscale_worm_domain" "worm_test" {
+	depends_on = [powerscale_filesystem.worm_dir]
+	path = "/ifs/tfacc_worm_domain"
+	type = "compliance"
+	default_retention = "2W"
+	min_retention = "1H"
+	max_retention = "1Y"
+	autocommit_offset = 3600
+	privileged_delete = "on"
+}
+`
+
+var WormDomainUpdatePathResourceConfig = WormDomainDirectoryConfig + `
+resource "powerscale_worm_domain" "worm_test" {
+	depends_on = [powerscale_filesystem.worm_dir]
+	path = "/ifs/tfacc_worm_domain_moved"
+	default_retention = "2W"
+	min_retention = "1H"
+	max_retention = "1Y"
+	autocommit_offset = 3600
+	privileged_delete = "on"
+}
+`
+
+var WormDomainInvalidRetentionResourceConfig = `
+resource "powerscale_worm_domain" "worm_test" {
+	path = "/ifs/tfacc_worm_domain"
+	default_retention = "1 year"
+}
+`
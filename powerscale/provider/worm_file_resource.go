@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WormFileResource{}
+var _ resource.ResourceWithConfigure = &WormFileResource{}
+var _ resource.ResourceWithModifyPlan = &WormFileResource{}
+
+// NewWormFileResource creates a new resource.
+func NewWormFileResource() resource.Resource {
+	return &WormFileResource{}
+}
+
+// WormFileResource defines the resource implementation.
+type WormFileResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *WormFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worm_file"
+}
+
+// Schema describes the resource arguments.
+func (r *WormFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to commit files in SmartLock WORM domains of PowerScale Array and to extend their retention dates. " +
+			"The retention date of a file is never shortened, and committed files cannot be uncommitted. Such changes are reported at plan time. " +
+			"Note that, destroying this resource only removes it from the Terraform state, the files stay committed.",
+		Description: "This resource is used to commit files in SmartLock WORM domains of PowerScale Array and to extend their retention dates. " +
+			"The retention date of a file is never shortened, and committed files cannot be uncommitted. Such changes are reported at plan time. " +
+			"Note that, destroying this resource only removes it from the Terraform state, the files stay committed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Placeholder ID",
+				MarkdownDescription: "Placeholder ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"paths": schema.SetAttribute{
+				Description:         "Absolute paths of the files in a WORM domain, e.g. /ifs/archive/file.txt.",
+				MarkdownDescription: "Absolute paths of the files in a WORM domain, e.g. /ifs/archive/file.txt.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs/.+`), "must be an absolute path under /ifs")),
+				},
+			},
+			"commit": schema.BoolAttribute{
+				Description:         "Whether to commit the files to WORM. Committed files cannot be uncommitted. Defaults to true.",
+				MarkdownDescription: "Whether to commit the files to WORM. Committed files cannot be uncommitted. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"retention_date": schema.StringAttribute{
+				Description:         "The retention date of the files in RFC3339 format, e.g. 2030-01-01T00:00:00Z. The retention date can only be extended. Defaults to the default retention of the WORM domain.",
+				MarkdownDescription: "The retention date of the files in RFC3339 format, e.g. 2030-01-01T00:00:00Z. The retention date can only be extended. Defaults to the default retention of the WORM domain.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(Z|[+-]\d{2}:\d{2})$`), "must be a date in RFC3339 format, e.g. 2030-01-01T00:00:00Z"),
+				},
+			},
+			"files": schema.ListNestedAttribute{
+				Description:         "The current WORM state of the files.",
+				MarkdownDescription: "The current WORM state of the files.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Description:         "Path of the file.",
+							MarkdownDescription: "Path of the file.",
+							Computed:            true,
+						},
+						"committed": schema.BoolAttribute{
+							Description:         "Whether the file is committed to WORM.",
+							MarkdownDescription: "Whether the file is committed to WORM.",
+							Computed:            true,
+						},
+						"retention_date": schema.StringAttribute{
+							Description:         "The retention date of the file in RFC3339 format.",
+							MarkdownDescription: "The retention date of the file in RFC3339 format.",
+							Computed:            true,
+						},
+						"domain_id": schema.Int64Attribute{
+							Description:         "ID of the WORM domain the file belongs to.",
+							MarkdownDescription: "ID of the WORM domain the file belongs to.",
+							Computed:            true,
+						},
+						"domain_path": schema.StringAttribute{
+							Description:         "Root path of the WORM domain the file belongs to.",
+							MarkdownDescription: "Root path of the WORM domain the file belongs to.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *WormFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ModifyPlan reports the irreversible effects of the planned changes.
+func (r *WormFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state, plan *models.WormFileResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &models.WormFileResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+	if !req.Plan.Raw.IsNull() {
+		plan = &models.WormFileResourceModel{}
+		resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	}
+	if resp.Diagnostics.HasError() || (state == nil && plan == nil) {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.WormFilePlanDiagnostics(state, plan)...)
+}
+
+// Create commits the files and sets the initial Terraform state.
+func (r *WormFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating WORM file resource state")
+
+	var plan models.WormFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if diags := helper.ApplyWormFiles(ctx, r.client, plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if diags := helper.UpdateWormFileResourceState(ctx, r.client, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create WORM file resource state")
+}
+
+// Read refreshes the WORM state of the files.
+func (r *WormFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading WORM file resource state")
+
+	var state models.WormFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if diags := helper.UpdateWormFileResourceState(ctx, r.client, &state); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read WORM file resource state")
+}
+
+// Update commits the files and extends their retention dates.
+func (r *WormFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating WORM file resource state")
+
+	var plan models.WormFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if diags := helper.ApplyWormFiles(ctx, r.client, plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if diags := helper.UpdateWormFileResourceState(ctx, r.client, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update WORM file resource state")
+}
+
+// Delete removes the resource from the state. Committed files cannot be uncommitted.
+func (r *WormFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting WORM file resource state")
+
+	var state models.WormFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete WORM file resource state")
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+	"time"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var wormFileRetentionDate = time.Now().UTC().Add(2 * time.Hour).Truncate(time.Second)
+
+func TestAccWormFileResource(t *testing.T) {
+	var wormFileResourceName = "powerscale_worm_file.worm_file_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + SetupWormFile() + WormFileResourceConfig(wormFileRetentionDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(wormFileResourceName, "id", "worm_file"),
+					resource.TestCheckResourceAttr(wormFileResourceName, "commit", "true"),
+					resource.TestCheckResourceAttr(wormFileResourceName, "retention_date", wormFileRetentionDate.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(wormFileResourceName, "files.#", "1"),
+					resource.TestCheckResourceAttr(wormFileResourceName, "files.0.committed", "true"),
+					resource.TestCheckResourceAttr(wormFileResourceName, "files.0.domain_path", "/ifs/tfacc_worm_file"),
+				),
+			},
+			// Extend the retention date
+			{
+				Config: ProviderConfig + SetupWormFile() + WormFileResourceConfig(wormFileRetentionDate.Add(time.Hour)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(wormFileResourceName, "retention_date", wormFileRetentionDate.Add(time.Hour).Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(wormFileResourceName, "files.0.retention_date", wormFileRetentionDate.Add(time.Hour).Format(time.RFC3339)),
+				),
+			},
+			// Shortening the retention date is rejected at plan time
+			{
+				Config:      ProviderConfig + SetupWormFile() + WormFileResourceConfig(wormFileRetentionDate),
+				ExpectError: regexp.MustCompile(`.*Retention date cannot be shortened*.`),
+			},
+			// Uncommitting is rejected at plan time
+			{
+				Config:      ProviderConfig + SetupWormFile() + WormFileUncommitResourceConfig(wormFileRetentionDate.Add(time.Hour)),
+				ExpectError: regexp.MustCompile(`.*Committed files cannot be uncommitted*.`),
+			},
+		},
+	})
+}
+
+func TestAccWormFileResourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + WormFileInvalidDateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*must be a date in RFC3339 format*.`),
+			},
+			{
+				Config:      ProviderConfig + WormFileInvalidPathResourceConfig,
+				ExpectError: regexp.MustCompile(`.*must be an absolute path under /ifs*.`),
+			},
+		},
+	})
+}
+
+func TestAccWormFileResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read WORM properties error
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.GetWormFileProperties).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SetupWormFile() + WormFileResourceConfig(wormFileRetentionDate),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Set WORM properties error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.SetWormFileProperties).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SetupWormFile() + WormFileResourceConfig(wormFileRetentionDate),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func SetupWormFile() string {
+	connection := fmt.Sprintf(`
+  connection {
+      host     = "%s"
+      port     = %s
+      user     = "%s"
+      password = "%s"
+      type     = "ssh"
+    }
+  `, powerScaleSSHIP, powerscaleSSHPort, powerscaleUsername, powerscalePassword)
+
+	return `
+  resource "terraform_data" "worm_file" {
+    provisioner "remote-exec" {
+      inline = [
+        "mkdir -p /ifs/tfacc_worm_file",
+        "isi worm domains view /ifs/tfacc_worm_file || isi worm domains create /ifs/tfacc_worm_file --default-retention 1H --privileged-delete on --force",
+        "test -f /ifs/tfacc_worm_file/file.txt || touch /ifs/tfacc_worm_file/file.txt",
+      ]
+      ` + connection + `
+    }
+  }
+`
+}
+
+func WormFileResourceConfig(retentionDate time.Time) string {
+	return fmt.Sprintf(`
+resource "powerscale_worm_file" "worm_file_test" {
+	depends_on = [terraform_data.worm_file]
+	paths = ["/ifs/tfacc_worm_file/file.txt"]
+	commit = true
+	retention_date = "%s"
+}
+`, retentionDate.Format(time.RFC3339))
+}
+
+func WormFileUncommitResourceConfig(retentionDate time.Time) string {
+	return fmt.Sprintf(`
+resource "powerscale_worm_file" "worm_file_test" {
+	depends_on = [terraform_data.worm_file]
+	paths = ["/ifs/tfacc_worm_file/file.txt"]
+	commit = false
+	retention_date = "%s"
+}
+`, retentionDate.Format(time.RFC3339))
+}
+
+var WormFileInvalidDateResourceConfig = `
+resource "powerscale_worm_file" "worm_file_test" {
+	paths = ["/ifs/tfacc_worm_file/file.txt"]
+	retention_date = "2030-01-01"
+}
+`
+
+var WormFileInvalidPathResourceConfig = `
+resource "powerscale_worm_file" "worm_file_test" {
+	paths = ["tfacc_worm_file/file.txt"]
+}
+`